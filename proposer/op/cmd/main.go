@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
 	"github.com/succinctlabs/op-succinct-go/proposer/utils"
@@ -54,11 +55,69 @@ func main() {
 				Usage:    "Address of L1 Beacon-node HTTP endpoint to use",
 				EnvVars:  []string{"L1_BEACON_RPC"},
 			},
+			&cli.StringFlag{
+				Name:     "celestia.server",
+				Required: false,
+				Usage:    "URL of the Celestia alt-DA server, for chains posting batch data to Celestia",
+				EnvVars:  []string{"CELESTIA_DA_SERVER"},
+			},
+			&cli.StringFlag{
+				Name:     "eigenda.proxy",
+				Required: false,
+				Usage:    "URL of the EigenDA proxy, for chains posting batch data to EigenDA",
+				EnvVars:  []string{"EIGENDA_PROXY"},
+			},
+			&cli.Uint64Flag{
+				Name:  "l1.concurrency",
+				Usage: "Number of L1 blocks to fetch at the same time",
+				Value: 10,
+			},
+			&cli.Uint64Flag{
+				Name:  "l1.beacon.concurrency",
+				Usage: "Number of slots whose blob sidecars are fetched from the L1 Beacon node at the same time",
+				Value: 4,
+			},
+			&cli.Uint64Flag{
+				Name:  "decode.memory-budget",
+				Usage: "Memory budget in bytes for decompressing channels at the same time",
+				Value: 512 << 20,
+			},
+			&cli.DurationFlag{
+				Name:  "rpc.timeout",
+				Usage: "Timeout of each RPC request to the L1, L1 Beacon and L2 nodes",
+				Value: utils.DefaultRPCTimeout,
+			},
+			&cli.Uint64Flag{
+				Name:  "l1.block-time",
+				Usage: "L1 block time in seconds, used if the block time measured from the L1 headers is implausible",
+				Value: 12,
+			},
+			&cli.DurationFlag{
+				Name:  "l1.head-wait",
+				Usage: "How long to wait for the L1 chain to reach the blocks the batches are expected in, for recent L2 blocks",
+			},
+			&cli.BoolFlag{
+				Name:  "strict",
+				Usage: "Fail with a report of every invalid frame and batch, instead of skipping them",
+			},
 			&cli.StringFlag{
 				Name:     "sender",
 				Required: false,
 				Usage:    "Batch Sender Address",
 			},
+			&cli.StringFlag{
+				Name:  "boundary",
+				Usage: "How span batches that straddle the edges of the range are returned: split clips them to the range, snap returns them whole",
+				Value: string(utils.BoundarySplit),
+			},
+			&cli.StringSliceFlag{
+				Name:  "inbox",
+				Usage: "Batch inbox address, if the batcher transactions aren't all sent to the batch inbox of the rollup config. Can be given multiple times",
+			},
+			&cli.BoolFlag{
+				Name:  "sender.auto",
+				Usage: "Derive the batch senders from the batcher updates of the SystemConfig contract, to handle batcher rotations",
+			},
 		},
 		Action: func(cliCtx *cli.Context) error {
 			// Get the chain ID from the L2 RPC.
@@ -77,7 +136,7 @@ func main() {
 				log.Fatal(err)
 			}
 
-			l1BeaconClient, err := utils.SetupBeacon(cliCtx.String("l1.beacon"))
+			l1BeaconClient, err := utils.SetupBeacon(cliCtx.Context, cliCtx.String("l1.beacon"), cliCtx.Duration("rpc.timeout"))
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -92,25 +151,54 @@ func main() {
 			}
 
 			config := utils.BatchDecoderConfig{
-				L2GenesisTime:     rollupCfg.Genesis.L2Time,
-				L2GenesisBlock:    rollupCfg.Genesis.L2.Number,
-				L2BlockTime:       rollupCfg.BlockTime,
-				BatchInboxAddress: rollupCfg.BatchInboxAddress,
-				L2StartBlock:      cliCtx.Uint64("start"),
-				L2EndBlock:        cliCtx.Uint64("end"),
-				L2ChainID:         rollupCfg.L2ChainID,
-				L2Node:            rollupClient,
-				L1RPC:             *l1Client,
-				L1Beacon:          l1BeaconClient,
-				BatchSender:       rollupCfg.Genesis.SystemConfig.BatcherAddr,
-				DataDir:           fmt.Sprintf("/tmp/batch_decoder/%d/transactions_cache", rollupCfg.L2ChainID),
+				L2GenesisTime:        rollupCfg.Genesis.L2Time,
+				L2GenesisBlock:       rollupCfg.Genesis.L2.Number,
+				L2BlockTime:          rollupCfg.BlockTime,
+				BatchInboxAddress:    rollupCfg.BatchInboxAddress,
+				L2StartBlock:         cliCtx.Uint64("start"),
+				L2EndBlock:           cliCtx.Uint64("end"),
+				L2ChainID:            rollupCfg.L2ChainID,
+				L2Node:               rollupClient,
+				L1RPC:                *l1Client,
+				L1Beacon:             l1BeaconClient,
+				BatchSender:          rollupCfg.Genesis.SystemConfig.BatcherAddr,
+				DataDir:              fmt.Sprintf("/tmp/batch_decoder/%d/transactions_cache", rollupCfg.L2ChainID),
+				L1FetchConcurrency:   cliCtx.Uint64("l1.concurrency"),
+				BlobFetchConcurrency: cliCtx.Uint64("l1.beacon.concurrency"),
+				DecodeMemoryBudget:   cliCtx.Uint64("decode.memory-budget"),
+				StrictDecode:         cliCtx.Bool("strict"),
+				RPCTimeout:           cliCtx.Duration("rpc.timeout"),
+				L1BlockTime:          cliCtx.Uint64("l1.block-time"),
+				L1HeadWait:           cliCtx.Duration("l1.head-wait"),
+				AutoBatchSender:      cliCtx.Bool("sender.auto"),
+			}
+			if config.BoundaryPolicy, err = utils.ParseRangeBoundaryPolicy(cliCtx.String("boundary")); err != nil {
+				log.Fatal(err)
+			}
+			if inboxes := cliCtx.StringSlice("inbox"); len(inboxes) > 0 {
+				config.InboxFilter = &utils.InboxFilter{}
+				for _, inbox := range inboxes {
+					if !common.IsHexAddress(inbox) {
+						log.Fatalf("invalid batch inbox address %q", inbox)
+					}
+					config.InboxFilter.Addresses = append(config.InboxFilter.Addresses, common.HexToAddress(inbox))
+				}
+			}
+			if celestiaServer := cliCtx.String("celestia.server"); celestiaServer != "" {
+				config.AltDA = utils.NewCelestiaDAClient(celestiaServer)
+			} else if eigenDAProxy := cliCtx.String("eigenda.proxy"); eigenDAProxy != "" {
+				config.AltDA = utils.NewEigenDAClient(eigenDAProxy)
 			}
 
-			ranges, err := utils.GetAllSpanBatchesInL2BlockRange(config)
+			ranges, stats, _, err := utils.GetAllSpanBatchesInL2BlockRangesWithStats(cliCtx.Context, config, []utils.SpanBatchRange{{Start: config.L2StartBlock, End: config.L2EndBlock}})
 			if err != nil {
 				log.Fatal(err)
 			}
-			fmt.Printf("Span batch ranges: %v\n", ranges)
+			fmt.Printf("Span batch ranges: %v\n", ranges[0])
+			for _, algo := range stats[0].Algos() {
+				s := stats[0][algo]
+				fmt.Printf("Channels compressed with %s: %d, %d bytes compressed, %d bytes decompressed\n", algo, s.Channels, s.CompressedBytes, s.DecompressedBytes)
+			}
 			return nil
 		},
 	}
@@ -0,0 +1,62 @@
+package proposer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// archiveBatchSize is the maximum number of proof requests archived per cleanup, so that a large backlog of old proof
+// requests doesn't stall the driver loop.
+const archiveBatchSize = 1000
+
+// archiveProofs moves the COMPLETE and FAILED proof requests that were added more than ArchiveAfter ago out of the DB,
+// keeping it small. Failed requests are only archived once they're no longer retried. Complete proofs are only
+// archived once the latest output on the L2OO contract is at or past their end block.
+//
+// The archived proof requests are appended to the archive file next to the DB as JSON lines, with their proofs, and
+// are only deleted from the DB once the file is synced. Proofs encrypted at rest are archived encrypted. If the
+// proposer crashes in between, they're archived again, so readers of the archive should deduplicate by ID.
+func (l *L2OutputSubmitter) archiveProofs(latestOutputBlock uint64) error {
+	if l.config().ArchiveAfter == 0 {
+		return nil
+	}
+	before := uint64(time.Now().Add(-l.config().ArchiveAfter).Unix())
+	proofs, err := l.db.GetArchivableProofs(before, latestOutputBlock, archiveBatchSize)
+	if err != nil {
+		return err
+	}
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	path := ArchivePath(l.config().DbPath)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	ids := make([]int, len(proofs))
+	for i, p := range proofs {
+		if err := enc.Encode(p); err != nil {
+			return fmt.Errorf("failed to write proof request %d to archive: %w", p.ID, err)
+		}
+		ids[i] = p.ID
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync archive: %w", err)
+	}
+
+	if err := l.db.DeleteProofRequests(ids); err != nil {
+		return err
+	}
+	l.Log.Info("archived old proof requests", "count", len(proofs), "path", path)
+	return nil
+}
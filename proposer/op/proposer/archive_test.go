@@ -0,0 +1,103 @@
+package proposer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// TestArchiveProofs tests that complete proofs below the latest output and failed proofs that aren't retried are moved
+// to the archive, with their proofs, and that the other proofs stay in the DB.
+func TestArchiveProofs(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "proofs.db")
+	proofDB, err := db.InitDB(dbPath, false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	for start := uint64(0); start < 400; start += 100 {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, start+100))
+	}
+	proofs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, p := range proofs[:3] {
+		require.NoError(t, proofDB.UpdateProofStatus(p.ID, proofrequest.StatusPROVING))
+	}
+	require.NoError(t, proofDB.AddFulfilledProof(proofs[0].ID, []byte{1, 2, 3}, db.ProofFormat{}))
+	require.NoError(t, proofDB.AddFulfilledProof(proofs[1].ID, []byte{4, 5, 6}, db.ProofFormat{}))
+	require.NoError(t, proofDB.FailWithoutRetry(proofs[2].ID, nil))
+	// Still to be retried.
+	require.NoError(t, proofDB.UpdateProofStatus(proofs[3].ID, proofrequest.StatusFAILED))
+
+	l := newTestSubmitter(t, "")
+	l.db = *proofDB
+	l.Cfg.DbPath = dbPath
+	// Archive everything that's eligible, regardless of when it was last updated.
+	l.Cfg.ArchiveAfter = -time.Minute
+	require.NoError(t, l.archiveProofs(100))
+
+	f, err := os.Open(ArchivePath(dbPath))
+	require.NoError(t, err)
+	defer f.Close()
+	var archived []ent.ProofRequest
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var p ent.ProofRequest
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &p))
+		archived = append(archived, p)
+	}
+	require.Len(t, archived, 2)
+	require.ElementsMatch(t, []int{proofs[0].ID, proofs[2].ID}, []int{archived[0].ID, archived[1].ID})
+	for _, p := range archived {
+		if p.ID == proofs[0].ID {
+			require.Equal(t, []byte{1, 2, 3}, p.Proof)
+		}
+	}
+
+	_, err = proofDB.GetProofRequest(proofs[0].ID)
+	require.True(t, ent.IsNotFound(err))
+	_, err = proofDB.GetProofRequest(proofs[1].ID)
+	require.NoError(t, err)
+	_, err = proofDB.GetProofRequest(proofs[3].ID)
+	require.NoError(t, err)
+}
+
+// TestArchiveProofsEncrypted tests that proofs encrypted at rest are archived encrypted.
+func TestArchiveProofsEncrypted(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "proofs.db")
+	opts := db.DefaultOptions()
+	opts.EncryptionKey = make([]byte, 32)
+	proofDB, err := db.InitDBWithOptions(dbPath, false, opts)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100))
+	proofs, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, proofDB.UpdateProofStatus(proofs[0].ID, proofrequest.StatusPROVING))
+	proof := []byte("span proof that must not be archived in plaintext")
+	require.NoError(t, proofDB.AddFulfilledProof(proofs[0].ID, proof, db.ProofFormat{}))
+
+	l := newTestSubmitter(t, "")
+	l.db = *proofDB
+	l.Cfg.DbPath = dbPath
+	l.Cfg.ArchiveAfter = -time.Minute
+	require.NoError(t, l.archiveProofs(100))
+
+	raw, err := os.ReadFile(ArchivePath(dbPath))
+	require.NoError(t, err)
+	var archived ent.ProofRequest
+	require.NoError(t, json.Unmarshal(raw, &archived))
+	require.Equal(t, proofs[0].ID, archived.ID)
+	require.True(t, bytes.HasPrefix(archived.Proof, []byte("aesgcm:")))
+	require.False(t, bytes.Contains(archived.Proof, proof))
+	require.False(t, bytes.Contains(raw, proof))
+	require.False(t, bytes.Contains(raw, []byte(base64.StdEncoding.EncodeToString(proof))))
+}
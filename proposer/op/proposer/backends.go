@@ -0,0 +1,224 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+const (
+	primaryBackendName   = "primary"
+	secondaryBackendName = "secondary"
+
+	// The number of recent proof outcomes used to compute the fulfillment rate of a backend.
+	fulfillmentWindowSize = 20
+	// The minimum number of outcomes required before failing over.
+	minFulfillmentSamples = 10
+
+	// The number of proof statuses kept to answer conditional status requests.
+	statusCacheSize = 4096
+)
+
+// proverBackend is an OP Succinct server that proofs can be requested from.
+type proverBackend struct {
+	name string
+	url  string
+
+	mu sync.Mutex
+	// Recent proof outcomes, true if the proof was fulfilled.
+	outcomes []bool
+
+	// The request budget reported by the backend.
+	limits rateLimit
+}
+
+// namespaceID prefixes a proof ID returned by the backend with the backend name, so that the proof's status is polled
+// from the same backend. Proof IDs of the primary backend are stored as-is.
+func (b *proverBackend) namespaceID(proofId string) string {
+	if b.name == primaryBackendName {
+		return proofId
+	}
+	return b.name + ":" + proofId
+}
+
+// recordOutcome records whether a proof requested from the backend was fulfilled.
+func (b *proverBackend) recordOutcome(fulfilled bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.outcomes = append(b.outcomes, fulfilled)
+	if len(b.outcomes) > fulfillmentWindowSize {
+		b.outcomes = b.outcomes[len(b.outcomes)-fulfillmentWindowSize:]
+	}
+}
+
+// fulfillmentRate returns the share of recent proofs that were fulfilled, and the number of recent proofs.
+func (b *proverBackend) fulfillmentRate() (float64, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.outcomes) == 0 {
+		return 1, 0
+	}
+	fulfilled := 0
+	for _, o := range b.outcomes {
+		if o {
+			fulfilled++
+		}
+	}
+	return float64(fulfilled) / float64(len(b.outcomes)), len(b.outcomes)
+}
+
+func (b *proverBackend) resetOutcomes() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.outcomes = nil
+}
+
+// proverBackends tracks the primary and (optional) secondary OP Succinct servers, and fails over to the secondary
+// when the fulfillment rate of the primary degrades.
+type proverBackends struct {
+	primary   *proverBackend
+	secondary *proverBackend
+
+	// Shared by all requests to the servers, so that connections are reused across requests.
+	client *http.Client
+	// The last status returned for each proof ID, along with its ETag. If the server supports conditional requests, an
+	// unchanged status is answered with 304 Not Modified, and the cached status is used instead.
+	statuses *lru.Cache[string, cachedStatus]
+
+	minFulfillmentRate float64
+	cooldown           time.Duration
+
+	mu           sync.Mutex
+	failedOverAt time.Time
+	useSecondary bool
+}
+
+func newProverBackends(cfg ProposerConfig) *proverBackends {
+	pb := &proverBackends{
+		primary:            &proverBackend{name: primaryBackendName, url: cfg.OPSuccinctServerUrl},
+		minFulfillmentRate: cfg.FailoverMinFulfillmentRate,
+		cooldown:           cfg.FailoverCooldown,
+		client:             newServerHTTPClient(cfg.ServerMaxIdleConns, cfg.ServerHTTP2, cfg.ServerCompression),
+	}
+	// The cache size is a positive constant, so creating the cache can't fail.
+	pb.statuses, _ = lru.New[string, cachedStatus](statusCacheSize)
+	if cfg.OPSuccinctSecondaryServerUrl != "" {
+		pb.secondary = &proverBackend{name: secondaryBackendName, url: cfg.OPSuccinctSecondaryServerUrl}
+	}
+	return pb
+}
+
+// cachedStatus is a proof status returned by a server, and the ETag it was returned with.
+type cachedStatus struct {
+	etag   string
+	status ProofStatus
+}
+
+// NewServerHTTPClient creates the HTTP client that the proposer talks to the OP Succinct server with, e.g. to test a
+// server implementation against the proposer's connection and compression settings.
+func NewServerHTTPClient(cfg ProposerConfig) *http.Client {
+	return newServerHTTPClient(cfg.ServerMaxIdleConns, cfg.ServerHTTP2, cfg.ServerCompression)
+}
+
+// newServerHTTPClient creates an HTTP client that keeps up to maxIdleConnsPerHost idle connections open to each
+// server, and compresses request bodies with the given encoding. The client has no timeout, as the timeout of each
+// request is set through its context.
+func newServerHTTPClient(maxIdleConnsPerHost uint64, http2 bool, compression string) *http.Client {
+	var transport http.RoundTripper = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:   http2,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: max(1, int(maxIdleConnsPerHost)),
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	if compression != "" && compression != ServerCompressionNone {
+		transport = &compressingTransport{base: transport, encoding: compression}
+	}
+	return &http.Client{Transport: transport}
+}
+
+// The timeout of health probes, which is short so that an unresponsive server doesn't stall the driver loop.
+const healthProbeTimeout = 5 * time.Second
+
+// probeHealth checks the health endpoint of the backend. Servers without a health endpoint are assumed to be healthy,
+// as long as they respond.
+func (pb *proverBackends) probeHealth(ctx context.Context, backend *proverBackend) error {
+	ctx, cancel := context.WithTimeout(ctx, healthProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", backend.url+"/health", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := pb.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrServerUnreachable, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusMethodNotAllowed {
+		return fmt.Errorf("health check of %s backend failed with status %d", backend.name, resp.StatusCode)
+	}
+	return nil
+}
+
+// active returns the backend that new proofs should be requested from.
+func (pb *proverBackends) active() *proverBackend {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+	if pb.useSecondary {
+		return pb.secondary
+	}
+	return pb.primary
+}
+
+// resolve returns the backend that a stored proof ID was requested from, and the proof ID on that backend.
+func (pb *proverBackends) resolve(proofId string) (*proverBackend, string) {
+	if pb.secondary != nil {
+		if id, ok := strings.CutPrefix(proofId, secondaryBackendName+":"); ok {
+			return pb.secondary, id
+		}
+	}
+	return pb.primary, proofId
+}
+
+// maybeFailover switches new requests to the secondary backend if the fulfillment rate of the primary dropped below
+// the configured minimum, and back to the primary once the cooldown has elapsed.
+func (pb *proverBackends) maybeFailover(log log.Logger) {
+	if pb.secondary == nil {
+		return
+	}
+
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+
+	if pb.useSecondary {
+		if time.Since(pb.failedOverAt) >= pb.cooldown {
+			log.Info("failover cooldown elapsed, switching back to the primary prover backend")
+			pb.useSecondary = false
+			pb.primary.resetOutcomes()
+		}
+		return
+	}
+
+	rate, samples := pb.primary.fulfillmentRate()
+	if samples >= minFulfillmentSamples && rate < pb.minFulfillmentRate {
+		log.Warn("primary prover backend fulfillment rate degraded, failing over to the secondary prover backend", "rate", rate, "samples", samples)
+		pb.useSecondary = true
+		pb.failedOverAt = time.Now()
+	}
+}
@@ -0,0 +1,89 @@
+package proposer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestProverBackendsFailover tests that proofs are requested from the secondary backend once the fulfillment rate of
+// the primary degrades, and that proof IDs resolve to the backend they were requested from.
+func TestProverBackendsFailover(t *testing.T) {
+	pb := newProverBackends(ProposerConfig{
+		OPSuccinctServerUrl:          "http://primary",
+		OPSuccinctSecondaryServerUrl: "http://secondary",
+		FailoverMinFulfillmentRate:   0.5,
+		FailoverCooldown:             time.Hour,
+	})
+	logger := testlog.Logger(t, log.LevelInfo)
+
+	for i := 0; i < minFulfillmentSamples; i++ {
+		pb.primary.recordOutcome(i%3 == 0)
+	}
+	pb.maybeFailover(logger)
+	assert.Equal(t, secondaryBackendName, pb.active().name)
+
+	id := pb.active().namespaceID("0x1234")
+	assert.Equal(t, "secondary:0x1234", id)
+	backend, rawId := pb.resolve(id)
+	assert.Equal(t, pb.secondary, backend)
+	assert.Equal(t, "0x1234", rawId)
+
+	backend, rawId = pb.resolve("0x5678")
+	assert.Equal(t, pb.primary, backend)
+	assert.Equal(t, "0x5678", rawId)
+}
+
+// TestRateLimit tests that the request budget reported by a server is spent by requests, and restored once it resets.
+func TestRateLimit(t *testing.T) {
+	var r rateLimit
+	now := time.Unix(1700000000, 0)
+	assert.Equal(t, -1, r.available(now))
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "1")
+	header.Set("X-RateLimit-Reset", "60")
+	r.observe(header, now)
+	assert.Equal(t, 1, r.available(now))
+	assert.True(t, r.take(now))
+	assert.False(t, r.take(now))
+
+	// Once the budget resets, requests aren't limited until the server reports a new budget.
+	later := now.Add(time.Minute)
+	assert.Equal(t, -1, r.available(later))
+	assert.True(t, r.take(later))
+
+	header.Set("X-RateLimit-Reset", "1700000120")
+	r.observe(header, later)
+	assert.Equal(t, 1, r.available(later))
+}
+
+// TestProbeHealth tests that servers are healthy if their health endpoint succeeds or doesn't exist.
+func TestProbeHealth(t *testing.T) {
+	for _, tc := range []struct {
+		status  int
+		healthy bool
+	}{
+		{http.StatusOK, true},
+		{http.StatusNotFound, true},
+		{http.StatusServiceUnavailable, false},
+	} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/health", r.URL.Path)
+			w.WriteHeader(tc.status)
+		}))
+		pb := newProverBackends(ProposerConfig{OPSuccinctServerUrl: srv.URL})
+		err := pb.probeHealth(context.Background(), pb.primary)
+		assert.Equal(t, tc.healthy, err == nil, "status %d", tc.status)
+		srv.Close()
+	}
+
+	pb := newProverBackends(ProposerConfig{OPSuccinctServerUrl: "http://127.0.0.1:1"})
+	assert.ErrorIs(t, pb.probeHealth(context.Background(), pb.primary), ErrServerUnreachable)
+}
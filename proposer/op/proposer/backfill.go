@@ -0,0 +1,142 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// BackfillRange is a historical L2 block range to prove, independently of the driver loop.
+type BackfillRange struct {
+	Start uint64
+	End   uint64
+	// The number of blocks covered by each agg proof. If 0, only span proofs are queued.
+	AggInterval uint64
+	// Only proof requests added at or after this unix timestamp count towards the backfill. Existing proofs of the
+	// range are reused if 0, and re-proven otherwise (e.g. after a vkey upgrade).
+	Since uint64
+}
+
+// Windows splits the range into the windows covered by each agg proof. If AggInterval is 0, the whole range is a
+// single window.
+func (r BackfillRange) Windows() []Span {
+	if r.AggInterval == 0 {
+		return []Span{{Start: r.Start, End: r.End}}
+	}
+	var windows []Span
+	for start := r.Start; start < r.End; start += r.AggInterval {
+		windows = append(windows, Span{Start: start, End: min(start+r.AggInterval, r.End)})
+	}
+	return windows
+}
+
+// BackfillProgress is the state of the proof requests of a backfill.
+type BackfillProgress struct {
+	// The number of span proof requests with each status.
+	Spans map[proofrequest.Status]int
+	// The number of windows that are covered by completed span proofs.
+	ProvenWindows int
+	// The number of agg proof requests with each status.
+	Aggs    map[proofrequest.Status]int
+	Windows int
+	// The number of proof requests queued by this run.
+	Queued int
+}
+
+// Done returns whether every window of the backfill is proven, and has a completed agg proof if required.
+func (p *BackfillProgress) Done(r BackfillRange) bool {
+	if p.ProvenWindows < p.Windows {
+		return false
+	}
+	return r.AggInterval == 0 || p.Aggs[proofrequest.StatusCOMPLETE] >= p.Windows
+}
+
+// Backfill queues the proof requests for a historical range, and reports the progress of the backfill. Span proofs
+// are created like the driver does, but within each agg window so that the span proofs of a window can be
+// aggregated. The agg proof of a window is queued once all of its span proofs are complete.
+//
+// Backfill is resumable: proof requests that are already queued (and added at or after r.Since) aren't queued again,
+// so it can be run repeatedly until the backfill is done. The running proposer requests the queued proofs.
+func Backfill(ctx context.Context, proofDB *db.ProofDB, cfg ProposerConfig, fetcher L2BlockFetcher, r BackfillRange) (*BackfillProgress, error) {
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Cfg: cfg}}
+	progress := &BackfillProgress{
+		Spans: make(map[proofrequest.Status]int),
+		Aggs:  make(map[proofrequest.Status]int),
+	}
+
+	for _, window := range r.Windows() {
+		progress.Windows++
+
+		spans := l.CreateSpans(window.Start, window.End)
+		if cfg.TargetCyclesPerSpanProof > 0 && fetcher != nil {
+			var err error
+			spans, err = l.CreateDynamicSpans(ctx, fetcher, window.Start, window.End)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create dynamic spans: %w", err)
+			}
+		}
+		// Unlike the driver, the trailing partial span is proven too, as the range won't be extended.
+		if len(spans) == 0 || spans[len(spans)-1].End < window.End {
+			spanStart := window.Start
+			if len(spans) > 0 {
+				spanStart = spans[len(spans)-1].End
+			}
+			spans = append(spans, Span{Start: spanStart, End: window.End})
+		}
+
+		for _, span := range spans {
+			created, err := proofDB.NewBackfillEntry(proofrequest.TypeSPAN, span.Start, span.End, r.Since)
+			if err != nil {
+				return nil, fmt.Errorf("failed to queue span proof %d-%d: %w", span.Start, span.End, err)
+			}
+			progress.Queued += created
+		}
+
+		proven, err := proofDB.IsSpanRangeProven(window.Start, window.End, r.Since)
+		if err != nil {
+			return nil, err
+		}
+		if proven {
+			progress.ProvenWindows++
+		}
+
+		if r.AggInterval == 0 {
+			continue
+		}
+		aggs, err := proofDB.GetBackfillProofs(proofrequest.TypeAGG, window.Start, window.End, r.Since)
+		if err != nil {
+			return nil, err
+		}
+		var active bool
+		for _, agg := range aggs {
+			if agg.StartBlock == window.Start && agg.EndBlock == window.End && agg.Status != proofrequest.StatusFAILED {
+				active = true
+			}
+		}
+		if proven && !active {
+			if _, err := proofDB.NewBackfillEntry(proofrequest.TypeAGG, window.Start, window.End, r.Since); err != nil {
+				return nil, fmt.Errorf("failed to queue agg proof %d-%d: %w", window.Start, window.End, err)
+			}
+			progress.Queued++
+		}
+	}
+
+	spans, err := proofDB.GetBackfillProofs(proofrequest.TypeSPAN, r.Start, r.End, r.Since)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range spans {
+		progress.Spans[p.Status]++
+	}
+	aggs, err := proofDB.GetBackfillProofs(proofrequest.TypeAGG, r.Start, r.End, r.Since)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range aggs {
+		progress.Aggs[p.Status]++
+	}
+
+	return progress, nil
+}
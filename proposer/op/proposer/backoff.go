@@ -0,0 +1,58 @@
+package proposer
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+const (
+	// How long to back off when the server is busy, but didn't say for how long.
+	defaultRetryAfter = 30 * time.Second
+	// The longest back off that is honored, so that a misconfigured server can't stall the proposer indefinitely.
+	maxRetryAfter = 10 * time.Minute
+)
+
+// parseRetryAfter returns how long to wait before the next request, given the Retry-After header of a response. The
+// header holds either a number of seconds or an HTTP date.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	d := defaultRetryAfter
+	if secs, err := strconv.ParseUint(header, 10, 32); err == nil {
+		d = time.Duration(secs) * time.Second
+	} else if at, err := http.ParseTime(header); err == nil {
+		d = at.Sub(now)
+	}
+	return min(max(d, 0), maxRetryAfter)
+}
+
+// backOffRequests stops new proofs from being requested until the given duration has passed.
+func (l *L2OutputSubmitter) backOffRequests(d time.Duration) {
+	until := time.Now().Add(d).UnixNano()
+	for {
+		current := l.requestBackoffUntil.Load()
+		if current >= until || l.requestBackoffUntil.CompareAndSwap(current, until) {
+			return
+		}
+	}
+}
+
+// requestsBackedOff returns whether the server asked the proposer to back off, and the back off hasn't passed yet.
+func (l *L2OutputSubmitter) requestsBackedOff() bool {
+	return time.Now().UnixNano() < l.requestBackoffUntil.Load()
+}
+
+// rescheduleRequests puts proof requests that the server turned away because it was busy back in the queue, and backs
+// off new requests for as long as the server asked. Unlike failed requests, they're requested again as they are,
+// instead of being marked FAILED and replaced.
+func (l *L2OutputSubmitter) rescheduleRequests(reqs []ent.ProofRequest, busy *ServerBusyError) {
+	l.Log.Warn("OP Succinct server is busy, backing off proof requests", "status", busy.StatusCode, "retryAfter", busy.RetryAfter, "count", len(reqs))
+	l.backOffRequests(busy.RetryAfter)
+	for _, p := range reqs {
+		if err := l.db.UpdateProofStatus(p.ID, proofrequest.StatusUNREQ); err != nil {
+			l.Log.Error("failed to reschedule proof request", "err", err, "id", p.ID)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package proposer
+
+import (
+	"fmt"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// backlogged returns whether new span proofs should wait for the backlog of unrequested and requested span proofs to
+// drain. Once the backlog reaches MaxPendingSpanProofs, span proofs are only queued again after it drained to half of
+// it, so that a recovering prover network works through the backlog before the queue grows again.
+func (l *L2OutputSubmitter) backlogged() (bool, error) {
+	if l.config().MaxPendingSpanProofs == 0 {
+		l.spanBackpressure = false
+		l.Metr.RecordSpanBackpressure(false)
+		return false, nil
+	}
+	pending, err := l.db.GetNumberOfRequestsOfTypeWithStatuses(proofrequest.TypeSPAN,
+		proofrequest.StatusUNREQ, proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING)
+	if err != nil {
+		return false, fmt.Errorf("failed to count pending span proofs: %w", err)
+	}
+
+	switch {
+	case !l.spanBackpressure && uint64(pending) >= l.config().MaxPendingSpanProofs:
+		l.spanBackpressure = true
+		l.Log.Warn("span proof backlog is full, not queueing new span proofs until it drains", "pending", pending, "max", l.config().MaxPendingSpanProofs)
+	case l.spanBackpressure && uint64(pending) <= l.config().MaxPendingSpanProofs/2:
+		l.spanBackpressure = false
+		l.Log.Info("span proof backlog drained, queueing new span proofs", "pending", pending)
+	}
+	l.Metr.RecordSpanBackpressure(l.spanBackpressure)
+	return l.spanBackpressure, nil
+}
@@ -0,0 +1,43 @@
+package proposer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// TestSpanBackpressure tests that new span proofs wait once the span proof backlog is full, until it drained to half.
+func TestSpanBackpressure(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Metr: metrics.NoopMetrics}, db: *proofDB}
+	l.Log = testlog.Logger(t, log.LevelInfo)
+	l.Cfg.MaxPendingSpanProofs = 4
+
+	for i := uint64(0); i < 4; i++ {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, i*100, (i+1)*100))
+	}
+	// Agg proofs don't count towards the backlog.
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 0, 400))
+	backlogged, err := l.backlogged()
+	require.NoError(t, err)
+	require.True(t, backlogged)
+
+	// Still backlogged above half of the limit.
+	require.NoError(t, proofDB.UpdateProofStatus(1, proofrequest.StatusCOMPLETE))
+	backlogged, err = l.backlogged()
+	require.NoError(t, err)
+	require.True(t, backlogged)
+
+	require.NoError(t, proofDB.UpdateProofStatus(2, proofrequest.StatusFAILED))
+	backlogged, err = l.backlogged()
+	require.NoError(t, err)
+	require.False(t, backlogged)
+}
@@ -0,0 +1,45 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// safeHeadProvider is implemented by rollup clients whose node keeps a safe head DB (op-node --safedb.path), which
+// records the L2 safe head derived from the batches in each L1 block.
+type safeHeadProvider interface {
+	SafeHeadAtL1Block(ctx context.Context, blockNum uint64) (*eth.SafeHeadResponse, error)
+}
+
+var errSafeHeadDBUnsupported = errors.New("rollup client does not support safe head queries")
+
+// batchGatingEnabled returns whether span proofs are only queued for L2 blocks whose batches are confirmed on L1.
+func (l *L2OutputSubmitter) batchGatingEnabled() bool {
+	return !l.config().FinalizedOnly && (l.config().BatchConfirmations > 0 || l.config().BatchFinalized)
+}
+
+// batchConfirmedL2Head returns the highest L2 block whose batch is included in an L1 block with at least
+// BatchConfirmations confirmations, and that is finalized if BatchFinalized is set. Span proofs for later blocks would
+// be wasted, as the prover can't derive them from L1 yet.
+func (l *L2OutputSubmitter) batchConfirmedL2Head(ctx context.Context, rollupClient dial.RollupClientInterface, status *eth.SyncStatus) (uint64, error) {
+	provider, ok := rollupClient.(safeHeadProvider)
+	if !ok {
+		return 0, errSafeHeadDBUnsupported
+	}
+
+	l1Block := status.HeadL1.Number - min(status.HeadL1.Number, l.config().BatchConfirmations)
+	if l.config().BatchFinalized {
+		l1Block = min(l1Block, status.FinalizedL1.Number)
+	}
+	resp, err := provider.SafeHeadAtL1Block(ctx, l1Block)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get safe head at L1 block %d: %w", l1Block, err)
+	}
+
+	l.Metr.RecordBatcherLag(status.UnsafeL2.Number - min(status.UnsafeL2.Number, resp.SafeHead.Number))
+	return resp.SafeHead.Number, nil
+}
@@ -0,0 +1,67 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// provingBudget limits the proving spend within a rolling window.
+type provingBudget struct {
+	name   string
+	window time.Duration
+	limit  uint64
+}
+
+// provingBudgets returns the configured proving budgets. A zero limit means no budget.
+func (l *L2OutputSubmitter) provingBudgets() []provingBudget {
+	return []provingBudget{
+		{name: "daily", window: 24 * time.Hour, limit: l.config().DailyProvingBudget},
+		{name: "weekly", window: 7 * 24 * time.Hour, limit: l.config().WeeklyProvingBudget},
+	}
+}
+
+// provingBudgetExhausted returns whether the proving spend within any budget window reached the budget.
+func (l *L2OutputSubmitter) provingBudgetExhausted() (bool, error) {
+	now := time.Now()
+	exhausted := false
+	for _, budget := range l.provingBudgets() {
+		if budget.limit == 0 {
+			continue
+		}
+		spent, err := l.db.GetProvingSpendSince(uint64(now.Add(-budget.window).Unix()))
+		if err != nil {
+			return false, err
+		}
+		l.Metr.RecordProvingSpend(budget.name, spent)
+		if spent >= budget.limit {
+			l.Log.Warn("proving budget exhausted, only requesting proofs for the next output", "budget", budget.name, "spent", spent, "limit", budget.limit)
+			exhausted = true
+		}
+	}
+	return exhausted, nil
+}
+
+// nextOutputBlock returns the L2 block of the next output that the L2OO contract requires. Proofs that start before it
+// are needed for the next output submission, so they're requested even if the proving budget is exhausted.
+func (l *L2OutputSubmitter) nextOutputBlock(ctx context.Context) (uint64, error) {
+	next, err := l.l2ooContract.NextBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get next block number: %w", err)
+	}
+	return next.Uint64(), nil
+}
+
+// urgentProofs returns the proof requests that are needed for the next output submission.
+func urgentProofs(reqs []*ent.ProofRequest, nextOutputBlock uint64) []*ent.ProofRequest {
+	urgent := reqs[:0]
+	for _, p := range reqs {
+		if p.StartBlock < nextOutputBlock {
+			urgent = append(urgent, p)
+		}
+	}
+	return urgent
+}
@@ -0,0 +1,202 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/metrics"
+)
+
+// The statuses of a dispute game, as defined by the GameStatus enum of the DisputeGameFactory.
+const (
+	gameStatusInProgress     = 0
+	gameStatusChallengerWins = 1
+	gameStatusDefenderWins   = 2
+)
+
+// challengeLookback is the number of games created before startup that are checked for games of the proposer, so
+// that games that were still in progress when the proposer restarted are monitored.
+const challengeLookback = 100
+
+// watchedGame is a dispute game created by the proposer that's still in progress.
+type watchedGame struct {
+	game    *bindings.FaultDisputeGameCaller
+	l2Block uint64
+	// The number of claims in the game. The root claim is the proposer's, so every further claim is a move by a
+	// challenger or a defense of the root claim against one.
+	claims uint64
+	// Whether the L2 block number of the root claim was challenged.
+	blockChallenged bool
+}
+
+// challenged returns whether anyone disputed the proposer's root claim.
+func (g *watchedGame) challenged() bool {
+	return g.claims > 1 || g.blockChallenged
+}
+
+// challengeMonitor watches the dispute games created by the proposer in fault-proof mode, and raises critical alerts
+// when they're challenged. Games are polled rather than subscribed to, so that it works with an HTTP L1 RPC and doesn't
+// miss events while the RPC connection is down.
+type challengeMonitor struct {
+	factory  *bindings.DisputeGameFactoryCaller
+	l1Client bind.ContractCaller
+	proposer common.Address
+	gameType uint32
+	db       *db.ProofDB
+	log      log.Logger
+	metr     metrics.Metricer
+
+	// The index of the next game on the factory to check, once the first poll looked back.
+	nextIndex *big.Int
+	games     map[common.Address]*watchedGame
+}
+
+func newChallengeMonitor(factoryAddr common.Address, l1Client bind.ContractCaller, proposer common.Address, gameType uint32, proofDB *db.ProofDB, log log.Logger, metr metrics.Metricer) (*challengeMonitor, error) {
+	factory, err := bindings.NewDisputeGameFactoryCaller(factoryAddr, l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DGF at address %s: %w", factoryAddr, err)
+	}
+	return &challengeMonitor{
+		factory:  factory,
+		l1Client: l1Client,
+		proposer: proposer,
+		gameType: gameType,
+		db:       proofDB,
+		log:      log,
+		metr:     metr,
+		games:    make(map[common.Address]*watchedGame),
+	}, nil
+}
+
+// run polls the dispute games every interval until the context is done.
+func (m *challengeMonitor) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.poll(ctx); err != nil && ctx.Err() == nil {
+			m.log.Warn("failed to check dispute games for challenges", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll picks up the games that the proposer created since the last poll, and checks the watched games for challenges.
+func (m *challengeMonitor) poll(ctx context.Context) error {
+	if err := m.addNewGames(ctx); err != nil {
+		return err
+	}
+	for addr, game := range m.games {
+		resolved, err := m.checkGame(ctx, addr, game)
+		if err != nil {
+			return err
+		}
+		if resolved {
+			delete(m.games, addr)
+		}
+	}
+	return nil
+}
+
+func (m *challengeMonitor) addNewGames(ctx context.Context) error {
+	opts := &bind.CallOpts{Context: ctx}
+	count, err := m.factory.GameCount(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get dispute game count: %w", err)
+	}
+	if m.nextIndex == nil {
+		m.nextIndex = new(big.Int).Sub(count, big.NewInt(challengeLookback))
+		if m.nextIndex.Sign() < 0 {
+			m.nextIndex.SetUint64(0)
+		}
+	}
+
+	for ; m.nextIndex.Cmp(count) < 0; m.nextIndex.Add(m.nextIndex, common.Big1) {
+		entry, err := m.factory.GameAtIndex(opts, m.nextIndex)
+		if err != nil {
+			return fmt.Errorf("failed to get dispute game %d: %w", m.nextIndex, err)
+		}
+		if entry.GameType != m.gameType {
+			continue
+		}
+		game, err := bindings.NewFaultDisputeGameCaller(entry.Proxy, m.l1Client)
+		if err != nil {
+			return fmt.Errorf("failed to create dispute game at address %s: %w", entry.Proxy, err)
+		}
+		creator, err := game.GameCreator(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get creator of dispute game %s: %w", entry.Proxy, err)
+		}
+		if creator != m.proposer {
+			continue
+		}
+		l2Block, err := game.L2BlockNumber(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get L2 block of dispute game %s: %w", entry.Proxy, err)
+		}
+		m.games[entry.Proxy] = &watchedGame{game: game, l2Block: l2Block.Uint64(), claims: 1}
+		m.log.Info("watching dispute game for challenges", "game", entry.Proxy, "l2Block", l2Block)
+	}
+	return nil
+}
+
+// checkGame alerts on new claims against a watched game, and on its resolution. Returns whether the game is resolved.
+func (m *challengeMonitor) checkGame(ctx context.Context, addr common.Address, game *watchedGame) (bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	claims, err := game.game.ClaimDataLen(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get claims of dispute game %s: %w", addr, err)
+	}
+	blockChallenged, err := game.game.L2BlockNumberChallenged(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get L2 block challenge of dispute game %s: %w", addr, err)
+	}
+	if claims.Uint64() > game.claims || (blockChallenged && !game.blockChallenged) {
+		m.metr.RecordDisputeGameChallenge("challenged")
+		m.log.Error("CRITICAL: dispute game of the proposer was challenged", "game", addr, "l2Block", game.l2Block, "claims", claims, "l2BlockChallenged", blockChallenged)
+		m.setChallengeStatus(game.l2Block, proofrequest.ChallengeStatusCHALLENGED)
+	}
+	game.claims, game.blockChallenged = claims.Uint64(), blockChallenged
+
+	status, err := game.game.Status(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to get status of dispute game %s: %w", addr, err)
+	}
+	switch status {
+	case gameStatusInProgress:
+		return false, nil
+	case gameStatusChallengerWins:
+		m.metr.RecordDisputeGameChallenge("challenger_wins")
+		m.log.Error("CRITICAL: dispute game of the proposer resolved in favor of the challenger", "game", addr, "l2Block", game.l2Block)
+		m.setChallengeStatus(game.l2Block, proofrequest.ChallengeStatusCHALLENGER_WINS)
+	case gameStatusDefenderWins:
+		if game.challenged() {
+			m.metr.RecordDisputeGameChallenge("defender_wins")
+			m.log.Info("challenged dispute game of the proposer resolved in favor of the proposer", "game", addr, "l2Block", game.l2Block)
+			m.setChallengeStatus(game.l2Block, proofrequest.ChallengeStatusDEFENDER_WINS)
+		}
+	}
+	return true, nil
+}
+
+func (m *challengeMonitor) setChallengeStatus(l2Block uint64, status proofrequest.ChallengeStatus) {
+	n, err := m.db.SetChallengeStatus(l2Block, status)
+	if err != nil {
+		m.log.Error("failed to record challenge status", "err", err, "l2Block", l2Block, "status", status)
+		return
+	}
+	if n == 0 {
+		m.log.Warn("no agg proof found for challenged dispute game", "l2Block", l2Block, "status", status)
+	}
+}
@@ -0,0 +1,828 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+var (
+	startBlockFlag = &cli.Uint64Flag{
+		Name:     "start",
+		Usage:    "The L2 block number to start at",
+		Required: true,
+	}
+	endBlockFlag = &cli.Uint64Flag{
+		Name:     "end",
+		Usage:    "The L2 block number to end at",
+		Required: true,
+	}
+	aggIntervalFlag = &cli.Uint64Flag{
+		Name:  "agg-interval",
+		Usage: "The number of blocks covered by each agg proof of the backfill. If 0, only span proofs are queued",
+	}
+	sinceFlag = &cli.Uint64Flag{
+		Name:  "since",
+		Usage: "Only count proof requests added at or after this unix timestamp towards the backfill. Pass the timestamp printed by a --reprove run to resume it",
+	}
+	reproveFlag = &cli.BoolFlag{
+		Name:  "reprove",
+		Usage: "Prove the range again even if it was proven before (e.g. after a vkey upgrade)",
+	}
+	watchFlag = &cli.BoolFlag{
+		Name:  "watch",
+		Usage: "Keep running and report the progress of the backfill until it is done",
+	}
+	pollIntervalFlag = &cli.DurationFlag{
+		Name:  "poll-interval",
+		Usage: "How often to report the progress of the backfill with --watch",
+		Value: time.Minute,
+	}
+	proofIDsFlag = &cli.IntSliceFlag{
+		Name:  "id",
+		Usage: "The ID of a FAILED proof request in the DB to re-queue. Can be given multiple times",
+	}
+	retryStartFlag = &cli.Uint64Flag{
+		Name:  "start",
+		Usage: "Re-queue the FAILED proof requests that start at or after this L2 block",
+	}
+	retryEndFlag = &cli.Uint64Flag{
+		Name:  "end",
+		Usage: "Re-queue the FAILED proof requests that end at or before this L2 block",
+	}
+	splitFlag = &cli.Uint64Flag{
+		Name:  "split",
+		Usage: "Split each re-queued span proof into this many span proofs of equal size",
+		Value: 1,
+	}
+	billingFlag = &cli.PathFlag{
+		Name:     "billing",
+		Usage:    "Path to the billing export of the prover network, a CSV with the columns request_id, fee, and optionally cycles and prover",
+		Required: true,
+	}
+	reconcileSinceFlag = &cli.Uint64Flag{
+		Name:  "since",
+		Usage: "Only reconcile the proofs requested at or after this unix timestamp",
+	}
+	reconcileUntilFlag = &cli.Uint64Flag{
+		Name:  "until",
+		Usage: "Only reconcile the proofs requested before this unix timestamp. Defaults to now",
+	}
+	lineageIDFlag = &cli.IntFlag{
+		Name:     "id",
+		Usage:    "The ID of a proof request in the DB",
+		Required: true,
+	}
+	fixtureIDFlag = &cli.IntFlag{
+		Name:     "id",
+		Usage:    "The ID of a completed agg proof request in the DB",
+		Required: true,
+	}
+	exportIDFlag = &cli.IntFlag{
+		Name:     "id",
+		Usage:    "The ID of a completed proof request in the DB",
+		Required: true,
+	}
+	exportOutFlag = &cli.PathFlag{
+		Name:  "out",
+		Usage: "Path to write the exported proof to. Defaults to stdout",
+	}
+	locateBlockFlag = &cli.Uint64Flag{
+		Name:     "block",
+		Usage:    "The L2 block number to locate the batch of",
+		Required: true,
+	}
+	autoBatchSenderFlag = &cli.BoolFlag{
+		Name:  "auto-batch-sender",
+		Usage: "Derive the batch senders from the batcher updates of the SystemConfig contract, instead of using the genesis batcher",
+	}
+	l1BlockTimeFlag = &cli.Uint64Flag{
+		Name:  "l1-block-time",
+		Usage: "L1 block time in seconds, used if the block time measured from the L1 headers is implausible",
+		Value: 12,
+	}
+	fixtureOutFlag = &cli.PathFlag{
+		Name:     "out",
+		Usage:    "Path to write the fixture JSON to",
+		Required: true,
+	}
+)
+
+// The flags used to locate the proof DB. The rollup RPC is used to look up the L2 chain ID.
+var dbFlags = []cli.Flag{flags.RollupRpcFlag, flags.DbPathFlag, flags.ProofEncryptionKeyFileFlag}
+
+func subcommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:   "propose",
+			Usage:  "Run the proposer (the default when no command is given)",
+			Flags:  cliapp.ProtectFlags(flags.Flags),
+			Action: cliapp.LifecycleCmd(proposer.Main(Version)),
+		},
+		{
+			Name:   "decode",
+			Usage:  "Print the span batch ranges that cover an L2 block range",
+			Flags:  cliapp.ProtectFlags([]cli.Flag{flags.L1EthRpcFlag, flags.RollupRpcFlag, flags.BeaconRpcFlag, startBlockFlag, endBlockFlag, autoBatchSenderFlag, l1BlockTimeFlag}),
+			Action: decodeAction,
+		},
+		{
+			Name:   "locate",
+			Usage:  "Print the channel, frames and L1 batcher transactions that carried the batch of an L2 block",
+			Flags:  cliapp.ProtectFlags([]cli.Flag{flags.L1EthRpcFlag, flags.RollupRpcFlag, flags.BeaconRpcFlag, locateBlockFlag, autoBatchSenderFlag, l1BlockTimeFlag}),
+			Action: locateAction,
+		},
+		{
+			Name:  "plan",
+			Usage: "Print the span proofs the proposer would request for an L2 block range, without touching the DB or server",
+			Flags: cliapp.ProtectFlags([]cli.Flag{
+				flags.RollupRpcFlag, flags.L2EthRpcFlag, flags.L1EthRpcFlag, flags.L2OOAddressFlag,
+				flags.MaxBlockRangePerSpanProofFlag, flags.TargetCyclesPerSpanProofFlag, startBlockFlag, endBlockFlag,
+			}),
+			Action: planAction,
+		},
+		{
+			Name:  "backfill",
+			Usage: "Queue span and agg proofs for a historical L2 block range, for the running proposer to request",
+			Flags: cliapp.ProtectFlags(append([]cli.Flag{
+				flags.L2EthRpcFlag, flags.MaxBlockRangePerSpanProofFlag, flags.TargetCyclesPerSpanProofFlag, startBlockFlag,
+				endBlockFlag, aggIntervalFlag, sinceFlag, reproveFlag, watchFlag, pollIntervalFlag,
+			}, dbFlags...)),
+			Action: backfillAction,
+		},
+		{
+			Name:   "status",
+			Usage:  "Print the proof queue, the proven height and the next L2OO output window",
+			Flags:  cliapp.ProtectFlags(append([]cli.Flag{flags.L1EthRpcFlag, flags.L2OOAddressFlag}, dbFlags...)),
+			Action: statusAction,
+		},
+		{
+			Name:   "retry",
+			Usage:  "Re-queue FAILED proof requests, by ID or by L2 block range",
+			Flags:  cliapp.ProtectFlags(append([]cli.Flag{proofIDsFlag, retryStartFlag, retryEndFlag, splitFlag}, dbFlags...)),
+			Action: retryAction,
+		},
+		{
+			Name:   "lineage",
+			Usage:  "Print the retry tree of a proof request: the originally queued request, and the requests that replaced it",
+			Flags:  cliapp.ProtectFlags(append([]cli.Flag{lineageIDFlag}, dbFlags...)),
+			Action: lineageAction,
+		},
+		{
+			Name:   "reconcile",
+			Usage:  "Compare the cycles, fees and provers recorded for fulfilled proofs against the prover network's billing",
+			Flags:  cliapp.ProtectFlags(append([]cli.Flag{billingFlag, reconcileSinceFlag, reconcileUntilFlag}, dbFlags...)),
+			Action: reconcileAction,
+		},
+		{
+			Name:   "export-proof",
+			Usage:  "Export a completed proof with its public values, vkey hash and range in the canonical proof export format",
+			Flags:  cliapp.ProtectFlags(append([]cli.Flag{exportIDFlag, exportOutFlag}, dbFlags...)),
+			Action: exportProofAction,
+		},
+		{
+			Name:   "fixture",
+			Usage:  "Export a completed agg proof, its public values and checkpointed L1 block as a Foundry test fixture",
+			Flags:  cliapp.ProtectFlags(append([]cli.Flag{fixtureIDFlag, fixtureOutFlag}, dbFlags...)),
+			Action: fixtureAction,
+		},
+		{
+			Name:   "version",
+			Usage:  "Print the build info, and the program info of the L2OO contract and OP Succinct server if given",
+			Flags:  cliapp.ProtectFlags([]cli.Flag{flags.L1EthRpcFlag, flags.L2OOAddressFlag, flags.OPSuccinctServerUrlFlag}),
+			Action: versionAction,
+		},
+		{
+			Name:   "migrate",
+			Usage:  "Create or upgrade the proof DB schema",
+			Flags:  cliapp.ProtectFlags(dbFlags),
+			Action: migrateAction,
+		},
+	}
+}
+
+// Open the proof DB of the chain served by the rollup RPC.
+func openProofDB(cliCtx *cli.Context) (*db.ProofDB, error) {
+	rollupClient, err := dial.DialRollupClientWithTimeout(cliCtx.Context, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(cliCtx.Context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rollup config: %w", err)
+	}
+
+	opts := db.DefaultOptions()
+	if path := cliCtx.String(flags.ProofEncryptionKeyFileFlag.Name); path != "" {
+		if opts.EncryptionKey, err = db.LoadEncryptionKey(path); err != nil {
+			return nil, err
+		}
+	}
+	dbPath := proposer.DBPath(cliCtx.String(flags.DbPathFlag.Name), rollupCfg.L2ChainID.Uint64())
+	proofDB, err := db.InitDBWithOptions(dbPath, true, opts)
+	if err != nil {
+		return nil, err
+	}
+	if n := proofDB.DedupedRequests(); n > 0 {
+		fmt.Fprintf(os.Stderr, "Failed %d duplicate active proof requests\n", n)
+	}
+	return proofDB, nil
+}
+
+func decodeAction(cliCtx *cli.Context) error {
+	config, err := newBatchDecoderConfig(cliCtx)
+	if err != nil {
+		return err
+	}
+	config.L2StartBlock = cliCtx.Uint64(startBlockFlag.Name)
+	config.L2EndBlock = cliCtx.Uint64(endBlockFlag.Name)
+
+	ranges, err := utils.GetAllSpanBatchesInL2BlockRange(config)
+	if err != nil {
+		return fmt.Errorf("failed to get span batch ranges: %w", err)
+	}
+	for _, r := range ranges {
+		fmt.Printf("%d-%d\n", r.Start, r.End)
+	}
+	return nil
+}
+
+func locateAction(cliCtx *cli.Context) error {
+	config, err := newBatchDecoderConfig(cliCtx)
+	if err != nil {
+		return err
+	}
+	block := cliCtx.Uint64(locateBlockFlag.Name)
+	loc, reports, err := utils.LocateL2Block(cliCtx.Context, config, block)
+	if err != nil {
+		// The decode errors of the channels in the L1 search range usually explain why the batch wasn't found.
+		for _, report := range reports {
+			fmt.Fprintln(os.Stderr, report)
+		}
+		return fmt.Errorf("failed to locate the batch of block %d: %w", block, err)
+	}
+	out, err := json.MarshalIndent(loc, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// newBatchDecoderConfig returns the batch decoder config of the chain of the rollup node, without an L2 block range.
+func newBatchDecoderConfig(cliCtx *cli.Context) (utils.BatchDecoderConfig, error) {
+	rollupClient, err := dial.DialRollupClientWithTimeout(cliCtx.Context, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return utils.BatchDecoderConfig{}, fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(cliCtx.Context)
+	if err != nil {
+		return utils.BatchDecoderConfig{}, fmt.Errorf("failed to get rollup config: %w", err)
+	}
+	l1Client, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return utils.BatchDecoderConfig{}, fmt.Errorf("failed to dial L1 client: %w", err)
+	}
+	l1BeaconClient, err := utils.SetupBeacon(cliCtx.Context, cliCtx.String(flags.BeaconRpcFlag.Name), utils.DefaultRPCTimeout)
+	if err != nil {
+		return utils.BatchDecoderConfig{}, fmt.Errorf("failed to set up beacon client: %w", err)
+	}
+
+	return utils.BatchDecoderConfig{
+		L2GenesisTime:     rollupCfg.Genesis.L2Time,
+		L2GenesisBlock:    rollupCfg.Genesis.L2.Number,
+		L2BlockTime:       rollupCfg.BlockTime,
+		BatchInboxAddress: rollupCfg.BatchInboxAddress,
+		L2ChainID:         new(big.Int).Set(rollupCfg.L2ChainID),
+		L2Node:            rollupClient,
+		L1RPC:             *l1Client,
+		L1Beacon:          l1BeaconClient,
+		BatchSender:       rollupCfg.Genesis.SystemConfig.BatcherAddr,
+		DataDir:           fmt.Sprintf("/tmp/batch_decoder/%d/transactions_cache", rollupCfg.L2ChainID),
+		AutoBatchSender:   cliCtx.Bool(autoBatchSenderFlag.Name),
+		L1BlockTime:       cliCtx.Uint64(l1BlockTimeFlag.Name),
+	}, nil
+}
+
+func planAction(cliCtx *cli.Context) error {
+	rollupClient, err := dial.DialRollupClientWithTimeout(cliCtx.Context, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+
+	var fetcher proposer.L2BlockFetcher
+	if cliCtx.IsSet(flags.L2EthRpcFlag.Name) {
+		l2Client, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(flags.L2EthRpcFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L2 client: %w", err)
+		}
+		fetcher = l2Client
+	}
+
+	// The agg proof windows start at the latest block proposed to the L2OO, and are SUBMISSION_INTERVAL blocks long.
+	var aggStart, submissionInterval uint64
+	if l2ooAddress := cliCtx.String(flags.L2OOAddressFlag.Name); l2ooAddress != "" && cliCtx.IsSet(flags.L1EthRpcFlag.Name) {
+		l1Client, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(flags.L1EthRpcFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L1 client: %w", err)
+		}
+		l2oo, err := bindings.NewOPSuccinctL2OutputOracleCaller(common.HexToAddress(l2ooAddress), l1Client)
+		if err != nil {
+			return fmt.Errorf("failed to bind L2OO contract: %w", err)
+		}
+		opts := &bind.CallOpts{Context: cliCtx.Context}
+		latest, err := l2oo.LatestBlockNumber(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get latest L2OO block number: %w", err)
+		}
+		interval, err := l2oo.SUBMISSIONINTERVAL(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get L2OO submission interval: %w", err)
+		}
+		aggStart, submissionInterval = latest.Uint64(), interval.Uint64()
+	}
+
+	cfg := proposer.ProposerConfig{
+		MaxBlockRangePerSpanProof: cliCtx.Uint64(flags.MaxBlockRangePerSpanProofFlag.Name),
+		TargetCyclesPerSpanProof:  cliCtx.Uint64(flags.TargetCyclesPerSpanProofFlag.Name),
+	}
+	start, end := cliCtx.Uint64(startBlockFlag.Name), cliCtx.Uint64(endBlockFlag.Name)
+	if end <= start {
+		return fmt.Errorf("end block %d must be greater than start block %d", end, start)
+	}
+	plan, err := proposer.PlanProofs(cliCtx.Context, cfg, rollupClient, fetcher, start, end, aggStart, submissionInterval)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%-23s %-7s %-15s %-23s %s\n", "SPAN", "BLOCKS", "EST. CYCLES", "L1 ORIGINS", "AGG WINDOW")
+	var totalCycles uint64
+	for _, sp := range plan.Spans {
+		cycles, window := "-", "-"
+		if fetcher != nil {
+			cycles = fmt.Sprint(sp.EstimatedCycles)
+			totalCycles += sp.EstimatedCycles
+		}
+		if sp.AggWindow >= 0 {
+			w := plan.AggWindows[sp.AggWindow]
+			window = fmt.Sprintf("%d (%d-%d)", sp.AggWindow, w.Start, w.End)
+		}
+		fmt.Printf("%-23s %-7d %-15s %-23s %s\n",
+			fmt.Sprintf("%d-%d", sp.Start, sp.End), sp.End-sp.Start, cycles,
+			fmt.Sprintf("%d-%d", sp.L1OriginStart, sp.L1OriginEnd), window)
+	}
+
+	fmt.Printf("\n%d span proofs", len(plan.Spans))
+	if fetcher != nil {
+		fmt.Printf(", %d estimated cycles", totalCycles)
+	}
+	fmt.Println()
+	if len(plan.Spans) > 0 {
+		fmt.Printf("L1 blocks %d-%d cover the batches for the range\n", plan.Spans[0].L1OriginStart, plan.Spans[len(plan.Spans)-1].L1OriginEnd)
+	}
+	for i, w := range plan.AggWindows {
+		fmt.Printf("Agg window %d (%d-%d): %d span proofs\n", i, w.Start, w.End, w.Spans)
+	}
+	if plan.Remainder != nil {
+		fmt.Printf("Blocks %d-%d don't fill a span, and would wait for more blocks\n", plan.Remainder.Start, plan.Remainder.End)
+	}
+	return nil
+}
+
+func backfillAction(cliCtx *cli.Context) error {
+	r := proposer.BackfillRange{
+		Start:       cliCtx.Uint64(startBlockFlag.Name),
+		End:         cliCtx.Uint64(endBlockFlag.Name),
+		AggInterval: cliCtx.Uint64(aggIntervalFlag.Name),
+		Since:       cliCtx.Uint64(sinceFlag.Name),
+	}
+	if r.End <= r.Start {
+		return fmt.Errorf("end block %d must be greater than start block %d", r.End, r.Start)
+	}
+	if cliCtx.Bool(reproveFlag.Name) {
+		if cliCtx.IsSet(sinceFlag.Name) {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", reproveFlag.Name, sinceFlag.Name)
+		}
+		r.Since = uint64(time.Now().Unix())
+		fmt.Printf("Re-proving blocks %d-%d, resume with --%s=%d\n", r.Start, r.End, sinceFlag.Name, r.Since)
+	}
+
+	var fetcher proposer.L2BlockFetcher
+	if cliCtx.IsSet(flags.L2EthRpcFlag.Name) {
+		l2Client, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(flags.L2EthRpcFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L2 client: %w", err)
+		}
+		fetcher = l2Client
+	}
+
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	cfg := proposer.ProposerConfig{
+		MaxBlockRangePerSpanProof: cliCtx.Uint64(flags.MaxBlockRangePerSpanProofFlag.Name),
+		TargetCyclesPerSpanProof:  cliCtx.Uint64(flags.TargetCyclesPerSpanProofFlag.Name),
+	}
+	for {
+		progress, err := proposer.Backfill(cliCtx.Context, proofDB, cfg, fetcher, r)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s queued=%d spans=[%s] proven-windows=%d/%d",
+			time.Now().Format(time.DateTime), progress.Queued, formatStatusCounts(progress.Spans), progress.ProvenWindows, progress.Windows)
+		if r.AggInterval > 0 {
+			fmt.Printf(" aggs=[%s]", formatStatusCounts(progress.Aggs))
+		}
+		fmt.Println()
+
+		if progress.Done(r) {
+			fmt.Println("Backfill complete")
+			return nil
+		}
+		if !cliCtx.Bool(watchFlag.Name) {
+			return nil
+		}
+		select {
+		case <-cliCtx.Context.Done():
+			return cliCtx.Context.Err()
+		case <-time.After(cliCtx.Duration(pollIntervalFlag.Name)):
+		}
+	}
+}
+
+// Format the number of proof requests with each status, in the order of the proof lifecycle.
+func formatStatusCounts(counts map[proofrequest.Status]int) string {
+	var parts []string
+	for _, status := range proofStatuses {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%s:%d", status, counts[status]))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// The proof request statuses, in the order of the proof lifecycle.
+var proofStatuses = []proofrequest.Status{
+	proofrequest.StatusUNREQ,
+	proofrequest.StatusWITNESSGEN,
+	proofrequest.StatusPROVING,
+	proofrequest.StatusCOMPLETE,
+	proofrequest.StatusFAILED,
+}
+
+func statusAction(cliCtx *cli.Context) error {
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	for _, status := range proofStatuses {
+		count, err := proofDB.GetNumberOfRequestsWithStatuses(status)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%-10s %d\n", status, count)
+	}
+	fmt.Println()
+
+	oldest, err := proofDB.GetOldestPendingProof()
+	if err != nil {
+		return err
+	}
+	if oldest != nil {
+		added := time.Unix(int64(oldest.RequestAddedTime), 0)
+		fmt.Printf("Oldest pending:   %s proof request %d for blocks %d-%d, %s for %s\n",
+			oldest.Type, oldest.ID, oldest.StartBlock, oldest.EndBlock, oldest.Status, time.Since(added).Truncate(time.Second))
+	} else {
+		fmt.Println("Oldest pending:   none")
+	}
+
+	rollupClient, err := dial.DialRollupClientWithTimeout(cliCtx.Context, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+	syncStatus, err := rollupClient.SyncStatus(cliCtx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+	fmt.Printf("L2 head:          unsafe %d, safe %d, finalized %d\n", syncStatus.UnsafeL2.Number, syncStatus.SafeL2.Number, syncStatus.FinalizedL2.Number)
+
+	l2ooAddress := cliCtx.String(flags.L2OOAddressFlag.Name)
+	if l2ooAddress == "" || !cliCtx.IsSet(flags.L1EthRpcFlag.Name) {
+		return nil
+	}
+	l1Client, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(flags.L1EthRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial L1 client: %w", err)
+	}
+	l2oo, err := bindings.NewOPSuccinctL2OutputOracleCaller(common.HexToAddress(l2ooAddress), l1Client)
+	if err != nil {
+		return fmt.Errorf("failed to bind L2OO contract: %w", err)
+	}
+	opts := &bind.CallOpts{Context: cliCtx.Context}
+	latest, err := l2oo.LatestBlockNumber(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get latest L2OO block number: %w", err)
+	}
+	next, err := l2oo.NextBlockNumber(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get next L2OO block number: %w", err)
+	}
+	// The completed span proofs past the latest output are the blocks ready to be aggregated into the next output.
+	proven, err := proofDB.GetMaxContiguousSpanProofRange(latest.Uint64())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Proposed height:  %d (%d blocks behind the safe head)\n", latest.Uint64(), blocksBehind(syncStatus.SafeL2.Number, latest.Uint64()))
+	fmt.Printf("Proven height:    %d (%d blocks behind the safe head)\n", proven, blocksBehind(syncStatus.SafeL2.Number, proven))
+	fmt.Printf("Next output:      blocks %d-%d or later, %d blocks proven\n", latest.Uint64(), next.Uint64(), proven-latest.Uint64())
+	return nil
+}
+
+// The number of blocks between a block and the head, or 0 if the block is at or past the head.
+func blocksBehind(head, block uint64) uint64 {
+	if block >= head {
+		return 0
+	}
+	return head - block
+}
+
+func retryAction(cliCtx *cli.Context) error {
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	var reqs []*ent.ProofRequest
+	for _, id := range cliCtx.IntSlice(proofIDsFlag.Name) {
+		p, err := proofDB.GetProofRequest(id)
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, p)
+	}
+	if cliCtx.IsSet(retryStartFlag.Name) || cliCtx.IsSet(retryEndFlag.Name) {
+		start, end := cliCtx.Uint64(retryStartFlag.Name), cliCtx.Uint64(retryEndFlag.Name)
+		if !cliCtx.IsSet(retryEndFlag.Name) {
+			end = math.MaxUint64
+		}
+		failed, err := proofDB.GetAllProofsWithStatus(proofrequest.StatusFAILED)
+		if err != nil {
+			return err
+		}
+		for _, p := range failed {
+			if p.StartBlock >= start && p.EndBlock <= end {
+				reqs = append(reqs, p)
+			}
+		}
+	}
+	if len(reqs) == 0 {
+		return fmt.Errorf("no proof requests to re-queue, pass --%s or --%s/--%s", proofIDsFlag.Name, retryStartFlag.Name, retryEndFlag.Name)
+	}
+
+	// Re-queue in block order, so that earlier blocks are proven first.
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].StartBlock < reqs[j].StartBlock })
+	split := cliCtx.Uint64(splitFlag.Name)
+	for _, p := range reqs {
+		queued, err := proposer.Requeue(proofDB, p, split)
+		if err != nil {
+			return err
+		}
+		if queued == 0 {
+			fmt.Printf("Did not re-queue %s proof request %d for blocks %d-%d, as its blocks are already queued\n", p.Type, p.ID, p.StartBlock, p.EndBlock)
+			continue
+		}
+		fmt.Printf("Re-queued %s proof request %d for blocks %d-%d as %d proof requests\n", p.Type, p.ID, p.StartBlock, p.EndBlock, queued)
+	}
+	return nil
+}
+
+func lineageAction(cliCtx *cli.Context) error {
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	tree, err := proofDB.GetRetryTree(cliCtx.Int(lineageIDFlag.Name))
+	if err != nil {
+		return err
+	}
+	children := make(map[int][]*ent.ProofRequest)
+	for _, p := range tree[1:] {
+		children[p.ParentID] = append(children[p.ParentID], p)
+	}
+	var printTree func(p *ent.ProofRequest, depth int)
+	printTree = func(p *ent.ProofRequest, depth int) {
+		fmt.Printf("%s%s proof request %d for blocks %d-%d, %s", strings.Repeat("  ", depth), p.Type, p.ID, p.StartBlock, p.EndBlock, p.Status)
+		if p.FailureReason != "" {
+			fmt.Printf(": %s", p.FailureReason)
+		}
+		fmt.Println()
+		for _, child := range children[p.ID] {
+			printTree(child, depth+1)
+		}
+	}
+	printTree(tree[0], 0)
+	return nil
+}
+
+func exportProofAction(cliCtx *cli.Context) error {
+	rollupClient, err := dial.DialRollupClientWithTimeout(cliCtx.Context, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(cliCtx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup config: %w", err)
+	}
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	id := cliCtx.Int(exportIDFlag.Name)
+	p, err := proofDB.GetProofRequest(id)
+	if err != nil {
+		return err
+	}
+	proof, err := proofDB.GetSpanProof(id)
+	if err != nil {
+		return err
+	}
+	export, err := proposer.ExportProof(p, proof, rollupCfg.L2ChainID.Uint64())
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	path := cliCtx.Path(exportOutFlag.Name)
+	if path == "" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("failed to write exported proof: %w", err)
+	}
+	return nil
+}
+
+func fixtureAction(cliCtx *cli.Context) error {
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	id := cliCtx.Int(fixtureIDFlag.Name)
+	aggProof, err := proofDB.GetProofRequest(id)
+	if err != nil {
+		return err
+	}
+	proof, err := proofDB.GetSpanProof(id)
+	if err != nil {
+		return err
+	}
+	rollupClient, err := dial.DialRollupClientWithTimeout(cliCtx.Context, dial.DefaultDialTimeout, nil, cliCtx.String(flags.RollupRpcFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to dial rollup client: %w", err)
+	}
+	output, err := rollupClient.OutputAtBlock(cliCtx.Context, aggProof.EndBlock)
+	if err != nil {
+		return fmt.Errorf("failed to get output at block %d: %w", aggProof.EndBlock, err)
+	}
+
+	fixture, err := proposer.NewProofFixture(aggProof, proof, output)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(cliCtx.Path(fixtureOutFlag.Name), append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	fmt.Printf("Wrote fixture of agg proof %d for L2 blocks %d-%d to %s\n", id, aggProof.StartBlock, aggProof.EndBlock, cliCtx.Path(fixtureOutFlag.Name))
+	return nil
+}
+
+func reconcileAction(cliCtx *cli.Context) error {
+	f, err := os.Open(cliCtx.Path(billingFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to open billing export: %w", err)
+	}
+	defer f.Close()
+	billing, err := proposer.ReadBillingCSV(f)
+	if err != nil {
+		return err
+	}
+
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer proofDB.CloseDB()
+
+	until := uint64(time.Now().Unix())
+	if cliCtx.IsSet(reconcileUntilFlag.Name) {
+		until = cliCtx.Uint64(reconcileUntilFlag.Name)
+	}
+	local, err := proofDB.GetRequestedProofsBetween(cliCtx.Uint64(reconcileSinceFlag.Name), until)
+	if err != nil {
+		return err
+	}
+	report := proposer.Reconcile(local, billing)
+
+	fmt.Printf("Matched:               %d\n", len(report.Matched))
+	fmt.Printf("Mismatched:            %d\n", len(report.Mismatched))
+	fmt.Printf("Billed, not fulfilled: %d\n", len(report.Unfulfilled))
+	fmt.Printf("Billed, unknown:       %d\n", len(report.Unknown))
+	fmt.Printf("Fulfilled, not billed: %d\n", len(report.Unbilled))
+	fmt.Printf("Fees:                  %d recorded, %d billed\n", report.LocalFee, report.BilledFee)
+
+	for _, r := range report.Mismatched {
+		fmt.Printf("\nMismatched %s proof request %d (%s) for blocks %d-%d:\n", r.Local.Type, r.Local.ID, r.Billed.RequestID, r.Local.StartBlock, r.Local.EndBlock)
+		fmt.Printf("  recorded: cycles %d, fee %d, prover %s\n", r.Local.FulfilledCycles, r.Local.FulfilledFee, r.Local.Prover)
+		fmt.Printf("  billed:   cycles %d, fee %d, prover %s\n", r.Billed.Cycles, r.Billed.Fee, r.Billed.Prover)
+	}
+	for _, r := range report.Unfulfilled {
+		fmt.Printf("\nBilled %s proof request %d (%s) for blocks %d-%d is %s: fee %d\n", r.Local.Type, r.Local.ID, r.Billed.RequestID, r.Local.StartBlock, r.Local.EndBlock, r.Local.Status, r.Billed.Fee)
+	}
+	for _, r := range report.Unknown {
+		fmt.Printf("\nBilled request %s isn't in the DB: fee %d\n", r.RequestID, r.Fee)
+	}
+	for _, p := range report.Unbilled {
+		fmt.Printf("\nFulfilled %s proof request %d (%s) for blocks %d-%d wasn't billed: fee %d\n", p.Type, p.ID, p.ProverRequestID, p.StartBlock, p.EndBlock, p.FulfilledFee)
+	}
+	return nil
+}
+
+func versionAction(cliCtx *cli.Context) error {
+	var contract proposer.VersionContract
+	if l2ooAddress := cliCtx.String(flags.L2OOAddressFlag.Name); l2ooAddress != "" && cliCtx.IsSet(flags.L1EthRpcFlag.Name) {
+		l1Client, err := ethclient.DialContext(cliCtx.Context, cliCtx.String(flags.L1EthRpcFlag.Name))
+		if err != nil {
+			return fmt.Errorf("failed to dial L1 client: %w", err)
+		}
+		contract, err = bindings.NewOPSuccinctL2OutputOracleCaller(common.HexToAddress(l2ooAddress), l1Client)
+		if err != nil {
+			return fmt.Errorf("failed to bind L2OO contract: %w", err)
+		}
+	}
+	serverUrl := ""
+	if cliCtx.IsSet(flags.OPSuccinctServerUrlFlag.Name) {
+		serverUrl = cliCtx.String(flags.OPSuccinctServerUrlFlag.Name)
+	}
+
+	info, err := proposer.NewVersionInfo(cliCtx.Context, Version, contract, serverUrl)
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func migrateAction(cliCtx *cli.Context) error {
+	// Opening the DB creates any missing tables and columns.
+	proofDB, err := openProofDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Proof DB schema is up to date")
+	return proofDB.CloseDB()
+}
@@ -23,6 +23,8 @@ var (
 
 func main() {
 	oplog.SetupDefaults()
+	proposer.GitCommit = GitCommit
+	proposer.GitDate = GitDate
 
 	app := cli.NewApp()
 	app.Flags = cliapp.ProtectFlags(flags.Flags)
@@ -31,12 +33,10 @@ func main() {
 	app.Usage = "L2 Output Submitter"
 	app.Description = "Service for generating and proposing L2 Outputs"
 	app.Action = cliapp.LifecycleCmd(proposer.Main(Version))
-	app.Commands = []*cli.Command{
-		{
-			Name:        "doc",
-			Subcommands: doc.NewSubcommands(metrics.NewMetrics("default")),
-		},
-	}
+	app.Commands = append(subcommands(), &cli.Command{
+		Name:        "doc",
+		Subcommands: doc.NewSubcommands(metrics.NewMetrics("default")),
+	})
 
 	err := app.Run(os.Args)
 	if err != nil {
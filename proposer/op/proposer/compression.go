@@ -0,0 +1,112 @@
+package proposer
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Encodings for the bodies of requests to the OP Succinct server.
+const (
+	ServerCompressionNone = "none"
+	ServerCompressionGzip = "gzip"
+	ServerCompressionZstd = "zstd"
+)
+
+// compressingTransport compresses request bodies with the configured encoding, and asks the server for compressed
+// responses, which it decompresses transparently.
+type compressingTransport struct {
+	base     http.RoundTripper
+	encoding string
+}
+
+func (t *compressingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = compressBody(req.Body, t.encoding)
+		// The compressed size isn't known up front, so the body is sent chunked.
+		req.ContentLength = -1
+		req.GetBody = nil
+		req.Header.Set("Content-Encoding", t.encoding)
+	}
+	req.Header.Set("Accept-Encoding", "zstd, gzip")
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := decompressResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// compressBody returns a reader of the body compressed with the encoding. The body is compressed as it's read, so
+// it's never held in memory.
+func compressBody(body io.ReadCloser, encoding string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer body.Close()
+		var w io.WriteCloser
+		if encoding == ServerCompressionZstd {
+			// The options are valid, so creating the encoder can't fail.
+			w, _ = zstd.NewWriter(pw)
+		} else {
+			w = gzip.NewWriter(pw)
+		}
+		_, err := io.Copy(w, body)
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// decompressResponse replaces the body of a compressed response with its decompressed body.
+func decompressResponse(resp *http.Response) error {
+	var body io.ReadCloser
+	switch encoding := resp.Header.Get("Content-Encoding"); encoding {
+	case "":
+		return nil
+	case "gzip":
+		r, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to decompress gzip response: %w", err)
+		}
+		body = &decompressedBody{Reader: r, close: r.Close, body: resp.Body}
+	case "zstd":
+		r, err := zstd.NewReader(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to decompress zstd response: %w", err)
+		}
+		body = &decompressedBody{Reader: r, close: func() error { r.Close(); return nil }, body: resp.Body}
+	default:
+		return fmt.Errorf("unsupported response content encoding: %s", encoding)
+	}
+	resp.Body = body
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// decompressedBody reads the decompressed body of a response, and closes both the decompressor and the response body.
+type decompressedBody struct {
+	io.Reader
+	close func() error
+	body  io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	err := b.close()
+	if bodyErr := b.body.Close(); err == nil {
+		err = bodyErr
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+package proposer
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCompressingTransport tests that request bodies are compressed with the configured encoding, and that compressed
+// responses are decompressed.
+func TestCompressingTransport(t *testing.T) {
+	for _, encoding := range []string{ServerCompressionGzip, ServerCompressionZstd} {
+		t.Run(encoding, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, encoding, r.Header.Get("Content-Encoding"))
+				var body io.Reader
+				if encoding == ServerCompressionGzip {
+					zr, err := gzip.NewReader(r.Body)
+					require.NoError(t, err)
+					body = zr
+				} else {
+					zr, err := zstd.NewReader(r.Body)
+					require.NoError(t, err)
+					defer zr.Close()
+					body = zr
+				}
+				req, err := io.ReadAll(body)
+				require.NoError(t, err)
+				assert.Equal(t, "request", string(req))
+
+				w.Header().Set("Content-Encoding", "gzip")
+				zw := gzip.NewWriter(w)
+				zw.Write([]byte("response"))
+				zw.Close()
+			}))
+			defer srv.Close()
+
+			client := newServerHTTPClient(1, false, encoding)
+			resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("request"))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "response", string(body))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// The number of proof outcomes observed since the last adjustment before the concurrency limit is adjusted again.
+	concurrencySampleSize = 5
+	// The share of failed proofs above which the concurrency limit is lowered.
+	concurrencyMaxFailureRate = 0.2
+	// The factor by which the concurrency limit is lowered when proofs are slow or failing.
+	concurrencyDecrease = 0.75
+)
+
+// concurrencyController adjusts the limit of concurrent proof requests to the recent turnaround times and failure
+// rate of the proofs: the limit is lowered by a factor when proofs take longer than the target latency or fail, and
+// raised by one otherwise, within [MinConcurrentProofRequests, MaxConcurrentProofRequests].
+type concurrencyController struct {
+	mu sync.Mutex
+	// The current limit, once the first proof outcome was observed.
+	limit   float64
+	started bool
+	// The outcomes observed since the last adjustment.
+	latencies []time.Duration
+	failures  int
+}
+
+// observe records the turnaround of a fulfilled proof, or a failed proof.
+func (c *concurrencyController) observe(latency time.Duration, fulfilled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if fulfilled {
+		c.latencies = append(c.latencies, latency)
+	} else {
+		c.failures++
+	}
+}
+
+// adjust updates the limit from the outcomes observed since the last adjustment, once there are enough of them, and
+// returns the limit. Without a target latency, the limit is MaxConcurrentProofRequests.
+func (c *concurrencyController) adjust(cfg ProposerConfig) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lo, hi := float64(max(cfg.MinConcurrentProofRequests, 1)), float64(cfg.MaxConcurrentProofRequests)
+	if cfg.TargetProofLatency == 0 || lo >= hi {
+		return cfg.MaxConcurrentProofRequests
+	}
+	if !c.started {
+		c.limit, c.started = hi, true
+	}
+
+	if n := len(c.latencies) + c.failures; n >= concurrencySampleSize {
+		var total time.Duration
+		for _, latency := range c.latencies {
+			total += latency
+		}
+		slow := len(c.latencies) > 0 && total/time.Duration(len(c.latencies)) > cfg.TargetProofLatency
+		failing := float64(c.failures)/float64(n) > concurrencyMaxFailureRate
+		if slow || failing {
+			c.limit *= concurrencyDecrease
+		} else {
+			c.limit++
+		}
+		c.latencies, c.failures = nil, 0
+	}
+	// The bounds can change when the config is reloaded.
+	c.limit = min(max(c.limit, lo), hi)
+	return uint64(c.limit)
+}
+
+// maxConcurrentProofRequests returns the current limit of concurrent proof requests.
+func (l *L2OutputSubmitter) maxConcurrentProofRequests() uint64 {
+	limit := l.concurrency.adjust(*l.config())
+	l.Metr.RecordEffectiveConcurrency(limit)
+	return limit
+}
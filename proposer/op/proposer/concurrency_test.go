@@ -0,0 +1,41 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestConcurrencyController tests that the limit of concurrent proof requests is lowered while proofs are slow or
+// failing, and raised again once they're fast, within the configured bounds.
+func TestConcurrencyController(t *testing.T) {
+	cfg := ProposerConfig{MaxConcurrentProofRequests: 20, MinConcurrentProofRequests: 4, TargetProofLatency: 10 * time.Minute}
+	var c concurrencyController
+	require.Equal(t, uint64(20), c.adjust(cfg))
+
+	observe := func(latency time.Duration, fulfilled bool) {
+		for i := 0; i < concurrencySampleSize; i++ {
+			c.observe(latency, fulfilled)
+		}
+	}
+	observe(20*time.Minute, true)
+	require.Equal(t, uint64(15), c.adjust(cfg))
+	// The limit is only adjusted once per sample.
+	require.Equal(t, uint64(15), c.adjust(cfg))
+
+	observe(0, false)
+	require.Equal(t, uint64(11), c.adjust(cfg))
+	for i := 0; i < 10; i++ {
+		observe(20*time.Minute, true)
+		c.adjust(cfg)
+	}
+	require.Equal(t, uint64(4), c.adjust(cfg))
+
+	observe(5*time.Minute, true)
+	require.Equal(t, uint64(5), c.adjust(cfg))
+
+	// Without a target latency, the limit is the configured maximum.
+	cfg.TargetProofLatency = 0
+	require.Equal(t, uint64(20), c.adjust(cfg))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
@@ -99,6 +100,140 @@ type CLIConfig struct {
 	BatchInbox string
 	// The batcher address to include transactions from. Note that this is ignored if L2 Chain ID is in rollup config.
 	BatcherAddress string
+	// L2EthRpc is the HTTP provider URL for the L2 execution node.
+	L2EthRpc string
+	// The estimated cycle count to target per span proof. If zero, spans have a fixed size of MaxBlockRangePerSpanProof.
+	TargetCyclesPerSpanProof uint64
+	// The maximum number of span proofs to submit to the OP Succinct server in a single request.
+	SpanProofBatchSize uint64
+	// Span proofs with a higher estimated cycle count are split before being requested. Zero means no limit.
+	MaxCyclesPerSpanProof uint64
+	// Span proofs with a higher estimated fee are split before being requested. Zero means no limit.
+	MaxFeePerSpanProof uint64
+	// Reuse an L1 block hash checkpointed for a previous agg proof if the block is at most this old.
+	CheckpointReuseWindow time.Duration
+	// How to select the L1 head checkpointed for agg proofs. One of: latest, confirmations, safe, finalized.
+	AggProofL1HeadPolicy string
+	// Number of blocks behind the block selected by the L1 head policy to checkpoint for agg proofs.
+	AggProofL1HeadOffset uint64
+	// The maximum number of agg proofs for consecutive output intervals to create ahead of the latest output on the L2OO contract.
+	MaxPipelinedAggProofs uint64
+	// The URL of a secondary OP Succinct server to fail over to when the fulfillment rate of the primary degrades.
+	OPSuccinctSecondaryServerUrl string
+	// The minimum share of recent proofs fulfilled by the primary OP Succinct server before failing over.
+	FailoverMinFulfillmentRate float64
+	// How long to request proofs from the secondary OP Succinct server before switching back to the primary.
+	FailoverCooldown time.Duration
+	// The proof system (zkVM) that the OP Succinct server generates proofs with.
+	ProofSystem string
+	// The maximum amount of time to wait for a span proof. If 0, ProofTimeout is used.
+	SpanProofTimeout uint64
+	// The maximum amount of time to wait for an agg proof. If 0, ProofTimeout is used.
+	AggProofTimeout uint64
+	// Additional time allowed for a proof per L2 block in its range.
+	ProofTimeoutPerBlock uint64
+	// The interval at which stale and orphaned proof requests are cleaned up. 0 disables the cleanup.
+	GCInterval time.Duration
+	// The superchain registry network that the chain-specific settings were loaded from, if any.
+	Network string
+	// The path of the config file, if any. Tunable settings are reloaded from it on SIGHUP.
+	ConfigFile string
+	// How long to cache outputs fetched from the rollup node.
+	RPCCacheTTL time.Duration
+	// The maximum number of pending proofs whose status is polled at the same time.
+	StatusPollConcurrency uint64
+	// The maximum number of idle connections kept open to each OP Succinct server.
+	ServerMaxIdleConns uint64
+	// Whether to attempt HTTP/2 for requests to the OP Succinct server.
+	ServerHTTP2 bool
+	// How long to wait on shutdown for in-flight proof requests before cancelling them.
+	ShutdownTimeout time.Duration
+	// The command that generates span proof witnesses locally. If empty, the OP Succinct server generates them.
+	WitnessGenCmd string
+	// The encoding used to compress requests to, and responses from, the OP Succinct server.
+	ServerCompression string
+	// The number of L1 confirmations of the batches of L2 blocks that span proofs are queued for. If 0, and
+	// BatchFinalized isn't set, span proofs are only queued for finalized L2 blocks.
+	BatchConfirmations uint64
+	// Whether the batches of L2 blocks that span proofs are queued for must be in finalized L1 blocks.
+	BatchFinalized bool
+	// How often the L2 safe and finalized heads are polled from the rollup node. If 0, they're fetched whenever
+	// they're used.
+	HeadPollInterval time.Duration
+	// Number of blocks behind the L2 head up to which span proofs are queued.
+	L2HeadMargin uint64
+	// Whether span proofs and proposals are restricted to L2 blocks derived from finalized L1 data.
+	FinalizedOnly bool
+	// Maximum price per cycle that the prover network may charge for a proof. Zero means no limit.
+	MaxPricePerCycle uint64
+	// Maximum total fee that the prover network may charge for a proof. Zero means no limit.
+	MaxTotalFee uint64
+	// What to do when the quote of a span proof exceeds the price ceiling.
+	PriceCeilingAction string
+	// Maximum proving spend in the last 24 hours and 7 days, beyond which only the proofs needed for the next output
+	// are requested. Zero means no budget.
+	DailyProvingBudget  uint64
+	WeeklyProvingBudget uint64
+	// Maximum time that the latest output may trail the L2 safe head. Zero disables the SLA monitor.
+	OutputSLA time.Duration
+	// Alert if the output SLA is predicted to be breached within this window.
+	OutputSLAAlertWindow time.Duration
+	// The L2OutputOracle contract address of an incumbent proposer to compare outputs against in shadow mode.
+	ShadowL2OOAddress string
+	// Whether agg proofs are cross-checked against the output roots computed by the rollup node before submission.
+	CrossCheckOutputRoots bool
+	// Additional rollup nodes that must reach RollupQuorum on the outputs used for proofs and submissions.
+	QuorumRollupRpcs []string
+	RollupQuorum     uint64
+	// COMPLETE and FAILED proof requests that were added this long ago are archived by the cleanup. 0 disables it.
+	ArchiveAfter time.Duration
+	// The SQLite journal mode of the proof DB.
+	DbJournalMode string
+	// How long a proof DB connection waits for a lock held by another connection.
+	DbBusyTimeout time.Duration
+	// The maximum number of open read connections to the proof DB.
+	DbMaxReadConns int
+	// Path to the file with the key that proofs are encrypted at rest with. Empty if proofs are stored unencrypted.
+	ProofEncryptionKeyFile string
+	// The RPC of the op-conductor whose leadership this replica follows.
+	ConductorRpc string
+	// The lease file that elects the active replica, if there's no op-conductor. It's next to the proof DB, which the
+	// replicas share.
+	LeaseFile string
+	// How long the lease is held without renewal.
+	LeaseDuration time.Duration
+	// The ID of this replica in the lease.
+	InstanceID string
+	// The dependency set file of an interop chain.
+	DependencySetFile string
+	// Whether to serve the GraphQL API.
+	GraphQL bool
+	// The address to serve the watch API over gRPC on, if any.
+	GRPCAddr string
+	// The URL of the event bus broker, if lifecycle events are published.
+	EventBus string
+	// The topic of the lifecycle events.
+	EventTopic string
+	// How often the stages of the driver loop that check the status of requested proofs, derive agg proofs and
+	// request queued proofs run. If 0, they run every PollInterval.
+	PendingProofsInterval time.Duration
+	AggProofsInterval     time.Duration
+	RequestProofsInterval time.Duration
+	// Cron expressions of the windows in which agg proofs (or DGF proposals) are submitted, and the maximum L1 base fee
+	// in gwei at which they're submitted. Both are overridden when the output SLA is at risk. Empty and 0 mean no
+	// restriction.
+	SubmissionSchedule   []string
+	SubmissionMaxBaseFee uint64
+	// Maximum number of unrequested and requested span proofs, beyond which new span proofs aren't queued until the
+	// backlog drains. Zero means no limit.
+	MaxPendingSpanProofs uint64
+	// The target turnaround time of proofs, to which the limit of concurrent proof requests adapts, between
+	// MinConcurrentProofRequests and MaxConcurrentProofRequests. Zero keeps the limit at MaxConcurrentProofRequests.
+	TargetProofLatency         time.Duration
+	MinConcurrentProofRequests uint64
+
+	// The settings that were set through flags or environment variables.
+	explicitFlags map[string]bool
 }
 
 func (c *CLIConfig) Check() error {
@@ -128,9 +263,98 @@ func (c *CLIConfig) Check() error {
 		return errors.New("the `ProposalInterval` was provided but the `DisputeGameFactory` address was not set")
 	}
 
+	switch c.AggProofL1HeadPolicy {
+	case L1HeadPolicyLatest, L1HeadPolicyConfirmations, L1HeadPolicySafe, L1HeadPolicyFinalized:
+	default:
+		return fmt.Errorf("unknown `AggProofL1HeadPolicy`: %s", c.AggProofL1HeadPolicy)
+	}
+	switch c.ServerCompression {
+	case ServerCompressionNone, ServerCompressionGzip, ServerCompressionZstd:
+	default:
+		return fmt.Errorf("unknown `ServerCompression`: %s", c.ServerCompression)
+	}
+	switch strings.ToUpper(c.DbJournalMode) {
+	case "WAL", "DELETE", "TRUNCATE", "PERSIST":
+	default:
+		return fmt.Errorf("unknown `DbJournalMode`: %s", c.DbJournalMode)
+	}
+	switch c.PriceCeilingAction {
+	case PriceCeilingWait, PriceCeilingShrink, PriceCeilingAlert:
+	default:
+		return fmt.Errorf("unknown `PriceCeilingAction`: %s", c.PriceCeilingAction)
+	}
+	// The L2OO contract can only checkpoint one of the 256 most recent L1 block hashes.
+	if c.AggProofL1HeadOffset >= 256 {
+		return errors.New("the `AggProofL1HeadOffset` must be less than 256")
+	}
+	if c.FailoverMinFulfillmentRate < 0 || c.FailoverMinFulfillmentRate > 1 {
+		return errors.New("the `FailoverMinFulfillmentRate` must be between 0 and 1")
+	}
+	if c.MaxPipelinedAggProofs == 0 {
+		return errors.New("the `MaxPipelinedAggProofs` must be at least 1")
+	}
+	if c.SpanProofBatchSize == 0 {
+		return errors.New("the `SpanProofBatchSize` must be at least 1")
+	}
+	if c.TargetCyclesPerSpanProof != 0 && c.L2EthRpc == "" {
+		return errors.New("the `TargetCyclesPerSpanProof` was provided but the L2 execution RPC was not set")
+	}
+	if c.RollupQuorum > uint64(len(c.QuorumRollupRpcs)+1) {
+		return fmt.Errorf("the `RollupQuorum` %d is larger than the number of rollup nodes %d", c.RollupQuorum, len(c.QuorumRollupRpcs)+1)
+	}
+	if c.ShadowL2OOAddress != "" && c.L2OOAddress == "" {
+		return errors.New("the `ShadowL2OOAddress` requires the `L2OOAddress` to be set")
+	}
+	if c.FinalizedOnly && (c.AllowNonFinalized || c.BatchConfirmations > 0 || c.BatchFinalized) {
+		return errors.New("the `FinalizedOnly` mode can't be combined with `AllowNonFinalized`, `BatchConfirmations` or `BatchFinalized`")
+	}
+	if c.ConductorRpc != "" && c.LeaseFile != "" {
+		return errors.New("only one of the `ConductorRpc` and the `LeaseFile` can be set")
+	}
+	if c.LeaseFile != "" {
+		if c.LeaseDuration <= c.PollInterval {
+			return fmt.Errorf("the `LeaseDuration` %s must exceed the `PollInterval` %s", c.LeaseDuration, c.PollInterval)
+		}
+		// The replicas share the proof DB, so that a standby replica takes over where the active one stopped. The lease
+		// file being next to it is how the DB is known to be on the shared storage.
+		if filepath.Dir(c.LeaseFile) != filepath.Dir(c.DbPath) {
+			return fmt.Errorf("the `LeaseFile` must be in the directory of the proof DB %s, which the replicas share", c.DbPath)
+		}
+		if !c.UseCachedDb {
+			return errors.New("the `LeaseFile` requires `UseCachedDb`, so that a starting replica doesn't reset the shared proof DB")
+		}
+		if strings.EqualFold(c.DbJournalMode, "WAL") {
+			return errors.New("the `LeaseFile` requires a `DbJournalMode` other than WAL, which doesn't work on shared storage")
+		}
+	}
+	if c.PendingProofsInterval < 0 || c.AggProofsInterval < 0 || c.RequestProofsInterval < 0 {
+		return errors.New("the `PendingProofsInterval`, `AggProofsInterval` and `RequestProofsInterval` can't be negative")
+	}
+	if c.TargetProofLatency > 0 && c.MinConcurrentProofRequests > c.MaxConcurrentProofRequests {
+		return fmt.Errorf("the `MinConcurrentProofRequests` %d must not exceed the `MaxConcurrentProofRequests` %d", c.MinConcurrentProofRequests, c.MaxConcurrentProofRequests)
+	}
+	if _, err := parseSubmissionWindows(c.SubmissionSchedule); err != nil {
+		return fmt.Errorf("invalid `SubmissionSchedule`: %w", err)
+	}
+	// The proving spend is summed from the completed proof requests in the DB, so they mustn't be archived while
+	// they're within a budget window.
+	if c.ArchiveAfter > 0 && ((c.DailyProvingBudget > 0 && c.ArchiveAfter < 24*time.Hour) || (c.WeeklyProvingBudget > 0 && c.ArchiveAfter < 7*24*time.Hour)) {
+		return fmt.Errorf("the `ArchiveAfter` %s must be at least as long as the windows of the configured proving budgets", c.ArchiveAfter)
+	}
+
 	return nil
 }
 
+// ArchivePath returns the path of the archive of old proof requests, next to the proof DB at the given path.
+func ArchivePath(dbPath string) string {
+	return filepath.Join(filepath.Dir(dbPath), "archive.jsonl")
+}
+
+// DBPath returns the path of the proof DB for the given L2 chain within the DB folder.
+func DBPath(dir string, l2ChainID uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%d", l2ChainID), "proofs.db")
+}
+
 // NewConfig parses the Config from the provided flags or environment variables.
 func NewConfig(ctx *cli.Context) *CLIConfig {
 	// Get the L2 chain ID from the rollup config
@@ -144,8 +368,7 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		log.Fatal(err)
 	}
 
-	dbPath := ctx.String(flags.DbPathFlag.Name)
-	dbPath = filepath.Join(dbPath, fmt.Sprintf("%d", rollupConfig.L2ChainID.Uint64()), "proofs.db")
+	dbPath := DBPath(ctx.String(flags.DbPathFlag.Name), rollupConfig.L2ChainID.Uint64())
 
 	return &CLIConfig{
 		// Required Flags
@@ -180,5 +403,69 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		MaxConcurrentProofRequests:   ctx.Uint64(flags.MaxConcurrentProofRequestsFlag.Name),
 		BatchInbox:                   ctx.String(flags.BatchInboxFlag.Name),
 		BatcherAddress:               ctx.String(flags.BatcherAddressFlag.Name),
+		L2EthRpc:                     ctx.String(flags.L2EthRpcFlag.Name),
+		TargetCyclesPerSpanProof:     ctx.Uint64(flags.TargetCyclesPerSpanProofFlag.Name),
+		SpanProofBatchSize:           ctx.Uint64(flags.SpanProofBatchSizeFlag.Name),
+		MaxCyclesPerSpanProof:        ctx.Uint64(flags.MaxCyclesPerSpanProofFlag.Name),
+		MaxFeePerSpanProof:           ctx.Uint64(flags.MaxFeePerSpanProofFlag.Name),
+		CheckpointReuseWindow:        ctx.Duration(flags.CheckpointReuseWindowFlag.Name),
+		AggProofL1HeadPolicy:         ctx.String(flags.AggProofL1HeadPolicyFlag.Name),
+		AggProofL1HeadOffset:         ctx.Uint64(flags.AggProofL1HeadOffsetFlag.Name),
+		MaxPipelinedAggProofs:        ctx.Uint64(flags.MaxPipelinedAggProofsFlag.Name),
+		OPSuccinctSecondaryServerUrl: ctx.String(flags.OPSuccinctSecondaryServerUrlFlag.Name),
+		FailoverMinFulfillmentRate:   ctx.Float64(flags.FailoverMinFulfillmentRateFlag.Name),
+		FailoverCooldown:             ctx.Duration(flags.FailoverCooldownFlag.Name),
+		ProofSystem:                  ctx.String(flags.ProofSystemFlag.Name),
+		SpanProofTimeout:             ctx.Uint64(flags.SpanProofTimeoutFlag.Name),
+		AggProofTimeout:              ctx.Uint64(flags.AggProofTimeoutFlag.Name),
+		ProofTimeoutPerBlock:         ctx.Uint64(flags.ProofTimeoutPerBlockFlag.Name),
+		GCInterval:                   ctx.Duration(flags.GCIntervalFlag.Name),
+		ConfigFile:                   ctx.String(flags.ConfigFileFlag.Name),
+		Network:                      ctx.String(flags.NetworkFlag.Name),
+		RPCCacheTTL:                  ctx.Duration(flags.RPCCacheTTLFlag.Name),
+		StatusPollConcurrency:        ctx.Uint64(flags.StatusPollConcurrencyFlag.Name),
+		ServerMaxIdleConns:           ctx.Uint64(flags.ServerMaxIdleConnsFlag.Name),
+		ServerHTTP2:                  ctx.Bool(flags.ServerHTTP2Flag.Name),
+		ShutdownTimeout:              ctx.Duration(flags.ShutdownTimeoutFlag.Name),
+		WitnessGenCmd:                ctx.String(flags.WitnessGenCmdFlag.Name),
+		ServerCompression:            ctx.String(flags.ServerCompressionFlag.Name),
+		BatchConfirmations:           ctx.Uint64(flags.BatchConfirmationsFlag.Name),
+		BatchFinalized:               ctx.Bool(flags.BatchFinalizedFlag.Name),
+		HeadPollInterval:             ctx.Duration(flags.HeadPollIntervalFlag.Name),
+		L2HeadMargin:                 ctx.Uint64(flags.L2HeadMarginFlag.Name),
+		FinalizedOnly:                ctx.Bool(flags.FinalizedOnlyFlag.Name),
+		MaxPricePerCycle:             ctx.Uint64(flags.MaxPricePerCycleFlag.Name),
+		MaxTotalFee:                  ctx.Uint64(flags.MaxTotalFeeFlag.Name),
+		PriceCeilingAction:           ctx.String(flags.PriceCeilingActionFlag.Name),
+		DailyProvingBudget:           ctx.Uint64(flags.DailyProvingBudgetFlag.Name),
+		WeeklyProvingBudget:          ctx.Uint64(flags.WeeklyProvingBudgetFlag.Name),
+		OutputSLA:                    ctx.Duration(flags.OutputSLAFlag.Name),
+		OutputSLAAlertWindow:         ctx.Duration(flags.OutputSLAAlertWindowFlag.Name),
+		ShadowL2OOAddress:            ctx.String(flags.ShadowL2OOAddressFlag.Name),
+		CrossCheckOutputRoots:        ctx.Bool(flags.CrossCheckOutputRootsFlag.Name),
+		QuorumRollupRpcs:             ctx.StringSlice(flags.QuorumRollupRpcsFlag.Name),
+		RollupQuorum:                 ctx.Uint64(flags.RollupQuorumFlag.Name),
+		ArchiveAfter:                 ctx.Duration(flags.ArchiveAfterFlag.Name),
+		DbJournalMode:                ctx.String(flags.DbJournalModeFlag.Name),
+		DbBusyTimeout:                ctx.Duration(flags.DbBusyTimeoutFlag.Name),
+		DbMaxReadConns:               ctx.Int(flags.DbMaxReadConnsFlag.Name),
+		ProofEncryptionKeyFile:       ctx.String(flags.ProofEncryptionKeyFileFlag.Name),
+		ConductorRpc:                 ctx.String(flags.ConductorRpcFlag.Name),
+		LeaseFile:                    ctx.String(flags.LeaseFileFlag.Name),
+		LeaseDuration:                ctx.Duration(flags.LeaseDurationFlag.Name),
+		InstanceID:                   ctx.String(flags.InstanceIDFlag.Name),
+		DependencySetFile:            ctx.String(flags.DependencySetFileFlag.Name),
+		GraphQL:                      ctx.Bool(flags.GraphQLFlag.Name),
+		GRPCAddr:                     ctx.String(flags.GRPCAddrFlag.Name),
+		EventBus:                     ctx.String(flags.EventBusFlag.Name),
+		EventTopic:                   ctx.String(flags.EventTopicFlag.Name),
+		PendingProofsInterval:        ctx.Duration(flags.PendingProofsIntervalFlag.Name),
+		AggProofsInterval:            ctx.Duration(flags.AggProofsIntervalFlag.Name),
+		RequestProofsInterval:        ctx.Duration(flags.RequestProofsIntervalFlag.Name),
+		SubmissionSchedule:           ctx.StringSlice(flags.SubmissionScheduleFlag.Name),
+		SubmissionMaxBaseFee:         ctx.Uint64(flags.SubmissionMaxBaseFeeFlag.Name),
+		MaxPendingSpanProofs:         ctx.Uint64(flags.MaxPendingSpanProofsFlag.Name),
+		TargetProofLatency:           ctx.Duration(flags.TargetProofLatencyFlag.Name),
+		MinConcurrentProofRequests:   ctx.Uint64(flags.MinConcurrentProofRequestsFlag.Name),
 	}
 }
@@ -0,0 +1,109 @@
+package proposer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadConfigFile applies the settings in a TOML or YAML config file to the CLI context. The keys of the file are flag
+// names (e.g. `l1-eth-rpc`), and a setting is only applied if the flag wasn't set on the command line or through its
+// environment variable. Unknown keys and values that can't be parsed for their flag are rejected.
+func LoadConfigFile(ctx *cli.Context, path string) error {
+	settings, err := readConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool)
+	for _, f := range ctx.Command.Flags {
+		for _, name := range f.Names() {
+			known[name] = true
+		}
+	}
+	for _, f := range ctx.App.Flags {
+		for _, name := range f.Names() {
+			known[name] = true
+		}
+	}
+
+	// Apply the settings in a deterministic order, so that errors are reproducible.
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, key := range keys {
+		if !known[key] {
+			errs = append(errs, fmt.Sprintf("unknown setting %q", key))
+			continue
+		}
+		if ctx.IsSet(key) {
+			continue
+		}
+		value, err := configValueString(settings[key])
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("invalid value for %q: %v", key, err))
+			continue
+		}
+		if err := ctx.Set(key, value); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid value for %q: %v", key, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config file %s:\n  %s", path, strings.Join(errs, "\n  "))
+	}
+
+	return nil
+}
+
+// Read the settings of a TOML or YAML config file, keyed by flag name.
+func readConfigFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	settings := make(map[string]interface{})
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		if err := toml.Unmarshal(data, &settings); err != nil {
+			return nil, fmt.Errorf("failed to parse TOML config file %s: %w", path, err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &settings); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML config file %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q, expected .toml, .yaml or .yml", ext)
+	}
+	return settings, nil
+}
+
+// Convert a config file value to the string form that the CLI flags parse. Lists are joined with commas.
+func configValueString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string, bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), nil
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			s, err := configValueString(item)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = s
+		}
+		return strings.Join(parts, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
@@ -0,0 +1,74 @@
+package proposer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func runWithConfigFile(t *testing.T, contents, ext string, args ...string) (*cli.Context, error) {
+	path := filepath.Join(t.TempDir(), "config"+ext)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+
+	var result *cli.Context
+	var loadErr error
+	app := &cli.App{
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "l1-eth-rpc"},
+			&cli.DurationFlag{Name: "poll-interval"},
+			&cli.Uint64Flag{Name: "max-concurrent-proof-requests"},
+		},
+		Action: func(ctx *cli.Context) error {
+			result = ctx
+			loadErr = LoadConfigFile(ctx, path)
+			return nil
+		},
+	}
+	require.NoError(t, app.Run(append([]string{"app"}, args...)))
+	return result, loadErr
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	toml := `
+l1-eth-rpc = "http://l1"
+poll-interval = "30s"
+max-concurrent-proof-requests = 5
+`
+	ctx, err := runWithConfigFile(t, toml, ".toml", "--l1-eth-rpc", "http://flag")
+	require.NoError(t, err)
+	// Flags take precedence over the config file.
+	require.Equal(t, "http://flag", ctx.String("l1-eth-rpc"))
+	require.Equal(t, 30*time.Second, ctx.Duration("poll-interval"))
+	require.Equal(t, uint64(5), ctx.Uint64("max-concurrent-proof-requests"))
+
+	yaml := "l1-eth-rpc: http://l1\nunknown-setting: 1\npoll-interval: soon\n"
+	_, err = runWithConfigFile(t, yaml, ".yaml")
+	require.ErrorContains(t, err, `unknown setting "unknown-setting"`)
+	require.ErrorContains(t, err, `invalid value for "poll-interval"`)
+}
+
+func TestLoadTunables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	contents := `
+l1-eth-rpc = "http://l1"
+poll-interval = "1m"
+max-concurrent-proof-requests = 20
+proof-timeout = 600
+`
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+
+	// The proof timeout was set through a flag, so it isn't reloaded. Structural settings are ignored.
+	update, names, err := loadTunables(path, map[string]bool{"proof-timeout": true})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"poll-interval", "max-concurrent-proof-requests"}, names)
+
+	cfg := ProposerConfig{ProofTimeout: 100, MaxConcurrentProofRequests: 1}
+	update(&cfg)
+	require.Equal(t, time.Minute, cfg.PollInterval)
+	require.Equal(t, uint64(20), cfg.MaxConcurrentProofRequests)
+	require.Equal(t, uint64(100), cfg.ProofTimeout)
+}
@@ -0,0 +1,92 @@
+package proposer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// AggregationOutputs are the public values committed by an agg proof.
+type AggregationOutputs struct {
+	L1Head           common.Hash
+	L2PreRoot        common.Hash
+	L2PostRoot       common.Hash
+	L2BlockNumber    uint64
+	ChainID          uint64
+	RollupConfigHash common.Hash
+	MultiBlockVKey   common.Hash
+}
+
+// aggregationOutputsSize is the size of the ABI encoding of the AggregationOutputs struct, which has 7 static fields.
+const aggregationOutputsSize = 7 * 32
+
+// DecodeAggregationOutputs decodes the ABI-encoded public values of an agg proof.
+func DecodeAggregationOutputs(b []byte) (*AggregationOutputs, error) {
+	if len(b) != aggregationOutputsSize {
+		return nil, fmt.Errorf("invalid aggregation outputs length %d, expected %d", len(b), aggregationOutputsSize)
+	}
+	word := func(i int) []byte { return b[i*32 : (i+1)*32] }
+	uint64Word := func(i int) (uint64, error) {
+		w := word(i)
+		if common.BytesToHash(w[:24]) != (common.Hash{}) {
+			return 0, fmt.Errorf("aggregation outputs field %d overflows uint64", i)
+		}
+		return binary.BigEndian.Uint64(w[24:]), nil
+	}
+
+	outputs := &AggregationOutputs{
+		L1Head:           common.BytesToHash(word(0)),
+		L2PreRoot:        common.BytesToHash(word(1)),
+		L2PostRoot:       common.BytesToHash(word(2)),
+		RollupConfigHash: common.BytesToHash(word(5)),
+		MultiBlockVKey:   common.BytesToHash(word(6)),
+	}
+	var err error
+	if outputs.L2BlockNumber, err = uint64Word(3); err != nil {
+		return nil, err
+	}
+	if outputs.ChainID, err = uint64Word(4); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// crossCheckAggProof compares the output root committed by an agg proof against the output root that the rollup node
+// computed for its end block, and returns ErrOutputRootMismatch if they differ. Agg proofs whose public values the
+// server didn't report can't be checked, and pass.
+func (l *L2OutputSubmitter) crossCheckAggProof(aggProof *ent.ProofRequest, output *eth.OutputResponse) error {
+	if aggProof.PublicValues == nil {
+		l.Log.Warn("OP Succinct server didn't report the public values of the agg proof, skipping output root cross-check", "start", aggProof.StartBlock, "end", aggProof.EndBlock)
+		return nil
+	}
+
+	outputs, err := DecodeAggregationOutputs(aggProof.PublicValues)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrOutputRootMismatch, err)
+	}
+	if outputs.L2BlockNumber != aggProof.EndBlock {
+		return fmt.Errorf("%w: proof ends at L2 block %d, expected %d", ErrOutputRootMismatch, outputs.L2BlockNumber, aggProof.EndBlock)
+	}
+	if outputs.L2PostRoot != common.Hash(output.OutputRoot) {
+		return fmt.Errorf("%w: proof commits to output root %s at L2 block %d, rollup node computed %s", ErrOutputRootMismatch, outputs.L2PostRoot, aggProof.EndBlock, common.Hash(output.OutputRoot))
+	}
+	return nil
+}
+
+// rejectAggProof marks an agg proof that failed the output root cross-check as failed, so that it's never submitted,
+// and raises a critical alert. The driver derives a new agg proof for the range, which is cross-checked again.
+func (l *L2OutputSubmitter) rejectAggProof(aggProof *ent.ProofRequest, reason error) {
+	l.Metr.RecordOutputRootMismatch()
+	l.Log.Error("CRITICAL: agg proof output root doesn't match the rollup node, refusing to submit it", "start", aggProof.StartBlock, "end", aggProof.EndBlock, "err", reason)
+	if err := l.db.UpdateProofStatus(aggProof.ID, proofrequest.StatusFAILED); err != nil {
+		l.Log.Error("failed to set proof status to failed", "err", err, "id", aggProof.ID)
+	}
+	if err := l.db.SetFailure(aggProof.ID, newFailure(proofrequest.FailureStageVERIFY, reason)); err != nil {
+		l.Log.Error("failed to record failure reason", "err", err, "id", aggProof.ID)
+	}
+	l.publishProofEvent(EventProofFailed, aggProof.ID)
+}
@@ -0,0 +1,36 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// TestCrossCheckAggProof tests that an agg proof only passes the cross-check if it commits to the rollup node's output
+// root at its end block.
+func TestCrossCheckAggProof(t *testing.T) {
+	root := common.HexToHash("0x01")
+	publicValues := make([]byte, aggregationOutputsSize)
+	copy(publicValues[2*32:], root[:])
+	publicValues[4*32-1] = 200
+
+	outputs, err := DecodeAggregationOutputs(publicValues)
+	require.NoError(t, err)
+	assert.Equal(t, root, outputs.L2PostRoot)
+	assert.Equal(t, uint64(200), outputs.L2BlockNumber)
+
+	l := &L2OutputSubmitter{}
+	l.Log = testlog.Logger(t, log.LevelInfo)
+	aggProof := &ent.ProofRequest{StartBlock: 100, EndBlock: 200, PublicValues: publicValues}
+	assert.NoError(t, l.crossCheckAggProof(aggProof, &eth.OutputResponse{OutputRoot: eth.Bytes32(root)}))
+	assert.ErrorIs(t, l.crossCheckAggProof(aggProof, &eth.OutputResponse{OutputRoot: eth.Bytes32{2}}), ErrOutputRootMismatch)
+
+	aggProof.EndBlock = 300
+	assert.ErrorIs(t, l.crossCheckAggProof(aggProof, &eth.OutputResponse{OutputRoot: eth.Bytes32(root)}), ErrOutputRootMismatch)
+}
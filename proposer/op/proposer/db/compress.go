@@ -0,0 +1,36 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Proofs are stored zstd compressed, prefixed with a marker so that proofs stored uncompressed by older versions can
+// still be read.
+var compressedProofMarker = []byte("zstd:")
+
+var (
+	proofEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	proofDecoder, _ = zstd.NewReader(nil)
+)
+
+// compressProof compresses a proof for storage in the database.
+func compressProof(proof []byte) []byte {
+	out := make([]byte, 0, len(compressedProofMarker)+len(proof)/2)
+	out = append(out, compressedProofMarker...)
+	return proofEncoder.EncodeAll(proof, out)
+}
+
+// decompressProof returns the original proof bytes of a proof stored in the database.
+func decompressProof(stored []byte) ([]byte, error) {
+	if !bytes.HasPrefix(stored, compressedProofMarker) {
+		return stored, nil
+	}
+	proof, err := proofDecoder.DecodeAll(stored[len(compressedProofMarker):], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress proof: %w", err)
+	}
+	return proof, nil
+}
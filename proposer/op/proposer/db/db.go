@@ -2,14 +2,21 @@ package db
 
 import (
 	"context"
+	"crypto/cipher"
+	stdsql "database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -18,13 +25,50 @@ import (
 type ProofDB struct {
 	writeClient *ent.Client
 	readClient  *ent.Client
+	// The cipher that proofs are encrypted at rest with, or nil if they're stored unencrypted.
+	proofCipher cipher.AEAD
+	// The number of duplicate active proof requests that were failed when the DB was opened.
+	dedupedRequests int64
 }
 
-// InitDB initializes the database and returns a handle to it.
+// Options are the SQLite connection settings of a ProofDB.
+type Options struct {
+	// The SQLite journal mode, e.g. WAL or DELETE. In WAL mode, readers don't block the writer and vice versa.
+	JournalMode string
+	// How long a connection waits for a lock held by another connection before failing with "database is locked".
+	BusyTimeout time.Duration
+	// The maximum number of open read connections. Writes always go through a single connection, so they're
+	// serialized within the process.
+	MaxReadConns int
+	// The AES-256 key that proofs are encrypted at rest with, or nil to store them unencrypted. Proofs stored
+	// unencrypted before encryption was enabled can still be read.
+	EncryptionKey []byte
+}
+
+// DefaultOptions returns the SQLite connection settings that suit the proposer, whose driver loop and request
+// goroutines read and write the DB concurrently.
+func DefaultOptions() Options {
+	return Options{
+		JournalMode:  "WAL",
+		BusyTimeout:  5 * time.Second,
+		MaxReadConns: 4,
+	}
+}
+
+// InitDB initializes the database with the default options and returns a handle to it.
 // If useCachedDb is false, the existing DB at the path will be deleted (if it exists).
 func InitDB(dbPath string, useCachedDb bool) (*ProofDB, error) {
+	return InitDBWithOptions(dbPath, useCachedDb, DefaultOptions())
+}
+
+// InitDBWithOptions initializes the database with the given SQLite connection settings and returns a handle to it.
+// If useCachedDb is false, the existing DB at the path will be deleted (if it exists).
+func InitDBWithOptions(dbPath string, useCachedDb bool, opts Options) (*ProofDB, error) {
 	if !useCachedDb {
-		os.Remove(dbPath)
+		// Also remove the write-ahead log and shared memory index of a DB in WAL mode, which would corrupt a new DB.
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			os.Remove(dbPath + suffix)
+		}
 	} else {
 		fmt.Printf("Using cached DB at %s\n", dbPath)
 	}
@@ -35,9 +79,16 @@ func InitDB(dbPath string, useCachedDb bool) (*ProofDB, error) {
 		return nil, fmt.Errorf("failed to create directories for DB: %w", err)
 	}
 
-	connectionUrl := fmt.Sprintf("file:%s?_fk=1", dbPath)
+	params := fmt.Sprintf("_fk=1&_journal_mode=%s&_busy_timeout=%d", opts.JournalMode, opts.BusyTimeout.Milliseconds())
+	if strings.EqualFold(opts.JournalMode, "WAL") {
+		// In WAL mode, syncing at checkpoints only is still safe against corruption, and much faster.
+		params += "&_synchronous=NORMAL"
+	}
+	connectionUrl := fmt.Sprintf("file:%s?%s", dbPath, params)
 
-	writeDrv, err := sql.Open("sqlite3", connectionUrl)
+	// Write transactions take the write lock when they begin, so that a transaction that reads first doesn't fail with
+	// "database is locked" when it upgrades to a write lock while another connection (e.g. a CLI command) writes.
+	writeDrv, err := sql.Open("sqlite3", connectionUrl+"&_txlock=immediate")
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to sqlite: %v", err)
 	}
@@ -51,9 +102,17 @@ func InitDB(dbPath string, useCachedDb bool) (*ProofDB, error) {
 		return nil, fmt.Errorf("failed opening connection to sqlite: %v", err)
 	}
 	readDb := readDrv.DB()
-	readDb.SetMaxOpenConns(4)
+	readDb.SetMaxOpenConns(max(opts.MaxReadConns, 1))
 	readDb.SetConnMaxLifetime(time.Hour)
 
+	proofCipher, err := newProofCipher(opts.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	deduped, err := dedupeActiveRequests(writeDb)
+	if err != nil {
+		return nil, err
+	}
 	readClient := ent.NewClient(ent.Driver(readDrv))
 	writeClient := ent.NewClient(ent.Driver(writeDrv))
 
@@ -64,7 +123,43 @@ func InitDB(dbPath string, useCachedDb bool) (*ProofDB, error) {
 		return nil, fmt.Errorf("failed creating schema resources: %v", err)
 	}
 
-	return &ProofDB{writeClient: writeClient, readClient: readClient}, nil
+	return &ProofDB{writeClient: writeClient, readClient: readClient, proofCipher: proofCipher, dedupedRequests: deduped}, nil
+}
+
+// DedupedRequests returns the number of duplicate active proof requests that were failed when the DB was opened, see
+// dedupeActiveRequests.
+func (db *ProofDB) DedupedRequests() int64 {
+	return db.dedupedRequests
+}
+
+// dedupeActiveRequests fails all but the oldest active proof request for each range, so that DBs created before at most
+// one request per range could be active can be migrated to the unique index that enforces it. Returns the number of
+// requests failed.
+func dedupeActiveRequests(db *stdsql.DB) (int64, error) {
+	var exists bool
+	err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'proof_requests')`).Scan(&exists)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check for the proof requests table: %w", err)
+	}
+	if !exists {
+		return 0, nil
+	}
+
+	res, err := db.Exec(`
+		UPDATE proof_requests SET status = 'FAILED'
+		WHERE status IN ('UNREQ', 'WITNESSGEN', 'PROVING') AND id NOT IN (
+			SELECT MIN(id) FROM proof_requests
+			WHERE status IN ('UNREQ', 'WITNESSGEN', 'PROVING')
+			GROUP BY type, start_block, end_block, backfill
+		)`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to dedupe active proof requests: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deduped proof requests: %w", err)
+	}
+	return n, nil
 }
 
 // CloseDB closes the connection to the database.
@@ -83,25 +178,205 @@ func (db *ProofDB) CloseDB() error {
 }
 
 // NewEntry creates a new proof request entry in the database.
+//
+// Span proof requests are coverage-aware: blocks in the range that are already covered by a span proof request that
+// hasn't failed are skipped, and an entry is only created for each uncovered gap. This prevents proving the same
+// blocks twice when a split or a retry overlaps existing requests (e.g. after crash recovery).
 func (db *ProofDB) NewEntry(proofType proofrequest.Type, start, end uint64) error {
-	now := uint64(time.Now().Unix())
-	_, err := db.writeClient.ProofRequest.
-		Create().
-		SetType(proofType).
-		SetStartBlock(start).
-		SetEndBlock(end).
-		SetStatus(proofrequest.StatusUNREQ).
-		SetRequestAddedTime(now).
-		SetLastUpdatedTime(now).
-		Save(context.Background())
+	_, err := db.newEntries(proofType, start, end, false, nil)
+	return err
+}
 
+// NewBackfillEntry creates a new proof request entry for the backfill of a historical range. Backfill requests are
+// requested after the driver's own requests, and aren't cancelled when the L2OO contract moves past their range.
+//
+// Span proof requests are coverage-aware like in NewEntry, but only span proof requests added at or after since count
+// as covering the range, so that blocks proven before e.g. a vkey upgrade can be proven again. Returns the number of
+// entries created.
+func (db *ProofDB) NewBackfillEntry(proofType proofrequest.Type, start, end, since uint64) (int, error) {
+	return db.newEntries(proofType, start, end, true, nil, proofrequest.RequestAddedTimeGTE(since))
+}
+
+// NewChildEntry creates a new proof request entry that replaces (part of) the range of the failed parent request, e.g.
+// when it's retried or split. The children record the ID of their parent, so that the retry tree of a range can be
+// reconstructed with GetRetryTree. Children of backfill requests are backfill requests themselves.
+//
+// Each range of a parent is only replaced once: if the parent already has a child with the same type and range, no
+// entry is created, so that a parent that's retried twice (e.g. by the driver and an operator) isn't split twice. A
+// failed child is retried as a child of the child instead. Returns the number of entries created.
+func (db *ProofDB) NewChildEntry(parent *ent.ProofRequest, proofType proofrequest.Type, start, end uint64) (int, error) {
+	var extra []predicate.ProofRequest
+	if parent.Backfill {
+		extra = append(extra, proofrequest.RequestAddedTimeGTE(parent.RequestAddedTime))
+	}
+	return db.newEntries(proofType, start, end, parent.Backfill, parent, extra...)
+}
+
+// SplitProofRequest marks the proof request with the given ID as failed, and replaces it with two children that cover
+// its range up to and from mid, in a single transaction, so that the range is never left without an active request.
+func (db *ProofDB) SplitProofRequest(id int, mid uint64) (err error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create new entry: %w", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
 	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
 
+	existingProof, err := tx.ProofRequest.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find existing proof: %w", err)
+	}
+	if mid <= existingProof.StartBlock || mid >= existingProof.EndBlock {
+		return fmt.Errorf("can't split proof request %d for blocks %d-%d at block %d", id, existingProof.StartBlock, existingProof.EndBlock, mid)
+	}
+	_, err = tx.ProofRequest.UpdateOne(existingProof).
+		SetStatus(proofrequest.StatusFAILED).
+		SetLastUpdatedTime(uint64(time.Now().Unix())).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set proof status to failed: %w", err)
+	}
+	var extra []predicate.ProofRequest
+	if existingProof.Backfill {
+		extra = append(extra, proofrequest.RequestAddedTimeGTE(existingProof.RequestAddedTime))
+	}
+	for _, r := range [][2]uint64{{existingProof.StartBlock, mid}, {mid, existingProof.EndBlock}} {
+		_, err = createEntries(ctx, tx.ProofRequest, existingProof.Type, r[0], r[1], existingProof.Backfill, existingProof, extra...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
 	return nil
 }
 
+// newEntries creates the proof request entries for NewEntry, NewBackfillEntry and NewChildEntry. The extra predicates
+// restrict the span proof requests that count as covering the range.
+func (db *ProofDB) newEntries(proofType proofrequest.Type, start, end uint64, backfill bool, parent *ent.ProofRequest, extra ...predicate.ProofRequest) (created int, err error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	created, err = createEntries(ctx, tx.ProofRequest, proofType, start, end, backfill, parent, extra...)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+// createEntries creates the proof request entries for a range with the given client, which is usually part of a
+// transaction. If parent isn't nil, the entries are children of the parent.
+func createEntries(ctx context.Context, client *ent.ProofRequestClient, proofType proofrequest.Type, start, end uint64, backfill bool, parent *ent.ProofRequest, extra ...predicate.ProofRequest) (int, error) {
+	if parent != nil {
+		exists, err := client.Query().
+			Where(
+				proofrequest.ParentID(parent.ID),
+				proofrequest.TypeEQ(proofType),
+				proofrequest.StartBlock(start),
+				proofrequest.EndBlock(end),
+			).
+			Exist(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query children of proof request %d: %w", parent.ID, err)
+		}
+		if exists {
+			return 0, nil
+		}
+	}
+
+	ranges := [][2]uint64{{start, end}}
+	if proofType == proofrequest.TypeSPAN {
+		var err error
+		ranges, err = uncoveredSpanRanges(ctx, client, start, end, extra...)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	now := uint64(time.Now().Unix())
+	created := 0
+	for _, r := range ranges {
+		create := client.
+			Create().
+			SetType(proofType).
+			SetStartBlock(r[0]).
+			SetEndBlock(r[1]).
+			SetStatus(proofrequest.StatusUNREQ).
+			SetRequestAddedTime(now).
+			SetLastUpdatedTime(now).
+			SetBackfill(backfill)
+		if parent != nil {
+			// Replacements keep the operator labels of the request they replace.
+			create.SetParentID(parent.ID)
+			if len(parent.Labels) > 0 {
+				create.SetLabels(parent.Labels)
+			}
+		}
+		_, err := create.Save(ctx)
+		// Another request for the range is already active, e.g. because a concurrent tick queued it first.
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to create new entry: %w", err)
+		}
+		created++
+	}
+	return created, nil
+}
+
+// uncoveredSpanRanges returns the sub-ranges of [start, end] that aren't covered by any span proof request that
+// hasn't failed (and matches the extra predicates, if any).
+func uncoveredSpanRanges(ctx context.Context, client *ent.ProofRequestClient, start, end uint64, extra ...predicate.ProofRequest) ([][2]uint64, error) {
+	existing, err := client.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusNEQ(proofrequest.StatusFAILED),
+			proofrequest.StartBlockLT(end),
+			proofrequest.EndBlockGT(start),
+		).
+		Where(extra...).
+		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query overlapping span proofs: %w", err)
+	}
+
+	var gaps [][2]uint64
+	cursor := start
+	for _, p := range existing {
+		if p.StartBlock > cursor {
+			gaps = append(gaps, [2]uint64{cursor, p.StartBlock})
+		}
+		if p.EndBlock > cursor {
+			cursor = p.EndBlock
+		}
+	}
+	if cursor < end {
+		gaps = append(gaps, [2]uint64{cursor, end})
+	}
+	return gaps, nil
+}
+
 // UpdateProofStatus updates the status of a proof request in the database.
 func (db *ProofDB) UpdateProofStatus(id int, proofStatus proofrequest.Status) error {
 	_, err := db.writeClient.ProofRequest.Update().
@@ -110,86 +385,767 @@ func (db *ProofDB) UpdateProofStatus(id int, proofStatus proofrequest.Status) er
 		SetLastUpdatedTime(uint64(time.Now().Unix())).
 		Save(context.Background())
 
-	return err
+	return err
+}
+
+// ClaimUnrequestedProofs moves the proof requests with the given IDs that are still UNREQ to WITNESSGEN in a single
+// transaction, before they're requested in the background, so that the next tick doesn't select them again. Returns
+// the IDs of the claimed proof requests.
+func (db *ProofDB) ClaimUnrequestedProofs(ids []int) (_ []int, err error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	claimed, err := tx.ProofRequest.Query().
+		Where(proofrequest.IDIn(ids...), proofrequest.StatusEQ(proofrequest.StatusUNREQ)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unrequested proofs: %w", err)
+	}
+	_, err = tx.ProofRequest.Update().
+		Where(proofrequest.IDIn(claimed...)).
+		SetStatus(proofrequest.StatusWITNESSGEN).
+		SetLastUpdatedTime(uint64(time.Now().Unix())).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim unrequested proofs: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return claimed, nil
+}
+
+// Failure describes why a proof request failed.
+type Failure struct {
+	// The stage of the proof's lifecycle that failed: requesting it, proving it, or verifying it before submission.
+	Stage proofrequest.FailureStage
+	// The error code reported by the OP Succinct server, or derived from the proposer's error.
+	Code string
+	// The error message.
+	Reason string
+}
+
+// FailWithoutRetry sets a proof request to FAILED for good, e.g. because other requests already cover its range, so
+// that it isn't retried like a request that failed before reaching the prover network. The failure is recorded if
+// it's not nil.
+func (db *ProofDB) FailWithoutRetry(id int, failure *Failure) error {
+	update := db.writeClient.ProofRequest.UpdateOneID(id).
+		SetStatus(proofrequest.StatusFAILED).
+		SetFinal(true).
+		SetLastUpdatedTime(uint64(time.Now().Unix()))
+	if failure != nil {
+		setFailure(update, *failure)
+	}
+	if _, err := update.Save(context.Background()); err != nil {
+		return fmt.Errorf("failed to set proof status to failed: %w", err)
+	}
+	return nil
+}
+
+// SetFailure records why a proof request failed.
+func (db *ProofDB) SetFailure(id int, failure Failure) error {
+	_, err := setFailure(db.writeClient.ProofRequest.UpdateOneID(id), failure).Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to set failure reason: %w", err)
+	}
+	return nil
+}
+
+// setFailure adds the fields of a failure to a proof request update.
+func setFailure(update *ent.ProofRequestUpdateOne, failure Failure) *ent.ProofRequestUpdateOne {
+	update.SetFailureReason(failure.Reason).SetFailureStage(failure.Stage)
+	if failure.Code != "" {
+		update.SetErrorCode(failure.Code)
+	} else {
+		update.ClearErrorCode()
+	}
+	return update
+}
+
+// SetChallengeStatus records the status of the challenge against the dispute game that proposed the output at the given
+// L2 block on the completed agg proofs that end at that block. Returns the number of updated agg proofs.
+func (db *ProofDB) SetChallengeStatus(endBlock uint64, status proofrequest.ChallengeStatus) (int, error) {
+	n, err := db.writeClient.ProofRequest.Update().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+			proofrequest.EndBlockEQ(endBlock),
+		).
+		SetChallengeStatus(status).
+		Save(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("failed to set challenge status: %w", err)
+	}
+	return n, nil
+}
+
+// AddLabels attaches the given operator labels to the proof requests with the given IDs. Labels that a request already
+// has aren't added twice.
+func (db *ProofDB) AddLabels(ids []int, labels []string) error {
+	return db.updateLabels(ids, func(existing []string) []string {
+		for _, label := range labels {
+			if !slices.Contains(existing, label) {
+				existing = append(existing, label)
+			}
+		}
+		return existing
+	})
+}
+
+// RemoveLabels removes the given operator labels from the proof requests with the given IDs.
+func (db *ProofDB) RemoveLabels(ids []int, labels []string) error {
+	return db.updateLabels(ids, func(existing []string) []string {
+		return slices.DeleteFunc(existing, func(label string) bool { return slices.Contains(labels, label) })
+	})
+}
+
+// updateLabels replaces the labels of the proof requests with the given IDs with the result of update.
+func (db *ProofDB) updateLabels(ids []int, update func(existing []string) []string) (err error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	proofs, err := tx.ProofRequest.Query().
+		Where(proofrequest.IDIn(ids...)).
+		Select(proofrequest.FieldID, proofrequest.FieldLabels).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query proof requests: %w", err)
+	}
+	if len(proofs) != len(ids) {
+		return fmt.Errorf("found %d of %d proof requests to label", len(proofs), len(ids))
+	}
+	for _, p := range proofs {
+		labels := update(p.Labels)
+		if len(labels) == 0 {
+			err = tx.ProofRequest.UpdateOneID(p.ID).ClearLabels().Exec(ctx)
+		} else {
+			err = tx.ProofRequest.UpdateOneID(p.ID).SetLabels(labels).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update labels of proof request %d: %w", p.ID, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// CountLabeledProofRequests returns the number of proof requests with each label, by status.
+func (db *ProofDB) CountLabeledProofRequests() (map[string]map[proofrequest.Status]int, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(proofrequest.LabelsNotNil()).
+		Select(proofrequest.FieldStatus, proofrequest.FieldLabels).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query labeled proof requests: %w", err)
+	}
+
+	counts := make(map[string]map[proofrequest.Status]int)
+	for _, p := range proofs {
+		for _, label := range p.Labels {
+			if counts[label] == nil {
+				counts[label] = make(map[proofrequest.Status]int)
+			}
+			counts[label][p.Status]++
+		}
+	}
+	return counts, nil
+}
+
+// SetProgramVersion records the hardfork whose range program a span proof was requested for.
+func (db *ProofDB) SetProgramVersion(id int, version string) error {
+	_, err := db.writeClient.ProofRequest.Update().
+		Where(proofrequest.ID(id)).
+		SetProgramVersion(version).
+		Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to set program version: %w", err)
+	}
+	return nil
+}
+
+// SetProverRequestID sets the prover request ID for a proof request in the database.
+//
+// The server can return a prover request ID that another entry already tracks, e.g. if it deduplicates requests. If
+// the other entry is active or complete, and proves the same range, the proof request is marked as FAILED for good (see
+// FailWithoutRetry) and linked to it instead, so that the two entries don't poll the same ID and race on its status,
+// and the linked entry isn't retried like a request that failed on the server. The ID of the entry that
+// tracks the prover request ID is returned. If the other entry proves a different range, a
+// *DuplicateProverRequestIDError is returned.
+func (db *ProofDB) SetProverRequestID(id int, proverRequestID string) (_ int, err error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	p, err := tx.ProofRequest.Get(ctx, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find proof request: %w", err)
+	}
+	existing, err := tx.ProofRequest.Query().
+		Where(
+			proofrequest.ProverRequestIDEQ(proverRequestID),
+			proofrequest.IDNEQ(id),
+			proofrequest.StatusIn(proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING, proofrequest.StatusCOMPLETE),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to query proof requests with the same prover request ID: %w", err)
+	}
+
+	now := uint64(time.Now().Unix())
+	linkedID := id
+	if existing != nil {
+		if existing.Type != p.Type || existing.StartBlock != p.StartBlock || existing.EndBlock != p.EndBlock {
+			return 0, &DuplicateProverRequestIDError{ProverRequestID: proverRequestID, ID: id, ExistingID: existing.ID}
+		}
+		// The prover request ID isn't set, so that the entry isn't polled or cancelled along with the existing one.
+		_, err = tx.ProofRequest.UpdateOneID(id).
+			SetStatus(proofrequest.StatusFAILED).
+			SetFinal(true).
+			SetLastUpdatedTime(now).
+			Save(ctx)
+		linkedID = existing.ID
+	} else {
+		_, err = tx.ProofRequest.UpdateOneID(id).
+			SetProverRequestID(proverRequestID).
+			SetProofRequestTime(now).
+			SetLastUpdatedTime(now).
+			Save(ctx)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to set prover network id: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return linkedID, nil
+}
+
+// DuplicateProverRequestIDError is returned when the server returns a prover request ID that is already tracked by an
+// entry for a different range.
+type DuplicateProverRequestIDError struct {
+	ProverRequestID string
+	ID              int
+	ExistingID      int
+}
+
+func (e *DuplicateProverRequestIDError) Error() string {
+	return fmt.Sprintf("prover request ID %s of proof request %d is already used by proof request %d for a different range", e.ProverRequestID, e.ID, e.ExistingID)
+}
+
+// SetProofEstimate records the estimated cycle count and fee of a proof request in the database.
+func (db *ProofDB) SetProofEstimate(id int, cycles, fee uint64) error {
+	_, err := db.writeClient.ProofRequest.Update().
+		Where(proofrequest.ID(id)).
+		SetEstimatedCycles(cycles).
+		SetEstimatedFee(fee).
+		SetLastUpdatedTime(uint64(time.Now().Unix())).
+		Save(context.Background())
+
+	if err != nil {
+		return fmt.Errorf("failed to set proof estimate: %w", err)
+	}
+
+	return nil
+}
+
+// ProofFormat describes the proof system that generated a proof, the verification key of the program, and how the
+// proof bytes are encoded.
+type ProofFormat struct {
+	ProofSystem string
+	VkeyHash    string
+	Encoding    string
+}
+
+// FulfillmentMetadata describes how a proof was fulfilled by the prover network, as reported by the OP Succinct
+// server. Fields that the server didn't report are left at zero.
+type FulfillmentMetadata struct {
+	Cycles uint64
+	Fee    uint64
+	// The prover that fulfilled the proof.
+	Prover string
+	// The Unix time the proof was fulfilled at.
+	FulfilledTime uint64
+}
+
+// AddFulfilledProof adds a proof to a proof request in the database and sets the status to COMPLETE.
+func (db *ProofDB) AddFulfilledProof(id int, proof []byte, format ProofFormat) error {
+	// Start a transaction
+	tx, err := db.writeClient.Tx(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Query the existing proof request
+	existingProof, err := tx.ProofRequest.
+		Query().
+		Where(proofrequest.ID(id)).
+		Only(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to find existing proof: %w", err)
+	}
+	if err := checkFulfillable(existingProof); err != nil {
+		return err
+	}
+	if err := db.setFulfilledProof(context.Background(), tx, existingProof, ProofUpdate{ID: id, Proof: proof, Format: format}); err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Check that a proof can be added to the proof request.
+func checkFulfillable(existingProof *ent.ProofRequest) error {
+	// Check if the status is PROVING.
+	if existingProof.Status != proofrequest.StatusPROVING {
+		return fmt.Errorf("proof request status is not PROVING: %v", existingProof.ID)
+	}
+
+	// Check if the proof is already set.
+	if existingProof.Proof != nil {
+		return fmt.Errorf("proof is already set: %v", existingProof.ID)
+	}
+	return nil
+}
+
+// Add the proof to the proof request and set the status to COMPLETE, as part of the transaction.
+func (db *ProofDB) setFulfilledProof(ctx context.Context, tx *ent.Tx, existingProof *ent.ProofRequest, u ProofUpdate) error {
+	stored, err := db.encodeProof(u.Proof)
+	if err != nil {
+		return err
+	}
+	format, metadata := u.Format, u.Metadata
+	update := tx.ProofRequest.
+		UpdateOne(existingProof).
+		SetProof(stored).
+		SetStatus(proofrequest.StatusCOMPLETE).
+		SetLastUpdatedTime(uint64(time.Now().Unix()))
+	if format.ProofSystem != "" {
+		update.SetProofSystem(format.ProofSystem)
+	}
+	if format.VkeyHash != "" {
+		update.SetVkeyHash(format.VkeyHash)
+	}
+	if format.Encoding != "" {
+		update.SetProofFormat(format.Encoding)
+	}
+	if metadata.Cycles != 0 {
+		update.SetFulfilledCycles(metadata.Cycles)
+	}
+	if metadata.Fee != 0 {
+		update.SetFulfilledFee(metadata.Fee)
+	}
+	if metadata.Prover != "" {
+		update.SetProver(metadata.Prover)
+	}
+	if metadata.FulfilledTime != 0 {
+		update.SetFulfilledTime(metadata.FulfilledTime)
+	}
+	if u.PublicValues != nil {
+		update.SetPublicValues(u.PublicValues)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("failed to update proof and status: %w", err)
+	}
+	return nil
+}
+
+// ProofUpdate is the outcome of a proof request, applied by ApplyProofUpdates.
+type ProofUpdate struct {
+	ID int
+	// The proof of a fulfilled request. If nil, the request failed and is retried.
+	Proof    []byte
+	Format   ProofFormat
+	Metadata FulfillmentMetadata
+	// The public values committed by the proof, if the server reported them.
+	PublicValues []byte
+	// Why the request failed, if it did.
+	Failure *Failure
+}
+
+// ApplyProofUpdates applies the outcomes of a batch of proof requests in a single transaction, so that the pending
+// proof loop holds the write lock once per iteration instead of once per proof. Fulfilled proofs are added and set to
+// COMPLETE. Failed requests are set to FAILED and re-queued like in NewEntry (or NewBackfillEntry for backfill
+// requests).
+//
+// Updates that no longer apply, such as a fulfilled proof whose request was cancelled in the meantime, are skipped
+// and reported in the returned error. The other updates are still committed.
+func (db *ProofDB) ApplyProofUpdates(updates []ProofUpdate) (err error) {
+	ctx := context.Background()
+	tx, err := db.writeClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var skipped []error
+	now := uint64(time.Now().Unix())
+	for _, u := range updates {
+		existingProof, err := tx.ProofRequest.Get(ctx, u.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find existing proof: %w", err)
+		}
+
+		if u.Proof != nil {
+			if err := checkFulfillable(existingProof); err != nil {
+				skipped = append(skipped, err)
+				continue
+			}
+			if err := db.setFulfilledProof(ctx, tx, existingProof, u); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if existingProof.Status == proofrequest.StatusCOMPLETE {
+			skipped = append(skipped, fmt.Errorf("proof request %d can't be retried with status %s", u.ID, existingProof.Status))
+			continue
+		}
+		update := tx.ProofRequest.UpdateOne(existingProof).
+			SetStatus(proofrequest.StatusFAILED).
+			SetLastUpdatedTime(now)
+		if u.Failure != nil {
+			setFailure(update, *u.Failure)
+		}
+		_, err = update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to set proof status to failed: %w", err)
+		}
+		var extra []predicate.ProofRequest
+		if existingProof.Backfill {
+			extra = append(extra, proofrequest.RequestAddedTimeGTE(existingProof.RequestAddedTime))
+		}
+		_, err = createEntries(ctx, tx.ProofRequest, existingProof.Type, existingProof.StartBlock, existingProof.EndBlock, existingProof.Backfill, existingProof, extra...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return errors.Join(skipped...)
+}
+
+// GetArchivableProofs returns up to limit COMPLETE and FAILED proof requests that were added before the given Unix
+// time, oldest first. Failed requests that are still to be retried (see GetProofsFailedOnServer) aren't returned.
+// Complete proofs are only returned once they end at or below the given block, i.e. once they're no longer needed to
+// build or submit an output, and once they were also fulfilled before the given time, so that their fees are kept
+// for the proving budgets. The returned proofs are decompressed, but proofs encrypted at rest are returned as
+// stored, i.e. still encrypted.
+func (db *ProofDB) GetArchivableProofs(before, block uint64, limit int) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.RequestAddedTimeLT(before),
+			proofrequest.Or(
+				proofrequest.And(
+					proofrequest.StatusEQ(proofrequest.StatusFAILED),
+					proofrequest.Not(awaitingRetry()),
+				),
+				proofrequest.And(
+					proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+					proofrequest.EndBlockLTE(block),
+					proofrequest.LastUpdatedTimeLT(before),
+				),
+			),
+		).
+		Order(ent.Asc(proofrequest.FieldRequestAddedTime), ent.Asc(proofrequest.FieldID)).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archivable proofs: %w", err)
+	}
+	for _, p := range proofs {
+		// Encrypted proofs stay encrypted, so that the archive is as protected as the DB.
+		if isEncryptedProof(p.Proof) {
+			continue
+		}
+		if p.Proof, err = db.decodeProof(p.Proof); err != nil {
+			return nil, err
+		}
+	}
+	return proofs, nil
+}
+
+// DeleteProofRequests deletes the proof requests with the given IDs.
+func (db *ProofDB) DeleteProofRequests(ids []int) error {
+	_, err := db.writeClient.ProofRequest.Delete().
+		Where(proofrequest.IDIn(ids...)).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to delete proof requests: %w", err)
+	}
+	return nil
+}
+
+// GetProofRequest returns the proof request with the given ID.
+func (db *ProofDB) GetProofRequest(id int) (*ent.ProofRequest, error) {
+	proof, err := db.readClient.ProofRequest.Get(context.Background(), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof request %d: %w", id, err)
+	}
+	return proof, nil
+}
+
+// GetProofRequestMetadata returns the proof request with the given ID, without its proof.
+func (db *ProofDB) GetProofRequestMetadata(id int) (*ent.ProofRequest, error) {
+	proof, err := db.readClient.ProofRequest.Query().
+		Where(proofrequest.ID(id)).
+		Select(columnsWithoutProof()...).
+		Only(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof request %d: %w", id, err)
+	}
+	return proof, nil
+}
+
+// GetProofRequestsMetadata returns the proof requests with the given IDs, without their proofs. IDs without a request
+// are skipped.
+func (db *ProofDB) GetProofRequestsMetadata(ids []int) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(proofrequest.IDIn(ids...)).
+		Select(columnsWithoutProof()...).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof requests: %w", err)
+	}
+	return proofs, nil
+}
+
+// GetRetryTree returns the retry tree that the proof request with the given ID is part of: the root request that was
+// originally queued, and all requests that replaced it or its replacements, in the order they were added.
+func (db *ProofDB) GetRetryTree(id int) ([]*ent.ProofRequest, error) {
+	ctx := context.Background()
+	root, err := db.readClient.ProofRequest.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof request %d: %w", id, err)
+	}
+	for root.ParentID != 0 {
+		parent, err := db.readClient.ProofRequest.Get(ctx, root.ParentID)
+		// Parents that were archived end the tree.
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent proof request %d: %w", root.ParentID, err)
+		}
+		root = parent
+	}
+
+	tree := []*ent.ProofRequest{root}
+	parents := []int{root.ID}
+	for len(parents) > 0 {
+		children, err := db.readClient.ProofRequest.Query().
+			Where(proofrequest.ParentIDIn(parents...)).
+			Order(ent.Asc(proofrequest.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query children of proof requests: %w", err)
+		}
+		parents = parents[:0]
+		for _, child := range children {
+			tree = append(tree, child)
+			parents = append(parents, child.ID)
+		}
+	}
+	return tree, nil
 }
 
-// SetProverRequestID sets the prover request ID for a proof request in the database.
-func (db *ProofDB) SetProverRequestID(id int, proverRequestID string) error {
-	_, err := db.writeClient.ProofRequest.Update().
-		Where(proofrequest.ID(id)).
-		SetProverRequestID(proverRequestID).
-		SetProofRequestTime(uint64(time.Now().Unix())).
-		SetLastUpdatedTime(uint64(time.Now().Unix())).
-		Save(context.Background())
+// GetNumberOfProofsWithStatuses returns the number of proofs with the given status(es).
+func (db *ProofDB) GetNumberOfRequestsWithStatuses(statuses ...proofrequest.Status) (int, error) {
+	count, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusIn(statuses...),
+		).
+		Count(context.Background())
 
 	if err != nil {
-		return fmt.Errorf("failed to set prover network id: %w", err)
+		return 0, fmt.Errorf("failed to count requests with statuses %v: %w", statuses, err)
 	}
 
-	return nil
+	return count, nil
 }
 
-// AddFulfilledProof adds a proof to a proof request in the database and sets the status to COMPLETE.
-func (db *ProofDB) AddFulfilledProof(id int, proof []byte) error {
-	// Start a transaction
-	tx, err := db.writeClient.Tx(context.Background())
+// GetNumberOfRequestsOfTypeWithStatuses returns the number of proofs of the given type with the given status(es).
+func (db *ProofDB) GetNumberOfRequestsOfTypeWithStatuses(proofType proofrequest.Type, statuses ...proofrequest.Status) (int, error) {
+	count, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofType),
+			proofrequest.StatusIn(statuses...),
+		).
+		Count(context.Background())
+
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return 0, fmt.Errorf("failed to count %s requests with statuses %v: %w", proofType, statuses, err)
 	}
-	defer tx.Rollback()
 
-	// Query the existing proof request
-	existingProof, err := tx.ProofRequest.
-		Query().
-		Where(proofrequest.ID(id)).
-		Only(context.Background())
+	return count, nil
+}
+
+// GetProvingSpendSince returns the sum of the fees reported for the proofs fulfilled at or after the given Unix time.
+// Proofs are dated by the fulfillment time that the prover network reported, or by their last update if it didn't
+// report one, so that later updates to a fulfilled proof don't move its fee into a later budget window.
+func (db *ProofDB) GetProvingSpendSince(since uint64) (uint64, error) {
+	fees, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+			proofrequest.Or(
+				proofrequest.FulfilledTimeGTE(since),
+				proofrequest.And(proofrequest.FulfilledTimeIsNil(), proofrequest.LastUpdatedTimeGTE(since)),
+			),
+			proofrequest.FulfilledFeeNotNil(),
+		).
+		Select(proofrequest.FieldFulfilledFee).
+		Ints(context.Background())
 	if err != nil {
-		return fmt.Errorf("failed to find existing proof: %w", err)
+		return 0, fmt.Errorf("failed to query fulfilled fees: %w", err)
 	}
 
-	// Check if the status is PROVING.
-	if existingProof.Status != proofrequest.StatusPROVING {
-		return fmt.Errorf("proof request status is not PROVING: %v", id)
+	var spend uint64
+	for _, fee := range fees {
+		spend += uint64(fee)
 	}
+	return spend, nil
+}
 
-	// Check if the proof is already set.
-	if existingProof.Proof != nil {
-		return fmt.Errorf("proof is already set: %v", id)
+// GetRequestedProofsBetween returns the proof requests of any status that were sent to the prover network in the
+// time range [since, until), for reconciliation against the network's billing.
+func (db *ProofDB) GetRequestedProofsBetween(since, until uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.ProverRequestIDNEQ(""),
+			proofrequest.ProofRequestTimeGTE(since),
+			proofrequest.ProofRequestTimeLT(until),
+		).
+		Order(ent.Asc(proofrequest.FieldProofRequestTime)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query requested proofs: %w", err)
 	}
+	return proofs, nil
+}
 
-	// Update the proof and status
-	_, err = tx.ProofRequest.
-		UpdateOne(existingProof).
-		SetProof(proof).
-		SetStatus(proofrequest.StatusCOMPLETE).
-		SetLastUpdatedTime(uint64(time.Now().Unix())).
-		Save(context.Background())
+// ProofRequestFilter selects the proof requests returned by ListProofRequests. Zero fields don't filter.
+type ProofRequestFilter struct {
+	Status proofrequest.Status
+	Type   proofrequest.Type
+	// Only requests whose block range overlaps [StartBlock, EndBlock).
+	StartBlock uint64
+	EndBlock   uint64
+	// Only requests added in the time range [Since, Until), in Unix time.
+	Since uint64
+	Until uint64
+	// Only requests with the given operator label.
+	Label string
+}
 
-	if err != nil {
-		return fmt.Errorf("failed to update proof and status: %w", err)
+// ListProofRequests returns up to limit proof requests that match the filter, newest first. Pages are continued by
+// passing the ID of the last request of the previous page as the cursor, or 0 for the first page. The proofs of the
+// requests aren't loaded.
+func (db *ProofDB) ListProofRequests(filter ProofRequestFilter, cursor, limit int) ([]*ent.ProofRequest, error) {
+	query := db.readClient.ProofRequest.Query()
+	if filter.Status != "" {
+		query.Where(proofrequest.StatusEQ(filter.Status))
 	}
-
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if filter.Type != "" {
+		query.Where(proofrequest.TypeEQ(filter.Type))
+	}
+	if filter.StartBlock != 0 {
+		query.Where(proofrequest.EndBlockGT(filter.StartBlock))
+	}
+	if filter.EndBlock != 0 {
+		query.Where(proofrequest.StartBlockLT(filter.EndBlock))
+	}
+	if filter.Since != 0 {
+		query.Where(proofrequest.RequestAddedTimeGTE(filter.Since))
+	}
+	if filter.Until != 0 {
+		query.Where(proofrequest.RequestAddedTimeLT(filter.Until))
+	}
+	if filter.Label != "" {
+		query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(proofrequest.FieldLabels, filter.Label))
+		})
+	}
+	if cursor != 0 {
+		query.Where(proofrequest.IDLT(cursor))
 	}
 
-	return nil
+	proofs, err := query.
+		Order(ent.Desc(proofrequest.FieldID)).
+		Limit(limit).
+		Select(columnsWithoutProof()...).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proof requests: %w", err)
+	}
+	return proofs, nil
 }
 
-// GetNumberOfProofsWithStatuses returns the number of proofs with the given status(es).
-func (db *ProofDB) GetNumberOfRequestsWithStatuses(statuses ...proofrequest.Status) (int, error) {
-	count, err := db.readClient.ProofRequest.Query().
+// GetProofRequestsCovering returns the proof requests that prove the given L2 block, i.e. whose range (StartBlock,
+// EndBlock] contains it, newest first. Their proofs are left out.
+func (db *ProofDB) GetProofRequestsCovering(block uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
 		Where(
-			proofrequest.StatusIn(statuses...),
+			proofrequest.StartBlockLT(block),
+			proofrequest.EndBlockGTE(block),
 		).
-		Count(context.Background())
-
+		Order(ent.Desc(proofrequest.FieldID)).
+		Select(columnsWithoutProof()...).
+		All(context.Background())
 	if err != nil {
-		return 0, fmt.Errorf("failed to count requests with statuses %v: %w", statuses, err)
+		return nil, fmt.Errorf("failed to get proof requests covering block %d: %w", block, err)
 	}
+	return proofs, nil
+}
 
-	return count, nil
+// columnsWithoutProof returns the proof request columns except the proof, which is large and not needed for listings.
+func columnsWithoutProof() []string {
+	var fields []string
+	for _, column := range proofrequest.Columns {
+		if column != proofrequest.FieldProof {
+			fields = append(fields, column)
+		}
+	}
+	return fields
 }
 
 // AddL1BlockInfoToAggRequest adds the L1 block info to the existing AGG proof request.
@@ -234,6 +1190,25 @@ func (db *ProofDB) AddL1BlockInfoToAggRequest(startBlock, endBlock, l1BlockNumbe
 	return updatedProof, nil
 }
 
+// GetLatestCheckpointedL1Block returns the most recent L1 block number and hash that was checkpointed for an AGG proof.
+// Returns 0 and an empty hash if no block hash has been checkpointed yet.
+func (db *ProofDB) GetLatestCheckpointedL1Block() (uint64, string, error) {
+	proof, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.L1BlockHashNEQ(""),
+		).
+		Order(ent.Desc(proofrequest.FieldL1BlockNumber)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, "", nil
+		}
+		return 0, "", fmt.Errorf("failed to get latest checkpointed L1 block: %w", err)
+	}
+	return proof.L1BlockNumber, proof.L1BlockHash, nil
+}
+
 // GetLatestEndBlock returns the latest end block of a proof request in the database.
 func (db *ProofDB) GetLatestEndBlock() (uint64, error) {
 	maxEnd, err := db.readClient.ProofRequest.Query().
@@ -271,13 +1246,11 @@ func (db *ProofDB) GetWitnessGenerationTimeoutProofsOnServer() ([]*ent.ProofRequ
 }
 
 // If a proof failed to be sent to the prover network, it's status will be set to FAILED, but the prover request ID will be empty.
-// This function returns all such proofs.
+// This function returns all such proofs that still need to be retried: proofs that were already replaced by a retry,
+// or that failed for good (see FailWithoutRetry), are skipped.
 func (db *ProofDB) GetProofsFailedOnServer() ([]*ent.ProofRequest, error) {
 	proofs, err := db.readClient.ProofRequest.Query().
-		Where(
-			proofrequest.StatusEQ(proofrequest.StatusFAILED),
-			proofrequest.ProverRequestIDEQ(""),
-		).
+		Where(awaitingRetry()).
 		All(context.Background())
 
 	if err != nil {
@@ -286,10 +1259,35 @@ func (db *ProofDB) GetProofsFailedOnServer() ([]*ent.ProofRequest, error) {
 		}
 		return nil, fmt.Errorf("failed to query failed proof: %w", err)
 	}
-
 	return proofs, nil
 }
 
+// awaitingRetry matches the proof requests that GetProofsFailedOnServer returns.
+func awaitingRetry() predicate.ProofRequest {
+	return proofrequest.And(
+		proofrequest.StatusEQ(proofrequest.StatusFAILED),
+		// New requests have no prover request ID until they're sent to the prover network.
+		proofrequest.Or(
+			proofrequest.ProverRequestIDIsNil(),
+			proofrequest.ProverRequestIDEQ(""),
+		),
+		proofrequest.FinalEQ(false),
+		proofrequest.Not(hasRetry()),
+	)
+}
+
+// hasRetry matches the proof requests that were replaced by a retry, i.e. that have children.
+func hasRetry() predicate.ProofRequest {
+	return func(s *sql.Selector) {
+		children := sql.Table(proofrequest.Table).As("children")
+		s.Where(sql.Exists(
+			sql.Select(children.C(proofrequest.FieldID)).
+				From(children).
+				Where(sql.ColumnsEQ(children.C(proofrequest.FieldParentID), s.C(proofrequest.FieldID))),
+		))
+	}
+}
+
 // Get all pending proofs with a status of requested and a prover ID that is not empty.
 func (db *ProofDB) GetAllPendingProofs() ([]*ent.ProofRequest, error) {
 	proofs, err := db.readClient.ProofRequest.Query().
@@ -304,6 +1302,144 @@ func (db *ProofDB) GetAllPendingProofs() ([]*ent.ProofRequest, error) {
 	return proofs, nil
 }
 
+// GetOldestPendingProof returns the proof request that has been waiting the longest to be completed, or nil if no
+// proof is pending.
+func (db *ProofDB) GetOldestPendingProof() (*ent.ProofRequest, error) {
+	proof, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusIn(proofrequest.StatusUNREQ, proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING),
+		).
+		Order(ent.Asc(proofrequest.FieldRequestAddedTime), ent.Asc(proofrequest.FieldID)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query oldest pending proof: %w", err)
+	}
+	return proof, nil
+}
+
+// GetOrphanedProvingProofs returns the proofs that are marked as PROVING, but have no prover request ID. These are
+// left behind if the proposer stops between requesting a proof and recording its prover request ID.
+func (db *ProofDB) GetOrphanedProvingProofs() ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusPROVING),
+			proofrequest.Or(
+				proofrequest.ProverRequestIDIsNil(),
+				proofrequest.ProverRequestIDEQ(""),
+			),
+		).
+		All(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orphaned proofs: %w", err)
+	}
+	return proofs, nil
+}
+
+// GetCoveredUnrequestedSpanProofs returns the unrequested span proofs whose range is already fully covered by span
+// proofs that were requested or completed, or by unrequested span proofs queued before them. Only counting earlier
+// unrequested span proofs keeps one of two overlapping unrequested span proofs, instead of failing both.
+func (db *ProofDB) GetCoveredUnrequestedSpanProofs() ([]*ent.ProofRequest, error) {
+	ctx := context.Background()
+	unrequested, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+			proofrequest.BackfillEQ(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unrequested span proofs: %w", err)
+	}
+
+	var covered []*ent.ProofRequest
+	for _, p := range unrequested {
+		gaps, err := uncoveredSpanRanges(ctx, db.readClient.ProofRequest, p.StartBlock, p.EndBlock,
+			proofrequest.Or(
+				proofrequest.StatusIn(proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING, proofrequest.StatusCOMPLETE),
+				proofrequest.And(proofrequest.StatusEQ(proofrequest.StatusUNREQ), proofrequest.IDLT(p.ID)),
+			))
+		if err != nil {
+			return nil, err
+		}
+		if len(gaps) == 0 {
+			covered = append(covered, p)
+		}
+	}
+	return covered, nil
+}
+
+// GetStaleAggProofs returns the AGG proofs that haven't completed or failed, but start below the given block. These
+// can never be submitted, as the L2OO contract already has an output past their start block.
+func (db *ProofDB) GetStaleAggProofs(block uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StatusIn(proofrequest.StatusUNREQ, proofrequest.StatusWITNESSGEN, proofrequest.StatusPROVING),
+			proofrequest.StartBlockLT(block),
+			proofrequest.BackfillEQ(false),
+		).
+		All(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stale AGG proofs: %w", err)
+	}
+	return proofs, nil
+}
+
+// GetSupersededSpanProofs returns all span proofs that are being proven, but only cover blocks up to the given block.
+func (db *ProofDB) GetSupersededSpanProofs(block uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+			proofrequest.StatusEQ(proofrequest.StatusPROVING),
+			proofrequest.EndBlockLTE(block),
+			proofrequest.BackfillEQ(false),
+		).
+		All(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query superseded span proofs: %w", err)
+	}
+	return proofs, nil
+}
+
+// GetBackfillProofs returns the backfill proof requests of the given type within the range [start, end] that were
+// added at or after since.
+func (db *ProofDB) GetBackfillProofs(proofType proofrequest.Type, start, end, since uint64) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofType),
+			proofrequest.BackfillEQ(true),
+			proofrequest.StartBlockGTE(start),
+			proofrequest.EndBlockLTE(end),
+			proofrequest.RequestAddedTimeGTE(since),
+		).
+		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		All(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backfill %s proofs: %w", proofType, err)
+	}
+	return proofs, nil
+}
+
+// IsSpanRangeProven returns whether the range [start, end] is covered by completed span proofs that were added at or
+// after since.
+func (db *ProofDB) IsSpanRangeProven(start, end, since uint64) (bool, error) {
+	gaps, err := uncoveredSpanRanges(context.Background(), db.readClient.ProofRequest, start, end,
+		proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+		proofrequest.RequestAddedTimeGTE(since),
+	)
+	if err != nil {
+		return false, err
+	}
+	return len(gaps) == 0, nil
+}
+
 // GetAllProofsWithStatus returns all proofs with the given status.
 func (db *ProofDB) GetAllProofsWithStatus(status proofrequest.Status) ([]*ent.ProofRequest, error) {
 	proofs, err := db.readClient.ProofRequest.Query().
@@ -330,7 +1466,7 @@ func (db *ProofDB) GetNextUnrequestedProof() (*ent.ProofRequest, error) {
 			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
 			proofrequest.TypeEQ(proofrequest.TypeAGG),
 		).
-		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		Order(ent.Asc(proofrequest.FieldBackfill), ent.Asc(proofrequest.FieldStartBlock)).
 		First(context.Background())
 
 	if err == nil {
@@ -347,7 +1483,7 @@ func (db *ProofDB) GetNextUnrequestedProof() (*ent.ProofRequest, error) {
 			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
 			proofrequest.TypeEQ(proofrequest.TypeSPAN),
 		).
-		Order(ent.Asc(proofrequest.FieldStartBlock)).
+		Order(ent.Asc(proofrequest.FieldBackfill), ent.Asc(proofrequest.FieldStartBlock)).
 		First(context.Background())
 
 	if err != nil {
@@ -362,6 +1498,24 @@ func (db *ProofDB) GetNextUnrequestedProof() (*ent.ProofRequest, error) {
 	return spanProof, nil
 }
 
+// GetNextUnrequestedSpanProofs returns up to limit unrequested SPAN proofs, ordered by start block.
+func (db *ProofDB) GetNextUnrequestedSpanProofs(limit int) ([]*ent.ProofRequest, error) {
+	proofs, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusUNREQ),
+			proofrequest.TypeEQ(proofrequest.TypeSPAN),
+		).
+		Order(ent.Asc(proofrequest.FieldBackfill), ent.Asc(proofrequest.FieldStartBlock)).
+		Limit(limit).
+		All(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SPAN unrequested proofs: %w", err)
+	}
+
+	return proofs, nil
+}
+
 // GetAllCompletedAggProofs returns all completed AGG proofs for a given start block.
 func (db *ProofDB) GetAllCompletedAggProofs(startBlock uint64) ([]*ent.ProofRequest, error) {
 	proofs, err := db.readClient.ProofRequest.Query().
@@ -379,9 +1533,35 @@ func (db *ProofDB) GetAllCompletedAggProofs(startBlock uint64) ([]*ent.ProofRequ
 		return nil, fmt.Errorf("failed to query completed AGG proof: %w", err)
 	}
 
+	for _, p := range proofs {
+		if p.Proof, err = db.decodeProof(p.Proof); err != nil {
+			return nil, err
+		}
+	}
+
 	return proofs, nil
 }
 
+// GetActiveAggProof returns the AGG proof with the given start block that is queued, in progress or completed. Returns
+// nil if there is no such AGG proof.
+func (db *ProofDB) GetActiveAggProof(startBlock uint64) (*ent.ProofRequest, error) {
+	proof, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.TypeEQ(proofrequest.TypeAGG),
+			proofrequest.StartBlockEQ(startBlock),
+			proofrequest.StatusNEQ(proofrequest.StatusFAILED),
+			proofrequest.BackfillEQ(false),
+		).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query AGG proof with start block %d: %w", startBlock, err)
+	}
+	return proof, nil
+}
+
 // TryCreateAggProofFromSpanProofs tries to create an AGG proof from the span proofs that cover the range [from, minTo).
 // Returns true if a new AGG proof was created, false otherwise.
 func (db *ProofDB) TryCreateAggProofFromSpanProofs(from, minTo uint64) (bool, uint64, error) {
@@ -391,6 +1571,7 @@ func (db *ProofDB) TryCreateAggProofFromSpanProofs(from, minTo uint64) (bool, ui
 			proofrequest.TypeEQ(proofrequest.TypeAGG),
 			proofrequest.StartBlockEQ(from),
 			proofrequest.StatusNEQ(proofrequest.StatusFAILED),
+			proofrequest.BackfillEQ(false),
 		).
 		Count(context.Background())
 	if err != nil {
@@ -451,9 +1632,60 @@ func (db *ProofDB) GetMaxContiguousSpanProofRange(start uint64) (uint64, error)
 	return max(start, currentBlock), nil
 }
 
-// GetConsecutiveSpanProofs returns the span proofs that cover the range [start, end].
-// If there's a gap in the proofs, or the proofs don't fully cover the range, return an error.
+// BlockRange is a range of L2 blocks [Start, End].
+type BlockRange struct {
+	Start uint64
+	End   uint64
+}
+
+// ErrCoverageGap is matched by every CoverageError, so that callers can check for it with errors.Is.
+var ErrCoverageGap = errors.New("span proofs don't cover range")
+
+// CoverageError is returned when the completed span proofs don't form a gap-free chain over a range. Missing holds
+// the sub-ranges that aren't covered by any completed span proof.
+type CoverageError struct {
+	Start   uint64
+	End     uint64
+	Missing []BlockRange
+}
+
+func (e *CoverageError) Error() string {
+	missing := make([]string, len(e.Missing))
+	for i, r := range e.Missing {
+		missing[i] = fmt.Sprintf("[%d, %d]", r.Start, r.End)
+	}
+	return fmt.Sprintf("span proofs don't cover range [%d, %d], missing %s", e.Start, e.End, strings.Join(missing, ", "))
+}
+
+func (e *CoverageError) Is(target error) bool {
+	return target == ErrCoverageGap
+}
+
+// GetConsecutiveSpanProofs returns the span proofs that form an exact, non-overlapping chain covering the range
+// [start, end]. If the completed span proofs leave gaps in the range, a *CoverageError describing the missing
+// sub-ranges is returned.
 func (db *ProofDB) GetConsecutiveSpanProofs(start, end uint64) ([][]byte, error) {
+	ids, err := db.GetConsecutiveSpanProofIDs(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]byte, len(ids))
+	for i, id := range ids {
+		if result[i], err = db.GetSpanProof(id); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetConsecutiveSpanProofIDs returns the IDs of the span proofs that form an exact, non-overlapping chain covering the
+// range [start, end], without loading the proofs themselves. Completed span proofs can overlap, e.g. after a split, so
+// the chain is searched for breadth-first, which finds the chain with the fewest proofs if there is one. If there is
+// no chain, a *CoverageError describing the sub-ranges that no completed span proof covers is returned. If the
+// completed span proofs cover the range without forming a chain, the missing range is the rest of the range after the
+// longest chain from start.
+func (db *ProofDB) GetConsecutiveSpanProofIDs(start, end uint64) ([]int, error) {
 	ctx := context.Background()
 
 	// Query the DB for the span proofs that cover the range [start, end].
@@ -464,7 +1696,8 @@ func (db *ProofDB) GetConsecutiveSpanProofs(start, end uint64) ([][]byte, error)
 			proofrequest.StartBlockGTE(start),
 			proofrequest.EndBlockLTE(end),
 		).
-		Order(ent.Asc(proofrequest.FieldStartBlock))
+		Order(ent.Asc(proofrequest.FieldStartBlock), ent.Desc(proofrequest.FieldEndBlock), ent.Desc(proofrequest.FieldRequestAddedTime)).
+		Select(proofrequest.FieldID, proofrequest.FieldStartBlock, proofrequest.FieldEndBlock)
 
 	// Execute the query.
 	spans, err := query.All(ctx)
@@ -472,21 +1705,68 @@ func (db *ProofDB) GetConsecutiveSpanProofs(start, end uint64) ([][]byte, error)
 		return nil, fmt.Errorf("failed to query span proofs: %w", err)
 	}
 
-	// Verify that the proofs are consecutive and cover the entire range.
-	var result [][]byte
-	currentBlock := start
-
+	// Search for a chain from the start block to the end block, where each proof is an edge from its start block to its
+	// end block. prev holds the proof that each reached block was first reached with, in the order of the query.
+	byStart := make(map[uint64][]*ent.ProofRequest)
 	for _, span := range spans {
-		if span.StartBlock != currentBlock {
-			return nil, fmt.Errorf("gap in proof chain: expected start block %d, got %d", currentBlock, span.StartBlock)
+		byStart[span.StartBlock] = append(byStart[span.StartBlock], span)
+	}
+	prev := make(map[uint64]*ent.ProofRequest)
+	reach := start
+	queue := []uint64{start}
+	for len(queue) > 0 && queue[0] != end {
+		block := queue[0]
+		queue = queue[1:]
+		for _, span := range byStart[block] {
+			if span.EndBlock <= block || prev[span.EndBlock] != nil {
+				continue
+			}
+			prev[span.EndBlock] = span
+			reach = max(reach, span.EndBlock)
+			queue = append(queue, span.EndBlock)
 		}
-		result = append(result, span.Proof)
-		currentBlock = span.EndBlock
 	}
 
-	if currentBlock != end {
-		return nil, fmt.Errorf("incomplete proof chain: ends at block %d, expected %d", currentBlock, end)
+	if start != end && prev[end] == nil {
+		return nil, &CoverageError{Start: start, End: end, Missing: uncoveredRanges(spans, start, end, reach)}
 	}
 
+	var result []int
+	for block := end; block != start; block = prev[block].StartBlock {
+		result = append(result, prev[block].ID)
+	}
+	slices.Reverse(result)
 	return result, nil
 }
+
+// uncoveredRanges returns the sub-ranges of [start, end] that none of the given span proofs, sorted by start block,
+// cover. If they cover the whole range, the range after reach is returned instead.
+func uncoveredRanges(spans []*ent.ProofRequest, start, end, reach uint64) []BlockRange {
+	var missing []BlockRange
+	covered := start
+	for _, span := range spans {
+		if span.StartBlock > covered {
+			missing = append(missing, BlockRange{Start: covered, End: span.StartBlock})
+		}
+		covered = max(covered, span.EndBlock)
+	}
+	if covered < end {
+		missing = append(missing, BlockRange{Start: covered, End: end})
+	}
+	if len(missing) == 0 {
+		missing = append(missing, BlockRange{Start: reach, End: end})
+	}
+	return missing
+}
+
+// GetSpanProof returns the decoded proof of a fulfilled proof request.
+func (db *ProofDB) GetSpanProof(id int) ([]byte, error) {
+	p, err := db.readClient.ProofRequest.Query().
+		Where(proofrequest.ID(id)).
+		Select(proofrequest.FieldProof).
+		Only(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof of proof request %d: %w", id, err)
+	}
+	return db.decodeProof(p.Proof)
+}
@@ -0,0 +1,589 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+func TestNewEntrySkipsCoveredSpans(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 300, 400))
+
+	// Only the gaps that aren't covered by the existing requests should be added.
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 150, 450))
+	// A range that's fully covered should be skipped.
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 120, 180))
+
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	var ranges [][2]uint64
+	for _, p := range proofs {
+		ranges = append(ranges, [2]uint64{p.StartBlock, p.EndBlock})
+	}
+	require.ElementsMatch(t, [][2]uint64{{100, 200}, {300, 400}, {200, 300}, {400, 450}}, ranges)
+}
+
+func TestGetConsecutiveSpanProofsReportsMissingRanges(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	for _, r := range []BlockRange{{100, 200}, {300, 400}} {
+		require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, r.Start, r.End))
+	}
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, p := range proofs {
+		require.NoError(t, db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING))
+		require.NoError(t, db.AddFulfilledProof(p.ID, []byte{1}, ProofFormat{}))
+	}
+
+	_, err = db.GetConsecutiveSpanProofs(100, 500)
+	var coverageErr *CoverageError
+	require.ErrorAs(t, err, &coverageErr)
+	require.Equal(t, []BlockRange{{200, 300}, {400, 500}}, coverageErr.Missing)
+
+	subproofs, err := db.GetConsecutiveSpanProofs(300, 400)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{1}}, subproofs)
+}
+
+func TestGetConsecutiveSpanProofIDsWithOverlappingSpans(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	// Completed span proofs that overlap, e.g. because a span was split after it was proven.
+	for _, r := range []BlockRange{{0, 60}, {0, 50}, {50, 100}, {100, 160}, {110, 200}} {
+		_, err := db.writeClient.ProofRequest.Create().
+			SetType(proofrequest.TypeSPAN).
+			SetStartBlock(r.Start).
+			SetEndBlock(r.End).
+			SetStatus(proofrequest.StatusCOMPLETE).
+			SetRequestAddedTime(0).
+			SetLastUpdatedTime(0).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	// The longest span from the start block doesn't lead to a chain, but the shorter one does.
+	ids, err := db.GetConsecutiveSpanProofIDs(0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3}, ids)
+
+	// Only the blocks that no span proof covers are missing.
+	_, err = db.GetConsecutiveSpanProofIDs(0, 220)
+	var coverageErr *CoverageError
+	require.ErrorAs(t, err, &coverageErr)
+	require.Equal(t, []BlockRange{{200, 220}}, coverageErr.Missing)
+
+	// The span proofs cover the range without forming a chain, so the rest of the range after the chain is missing.
+	_, err = db.GetConsecutiveSpanProofIDs(0, 200)
+	require.ErrorAs(t, err, &coverageErr)
+	require.Equal(t, []BlockRange{{160, 200}}, coverageErr.Missing)
+}
+
+func TestNewBackfillEntryIgnoresOlderSpans(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	since := proofs[0].RequestAddedTime + 1
+	time.Sleep(time.Until(time.Unix(int64(since), 0)))
+
+	// Spans added before since don't count as covering the range, so the range is queued again.
+	created, err := db.NewBackfillEntry(proofrequest.TypeSPAN, 100, 200, since)
+	require.NoError(t, err)
+	require.Equal(t, 1, created)
+
+	// Re-running the backfill is a no-op.
+	created, err = db.NewBackfillEntry(proofrequest.TypeSPAN, 100, 200, since)
+	require.NoError(t, err)
+	require.Equal(t, 0, created)
+
+	backfill, err := db.GetBackfillProofs(proofrequest.TypeSPAN, 100, 200, since)
+	require.NoError(t, err)
+	require.Len(t, backfill, 1)
+
+	// Backfill requests are requested after the driver's own requests.
+	next, err := db.GetNextUnrequestedProof()
+	require.NoError(t, err)
+	require.False(t, next.Backfill)
+}
+
+func TestClaimUnrequestedProofs(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 200, 300))
+
+	claimed, err := db.ClaimUnrequestedProofs([]int{1, 2})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int{1, 2}, claimed)
+	n, err := db.GetNumberOfRequestsWithStatuses(proofrequest.StatusWITNESSGEN)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	// Proofs that were already claimed aren't claimed again.
+	claimed, err = db.ClaimUnrequestedProofs([]int{1, 2})
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+}
+
+func TestApplyProofUpdates(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 200, 300))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, p := range proofs {
+		require.NoError(t, db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING))
+	}
+
+	err = db.ApplyProofUpdates([]ProofUpdate{
+		{ID: proofs[0].ID, Proof: []byte{1}},
+		{ID: proofs[1].ID},
+		// A second proof for the same request is skipped, but doesn't prevent the other updates.
+		{ID: proofs[0].ID, Proof: []byte{2}},
+	})
+	require.Error(t, err)
+
+	completed, err := db.GetAllProofsWithStatus(proofrequest.StatusCOMPLETE)
+	require.NoError(t, err)
+	require.Len(t, completed, 1)
+	require.Equal(t, uint64(100), completed[0].StartBlock)
+
+	// The failed request is re-queued.
+	requeued, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, requeued, 1)
+	require.Equal(t, uint64(200), requeued[0].StartBlock)
+}
+
+func TestSetProverRequestIDRejectsDuplicateForDifferentRange(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 200, 300))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, p := range proofs {
+		require.NoError(t, db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING))
+	}
+
+	linkedID, err := db.SetProverRequestID(proofs[0].ID, "0x01")
+	require.NoError(t, err)
+	require.Equal(t, proofs[0].ID, linkedID)
+
+	_, err = db.SetProverRequestID(proofs[1].ID, "0x01")
+	var dupErr *DuplicateProverRequestIDError
+	require.ErrorAs(t, err, &dupErr)
+	require.Equal(t, proofs[0].ID, dupErr.ExistingID)
+
+	p, err := db.GetProofRequest(proofs[1].ID)
+	require.NoError(t, err)
+	require.Empty(t, p.ProverRequestID)
+}
+
+func TestSetProverRequestIDLinksDuplicateForSameRange(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	// A backfill request ignores the older request for the range.
+	_, err = db.NewBackfillEntry(proofrequest.TypeSPAN, 100, 200, uint64(time.Now().Unix())+1)
+	require.NoError(t, err)
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, proofs, 2)
+	for _, p := range proofs {
+		require.NoError(t, db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING))
+	}
+
+	_, err = db.SetProverRequestID(proofs[0].ID, "0x01")
+	require.NoError(t, err)
+	linkedID, err := db.SetProverRequestID(proofs[1].ID, "0x01")
+	require.NoError(t, err)
+	require.Equal(t, proofs[0].ID, linkedID)
+
+	// The linked entry isn't retried.
+	failed, err := db.GetProofsFailedOnServer()
+	require.NoError(t, err)
+	require.Empty(t, failed)
+}
+
+func TestApplyProofUpdatesRecordsFulfillmentMetadata(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateProofStatus(proofs[0].ID, proofrequest.StatusPROVING))
+
+	metadata := FulfillmentMetadata{Cycles: 1000, Fee: 5, Prover: "0xprover", FulfilledTime: 1700000000}
+	require.NoError(t, db.ApplyProofUpdates([]ProofUpdate{{ID: proofs[0].ID, Proof: []byte{1}, Metadata: metadata}}))
+
+	p, err := db.GetProofRequest(proofs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, metadata, FulfillmentMetadata{Cycles: p.FulfilledCycles, Fee: p.FulfilledFee, Prover: p.Prover, FulfilledTime: p.FulfilledTime})
+
+	// The fee is dated by the fulfillment time, not by the last update.
+	spend, err := db.GetProvingSpendSince(metadata.FulfilledTime)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), spend)
+	spend, err = db.GetProvingSpendSince(metadata.FulfilledTime + 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), spend)
+
+	// Without a fulfillment time, the fee is dated by the last update.
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 200, 300))
+	proofs, err = db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateProofStatus(proofs[0].ID, proofrequest.StatusPROVING))
+	require.NoError(t, db.ApplyProofUpdates([]ProofUpdate{{ID: proofs[0].ID, Proof: []byte{1}, Metadata: FulfillmentMetadata{Fee: 7}}}))
+	p, err = db.GetProofRequest(proofs[0].ID)
+	require.NoError(t, err)
+	spend, err = db.GetProvingSpendSince(p.LastUpdatedTime)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), spend)
+	spend, err = db.GetProvingSpendSince(p.LastUpdatedTime + 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), spend)
+}
+
+func TestApplyProofUpdatesRecordsFailure(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateProofStatus(proofs[0].ID, proofrequest.StatusPROVING))
+
+	failure := Failure{Stage: proofrequest.FailureStagePROVE, Code: "PROOF_TIMED_OUT", Reason: "proof timed out"}
+	require.NoError(t, db.ApplyProofUpdates([]ProofUpdate{{ID: proofs[0].ID, Failure: &failure}}))
+
+	p, err := db.GetProofRequest(proofs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, p.Status)
+	require.Equal(t, failure, Failure{Stage: p.FailureStage, Code: p.ErrorCode, Reason: p.FailureReason})
+}
+
+func TestNewChildEntryTracksRetryLineage(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	root := proofs[0]
+	require.NoError(t, db.UpdateProofStatus(root.ID, proofrequest.StatusFAILED))
+
+	// Split the root in two.
+	for _, r := range [][2]uint64{{100, 150}, {150, 200}} {
+		created, err := db.NewChildEntry(root, proofrequest.TypeSPAN, r[0], r[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, created)
+	}
+	children, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+
+	// The first child fails and is retried. The root isn't split a second time, even once its children failed.
+	require.NoError(t, db.UpdateProofStatus(children[0].ID, proofrequest.StatusFAILED))
+	_, err = db.NewChildEntry(children[0], proofrequest.TypeSPAN, 100, 150)
+	require.NoError(t, err)
+	created, err := db.NewChildEntry(root, proofrequest.TypeSPAN, 100, 150)
+	require.NoError(t, err)
+	require.Zero(t, created)
+
+	tree, err := db.GetRetryTree(children[1].ID)
+	require.NoError(t, err)
+	require.Len(t, tree, 4)
+	require.Equal(t, root.ID, tree[0].ID)
+	require.Equal(t, []int{root.ID, root.ID, children[0].ID}, []int{tree[1].ParentID, tree[2].ParentID, tree[3].ParentID})
+}
+
+func TestSplitProofRequest(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	root := proofs[0]
+
+	// A split that leaves an empty half is rejected, and the request is left as is.
+	require.Error(t, db.SplitProofRequest(root.ID, 200))
+	proofs, err = db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+
+	require.NoError(t, db.SplitProofRequest(root.ID, 150))
+	root, err = db.GetProofRequest(root.ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, root.Status)
+	children, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	var ranges [][2]uint64
+	for _, p := range children {
+		require.Equal(t, root.ID, p.ParentID)
+		ranges = append(ranges, [2]uint64{p.StartBlock, p.EndBlock})
+	}
+	require.ElementsMatch(t, [][2]uint64{{100, 150}, {150, 200}}, ranges)
+}
+
+func TestInitDBWithOptionsEnablesWAL(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "proofs.db")
+	db, err := InitDBWithOptions(dbPath, false, DefaultOptions())
+	require.NoError(t, err)
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+
+	// Writes go to the write-ahead log.
+	_, err = os.Stat(dbPath + "-wal")
+	require.NoError(t, err)
+	require.NoError(t, db.CloseDB())
+
+	// A fresh DB starts empty.
+	db, err = InitDBWithOptions(dbPath, false, DefaultOptions())
+	require.NoError(t, err)
+	defer db.CloseDB()
+	count, err := db.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestLabels(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 200, 300))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	ids := []int{proofs[0].ID, proofs[1].ID}
+
+	require.NoError(t, db.AddLabels(ids, []string{"backfill", "incident-123"}))
+	require.NoError(t, db.AddLabels(ids[:1], []string{"backfill"}))
+	require.NoError(t, db.RemoveLabels(ids[1:], []string{"incident-123"}))
+	require.Error(t, db.AddLabels([]int{1000}, []string{"backfill"}))
+
+	labeled, err := db.ListProofRequests(ProofRequestFilter{Label: "incident-123"}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, labeled, 1)
+	require.Equal(t, []string{"backfill", "incident-123"}, labeled[0].Labels)
+
+	// Replacements keep the labels of the request they replace.
+	require.NoError(t, db.UpdateProofStatus(ids[1], proofrequest.StatusFAILED))
+	p, err := db.GetProofRequest(ids[1])
+	require.NoError(t, err)
+	_, err = db.NewChildEntry(p, proofrequest.TypeSPAN, 200, 300)
+	require.NoError(t, err)
+
+	counts, err := db.CountLabeledProofRequests()
+	require.NoError(t, err)
+	require.Equal(t, map[string]map[proofrequest.Status]int{
+		"backfill":     {proofrequest.StatusUNREQ: 2, proofrequest.StatusFAILED: 1},
+		"incident-123": {proofrequest.StatusUNREQ: 1},
+	}, counts)
+}
+
+func TestNewEntryKeepsOneActiveRequestPerRange(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "proofs.db")
+	db, err := InitDB(dbPath, false)
+	require.NoError(t, err)
+
+	// Agg proof requests aren't coverage-aware, so the unique index rejects the duplicate.
+	require.NoError(t, db.NewEntry(proofrequest.TypeAGG, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeAGG, 100, 200))
+	count, err := db.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.NoError(t, db.CloseDB())
+
+	// DBs with duplicate active requests from before the unique index are deduplicated when they're opened.
+	raw, err := stdsql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	_, err = raw.Exec(`DROP INDEX proofrequest_type_start_block_end_block_backfill`)
+	require.NoError(t, err)
+	_, err = raw.Exec(`INSERT INTO proof_requests (type, start_block, end_block, status, request_added_time, last_updated_time, backfill) VALUES ('AGG', 100, 200, 'UNREQ', 0, 0, false)`)
+	require.NoError(t, err)
+	require.NoError(t, raw.Close())
+
+	db, err = InitDB(dbPath, true)
+	require.NoError(t, err)
+	defer db.CloseDB()
+	count, err = db.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = db.GetNumberOfRequestsWithStatuses(proofrequest.StatusFAILED)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
+
+func TestGetCoveredUnrequestedSpanProofsKeepsOneOfOverlapping(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	// Overlapping unrequested span proofs, e.g. a backfill request over the range of a driver request.
+	for _, r := range []struct {
+		start, end uint64
+		backfill   bool
+	}{{100, 200, false}, {100, 200, true}, {150, 200, false}} {
+		_, err := db.writeClient.ProofRequest.Create().
+			SetType(proofrequest.TypeSPAN).
+			SetStartBlock(r.start).
+			SetEndBlock(r.end).
+			SetStatus(proofrequest.StatusUNREQ).
+			SetRequestAddedTime(0).
+			SetLastUpdatedTime(0).
+			SetBackfill(r.backfill).
+			Save(context.Background())
+		require.NoError(t, err)
+	}
+
+	// Only the span proof queued after one covering it is covered, so the first one is still proven.
+	covered, err := db.GetCoveredUnrequestedSpanProofs()
+	require.NoError(t, err)
+	require.Len(t, covered, 1)
+	require.Equal(t, 3, covered[0].ID)
+
+	// Collecting the covered span proof doesn't queue it for a retry.
+	require.NoError(t, db.FailWithoutRetry(covered[0].ID, nil))
+	failed, err := db.GetProofsFailedOnServer()
+	require.NoError(t, err)
+	require.Empty(t, failed)
+
+	// Span proofs that were requested cover any unrequested span proof.
+	require.NoError(t, db.UpdateProofStatus(2, proofrequest.StatusPROVING))
+	covered, err = db.GetCoveredUnrequestedSpanProofs()
+	require.NoError(t, err)
+	require.Len(t, covered, 1)
+	require.Equal(t, 1, covered[0].ID)
+}
+
+func TestGetArchivableProofsByRequestTime(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	_, err = db.writeClient.ProofRequest.UpdateOneID(proofs[0].ID).SetRequestAddedTime(1).Save(context.Background())
+	require.NoError(t, err)
+
+	// A failed request that still has to be retried isn't archived.
+	require.NoError(t, db.UpdateProofStatus(proofs[0].ID, proofrequest.StatusFAILED))
+	before := uint64(time.Now().Add(-time.Hour).Unix())
+	archivable, err := db.GetArchivableProofs(before, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, archivable)
+
+	// Retrying it updates it, but it's archived by the time it was added.
+	require.NoError(t, db.ApplyProofUpdates([]ProofUpdate{{ID: proofs[0].ID}}))
+	archivable, err = db.GetArchivableProofs(before, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, archivable, 1)
+	require.Equal(t, proofs[0].ID, archivable[0].ID)
+}
+
+func TestRollUpStats(t *testing.T) {
+	db, err := InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer db.CloseDB()
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 200, 300))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	for _, p := range proofs {
+		require.NoError(t, db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING))
+	}
+	metadata := FulfillmentMetadata{Fee: 5}
+	require.NoError(t, db.ApplyProofUpdates([]ProofUpdate{{ID: proofs[0].ID, Proof: []byte{1}, Metadata: metadata}, {ID: proofs[1].ID}}))
+
+	// The current hour isn't rolled up until it ends.
+	now := time.Now()
+	rolledUp, err := db.RollUpStats(proofstat.PeriodHOUR, now, 10)
+	require.NoError(t, err)
+	require.Zero(t, rolledUp)
+
+	rolledUp, err = db.RollUpStats(proofstat.PeriodHOUR, now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, rolledUp)
+	stats, err := db.GetStats(proofstat.PeriodHOUR, 0, uint64(now.Add(time.Hour).Unix()))
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, 1, stats[0].Fulfilled)
+	require.Equal(t, 1, stats[0].Failed)
+	require.Equal(t, uint64(100), stats[0].BlocksProven)
+	require.Equal(t, uint64(5), stats[0].Spend)
+
+	// Periods are only rolled up once.
+	rolledUp, err = db.RollUpStats(proofstat.PeriodHOUR, now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Zero(t, rolledUp)
+}
+
+func TestEncryptedProofs(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "proofs.db")
+	opts := DefaultOptions()
+	opts.EncryptionKey = make([]byte, 32)
+	db, err := InitDBWithOptions(dbPath, false, opts)
+	require.NoError(t, err)
+
+	require.NoError(t, db.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	proofs, err := db.GetAllProofsWithStatus(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateProofStatus(proofs[0].ID, proofrequest.StatusPROVING))
+	proof := []byte("span proof")
+	require.NoError(t, db.AddFulfilledProof(proofs[0].ID, proof, ProofFormat{}))
+
+	stored, err := db.GetProofRequest(proofs[0].ID)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(stored.Proof, encryptedProofMarker))
+	decoded, err := db.GetSpanProof(proofs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, proof, decoded)
+	require.NoError(t, db.CloseDB())
+
+	// Encrypted proofs can't be read without the key.
+	db, err = InitDB(dbPath, true)
+	require.NoError(t, err)
+	defer db.CloseDB()
+	_, err = db.GetSpanProof(proofs[0].ID)
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Proofs encrypted at rest are prefixed with a marker, followed by the nonce and the sealed compressed proof, so that
+// proofs stored unencrypted can still be read after encryption is enabled.
+var encryptedProofMarker = []byte("aesgcm:")
+
+// LoadEncryptionKey reads a hex-encoded 32-byte AES-256 key from the file at the given path, e.g. one provisioned by
+// a KMS or secret manager.
+func LoadEncryptionKey(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proof encryption key: %w", err)
+	}
+	key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(b)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode proof encryption key: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("proof encryption key is %d bytes, expected 32", len(key))
+	}
+	return key, nil
+}
+
+// newProofCipher returns the AEAD that proofs are encrypted with, or nil if no key is given.
+func newProofCipher(key []byte) (cipher.AEAD, error) {
+	if key == nil {
+		return nil, nil
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof encryption key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// encodeProof compresses a proof for storage in the database, and encrypts it if an encryption key is configured.
+func (db *ProofDB) encodeProof(proof []byte) ([]byte, error) {
+	compressed := compressProof(proof)
+	if db.proofCipher == nil {
+		return compressed, nil
+	}
+
+	nonceSize := db.proofCipher.NonceSize()
+	out := make([]byte, len(encryptedProofMarker)+nonceSize, len(encryptedProofMarker)+nonceSize+len(compressed)+db.proofCipher.Overhead())
+	copy(out, encryptedProofMarker)
+	nonce := out[len(encryptedProofMarker):]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return db.proofCipher.Seal(out, nonce, compressed, nil), nil
+}
+
+// isEncryptedProof returns whether a proof stored in the database is encrypted.
+func isEncryptedProof(stored []byte) bool {
+	return bytes.HasPrefix(stored, encryptedProofMarker)
+}
+
+// decodeProof returns the original proof bytes of a proof stored in the database, decrypting it if it's encrypted.
+func (db *ProofDB) decodeProof(stored []byte) ([]byte, error) {
+	if !isEncryptedProof(stored) {
+		return decompressProof(stored)
+	}
+	if db.proofCipher == nil {
+		return nil, errors.New("proof is encrypted, but no proof encryption key is configured")
+	}
+
+	sealed := stored[len(encryptedProofMarker):]
+	nonceSize := db.proofCipher.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("encrypted proof is truncated")
+	}
+	compressed, err := db.proofCipher.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt proof: %w", err)
+	}
+	return decompressProof(compressed)
+}
@@ -15,6 +15,7 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,6 +25,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// ProofRequest is the client for interacting with the ProofRequest builders.
 	ProofRequest *ProofRequestClient
+	// ProofStat is the client for interacting with the ProofStat builders.
+	ProofStat *ProofStatClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,6 +39,7 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ProofRequest = NewProofRequestClient(c.config)
+	c.ProofStat = NewProofStatClient(c.config)
 }
 
 type (
@@ -129,6 +133,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:          ctx,
 		config:       cfg,
 		ProofRequest: NewProofRequestClient(cfg),
+		ProofStat:    NewProofStatClient(cfg),
 	}, nil
 }
 
@@ -149,6 +154,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:          ctx,
 		config:       cfg,
 		ProofRequest: NewProofRequestClient(cfg),
+		ProofStat:    NewProofStatClient(cfg),
 	}, nil
 }
 
@@ -178,12 +184,14 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.ProofRequest.Use(hooks...)
+	c.ProofStat.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.ProofRequest.Intercept(interceptors...)
+	c.ProofStat.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -191,6 +199,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ProofRequestMutation:
 		return c.ProofRequest.mutate(ctx, m)
+	case *ProofStatMutation:
+		return c.ProofStat.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -329,12 +339,145 @@ func (c *ProofRequestClient) mutate(ctx context.Context, m *ProofRequestMutation
 	}
 }
 
+// ProofStatClient is a client for the ProofStat schema.
+type ProofStatClient struct {
+	config
+}
+
+// NewProofStatClient returns a client for the ProofStat from the given config.
+func NewProofStatClient(c config) *ProofStatClient {
+	return &ProofStatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `proofstat.Hooks(f(g(h())))`.
+func (c *ProofStatClient) Use(hooks ...Hook) {
+	c.hooks.ProofStat = append(c.hooks.ProofStat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `proofstat.Intercept(f(g(h())))`.
+func (c *ProofStatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProofStat = append(c.inters.ProofStat, interceptors...)
+}
+
+// Create returns a builder for creating a ProofStat entity.
+func (c *ProofStatClient) Create() *ProofStatCreate {
+	mutation := newProofStatMutation(c.config, OpCreate)
+	return &ProofStatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProofStat entities.
+func (c *ProofStatClient) CreateBulk(builders ...*ProofStatCreate) *ProofStatCreateBulk {
+	return &ProofStatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProofStatClient) MapCreateBulk(slice any, setFunc func(*ProofStatCreate, int)) *ProofStatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProofStatCreateBulk{err: fmt.Errorf("calling to ProofStatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProofStatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProofStatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProofStat.
+func (c *ProofStatClient) Update() *ProofStatUpdate {
+	mutation := newProofStatMutation(c.config, OpUpdate)
+	return &ProofStatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProofStatClient) UpdateOne(ps *ProofStat) *ProofStatUpdateOne {
+	mutation := newProofStatMutation(c.config, OpUpdateOne, withProofStat(ps))
+	return &ProofStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProofStatClient) UpdateOneID(id int) *ProofStatUpdateOne {
+	mutation := newProofStatMutation(c.config, OpUpdateOne, withProofStatID(id))
+	return &ProofStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProofStat.
+func (c *ProofStatClient) Delete() *ProofStatDelete {
+	mutation := newProofStatMutation(c.config, OpDelete)
+	return &ProofStatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProofStatClient) DeleteOne(ps *ProofStat) *ProofStatDeleteOne {
+	return c.DeleteOneID(ps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProofStatClient) DeleteOneID(id int) *ProofStatDeleteOne {
+	builder := c.Delete().Where(proofstat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProofStatDeleteOne{builder}
+}
+
+// Query returns a query builder for ProofStat.
+func (c *ProofStatClient) Query() *ProofStatQuery {
+	return &ProofStatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProofStat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProofStat entity by its id.
+func (c *ProofStatClient) Get(ctx context.Context, id int) (*ProofStat, error) {
+	return c.Query().Where(proofstat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProofStatClient) GetX(ctx context.Context, id int) *ProofStat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProofStatClient) Hooks() []Hook {
+	return c.hooks.ProofStat
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProofStatClient) Interceptors() []Interceptor {
+	return c.inters.ProofStat
+}
+
+func (c *ProofStatClient) mutate(ctx context.Context, m *ProofStatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProofStatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProofStatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProofStatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProofStatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProofStat mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ProofRequest []ent.Hook
+		ProofRequest, ProofStat []ent.Hook
 	}
 	inters struct {
-		ProofRequest []ent.Interceptor
+		ProofRequest, ProofStat []ent.Interceptor
 	}
 )
@@ -21,6 +21,18 @@ func (f ProofRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProofRequestMutation", m)
 }
 
+// The ProofStatFunc type is an adapter to allow the use of ordinary
+// function as ProofStat mutator.
+type ProofStatFunc func(context.Context, *ent.ProofStatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProofStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProofStatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProofStatMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
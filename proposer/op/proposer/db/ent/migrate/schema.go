@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -22,16 +23,71 @@ var (
 		{Name: "l1_block_number", Type: field.TypeUint64, Nullable: true},
 		{Name: "l1_block_hash", Type: field.TypeString, Nullable: true},
 		{Name: "proof", Type: field.TypeBytes, Nullable: true},
+		{Name: "estimated_cycles", Type: field.TypeUint64, Nullable: true},
+		{Name: "estimated_fee", Type: field.TypeUint64, Nullable: true},
+		{Name: "proof_system", Type: field.TypeString, Nullable: true},
+		{Name: "vkey_hash", Type: field.TypeString, Nullable: true},
+		{Name: "proof_format", Type: field.TypeString, Nullable: true},
+		{Name: "backfill", Type: field.TypeBool, Default: false},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "fulfilled_cycles", Type: field.TypeUint64, Nullable: true},
+		{Name: "fulfilled_fee", Type: field.TypeUint64, Nullable: true},
+		{Name: "prover", Type: field.TypeString, Nullable: true},
+		{Name: "fulfilled_time", Type: field.TypeUint64, Nullable: true},
+		{Name: "program_version", Type: field.TypeString, Nullable: true},
+		{Name: "public_values", Type: field.TypeBytes, Nullable: true},
+		{Name: "error_code", Type: field.TypeString, Nullable: true},
+		{Name: "failure_stage", Type: field.TypeEnum, Nullable: true, Enums: []string{"REQUEST", "PROVE", "VERIFY"}},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "challenge_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"CHALLENGED", "CHALLENGER_WINS", "DEFENDER_WINS"}},
+		{Name: "final", Type: field.TypeBool, Default: false},
 	}
 	// ProofRequestsTable holds the schema information for the "proof_requests" table.
 	ProofRequestsTable = &schema.Table{
 		Name:       "proof_requests",
 		Columns:    ProofRequestsColumns,
 		PrimaryKey: []*schema.Column{ProofRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "proofrequest_type_start_block_end_block_backfill",
+				Unique:  true,
+				Columns: []*schema.Column{ProofRequestsColumns[1], ProofRequestsColumns[2], ProofRequestsColumns[3], ProofRequestsColumns[17]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status IN ('UNREQ', 'WITNESSGEN', 'PROVING')",
+				},
+			},
+		},
+	}
+	// ProofStatsColumns holds the columns for the "proof_stats" table.
+	ProofStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"HOUR", "DAY"}},
+		{Name: "period_start", Type: field.TypeUint64},
+		{Name: "requested", Type: field.TypeInt},
+		{Name: "fulfilled", Type: field.TypeInt},
+		{Name: "failed", Type: field.TypeInt},
+		{Name: "blocks_proven", Type: field.TypeUint64},
+		{Name: "average_latency", Type: field.TypeFloat64},
+		{Name: "spend", Type: field.TypeUint64},
+	}
+	// ProofStatsTable holds the schema information for the "proof_stats" table.
+	ProofStatsTable = &schema.Table{
+		Name:       "proof_stats",
+		Columns:    ProofStatsColumns,
+		PrimaryKey: []*schema.Column{ProofStatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "proofstat_period_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{ProofStatsColumns[1], ProofStatsColumns[2]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ProofRequestsTable,
+		ProofStatsTable,
 	}
 )
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
 )
 
 const (
@@ -24,6 +25,7 @@ const (
 
 	// Node types.
 	TypeProofRequest = "ProofRequest"
+	TypeProofStat    = "ProofStat"
 )
 
 // ProofRequestMutation represents an operation that mutates the ProofRequest nodes in the graph.
@@ -49,6 +51,32 @@ type ProofRequestMutation struct {
 	addl1_block_number    *int64
 	l1_block_hash         *string
 	proof                 *[]byte
+	estimated_cycles      *uint64
+	addestimated_cycles   *int64
+	estimated_fee         *uint64
+	addestimated_fee      *int64
+	proof_system          *string
+	vkey_hash             *string
+	proof_format          *string
+	backfill              *bool
+	failure_reason        *string
+	fulfilled_cycles      *uint64
+	addfulfilled_cycles   *int64
+	fulfilled_fee         *uint64
+	addfulfilled_fee      *int64
+	prover                *string
+	fulfilled_time        *uint64
+	addfulfilled_time     *int64
+	program_version       *string
+	public_values         *[]byte
+	error_code            *string
+	failure_stage         *proofrequest.FailureStage
+	parent_id             *int
+	addparent_id          *int
+	labels                *[]string
+	appendlabels          []string
+	challenge_status      *proofrequest.ChallengeStatus
+	final                 *bool
 	clearedFields         map[string]struct{}
 	done                  bool
 	oldValue              func(context.Context) (*ProofRequest, error)
@@ -736,15 +764,2569 @@ func (m *ProofRequestMutation) ResetProof() {
 	delete(m.clearedFields, proofrequest.FieldProof)
 }
 
+// SetEstimatedCycles sets the "estimated_cycles" field.
+func (m *ProofRequestMutation) SetEstimatedCycles(u uint64) {
+	m.estimated_cycles = &u
+	m.addestimated_cycles = nil
+}
+
+// EstimatedCycles returns the value of the "estimated_cycles" field in the mutation.
+func (m *ProofRequestMutation) EstimatedCycles() (r uint64, exists bool) {
+	v := m.estimated_cycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstimatedCycles returns the old "estimated_cycles" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldEstimatedCycles(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstimatedCycles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstimatedCycles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstimatedCycles: %w", err)
+	}
+	return oldValue.EstimatedCycles, nil
+}
+
+// AddEstimatedCycles adds u to the "estimated_cycles" field.
+func (m *ProofRequestMutation) AddEstimatedCycles(u int64) {
+	if m.addestimated_cycles != nil {
+		*m.addestimated_cycles += u
+	} else {
+		m.addestimated_cycles = &u
+	}
+}
+
+// AddedEstimatedCycles returns the value that was added to the "estimated_cycles" field in this mutation.
+func (m *ProofRequestMutation) AddedEstimatedCycles() (r int64, exists bool) {
+	v := m.addestimated_cycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEstimatedCycles clears the value of the "estimated_cycles" field.
+func (m *ProofRequestMutation) ClearEstimatedCycles() {
+	m.estimated_cycles = nil
+	m.addestimated_cycles = nil
+	m.clearedFields[proofrequest.FieldEstimatedCycles] = struct{}{}
+}
+
+// EstimatedCyclesCleared returns if the "estimated_cycles" field was cleared in this mutation.
+func (m *ProofRequestMutation) EstimatedCyclesCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldEstimatedCycles]
+	return ok
+}
+
+// ResetEstimatedCycles resets all changes to the "estimated_cycles" field.
+func (m *ProofRequestMutation) ResetEstimatedCycles() {
+	m.estimated_cycles = nil
+	m.addestimated_cycles = nil
+	delete(m.clearedFields, proofrequest.FieldEstimatedCycles)
+}
+
+// SetEstimatedFee sets the "estimated_fee" field.
+func (m *ProofRequestMutation) SetEstimatedFee(u uint64) {
+	m.estimated_fee = &u
+	m.addestimated_fee = nil
+}
+
+// EstimatedFee returns the value of the "estimated_fee" field in the mutation.
+func (m *ProofRequestMutation) EstimatedFee() (r uint64, exists bool) {
+	v := m.estimated_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstimatedFee returns the old "estimated_fee" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldEstimatedFee(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstimatedFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstimatedFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstimatedFee: %w", err)
+	}
+	return oldValue.EstimatedFee, nil
+}
+
+// AddEstimatedFee adds u to the "estimated_fee" field.
+func (m *ProofRequestMutation) AddEstimatedFee(u int64) {
+	if m.addestimated_fee != nil {
+		*m.addestimated_fee += u
+	} else {
+		m.addestimated_fee = &u
+	}
+}
+
+// AddedEstimatedFee returns the value that was added to the "estimated_fee" field in this mutation.
+func (m *ProofRequestMutation) AddedEstimatedFee() (r int64, exists bool) {
+	v := m.addestimated_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEstimatedFee clears the value of the "estimated_fee" field.
+func (m *ProofRequestMutation) ClearEstimatedFee() {
+	m.estimated_fee = nil
+	m.addestimated_fee = nil
+	m.clearedFields[proofrequest.FieldEstimatedFee] = struct{}{}
+}
+
+// EstimatedFeeCleared returns if the "estimated_fee" field was cleared in this mutation.
+func (m *ProofRequestMutation) EstimatedFeeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldEstimatedFee]
+	return ok
+}
+
+// ResetEstimatedFee resets all changes to the "estimated_fee" field.
+func (m *ProofRequestMutation) ResetEstimatedFee() {
+	m.estimated_fee = nil
+	m.addestimated_fee = nil
+	delete(m.clearedFields, proofrequest.FieldEstimatedFee)
+}
+
+// SetProofSystem sets the "proof_system" field.
+func (m *ProofRequestMutation) SetProofSystem(s string) {
+	m.proof_system = &s
+}
+
+// ProofSystem returns the value of the "proof_system" field in the mutation.
+func (m *ProofRequestMutation) ProofSystem() (r string, exists bool) {
+	v := m.proof_system
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofSystem returns the old "proof_system" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProofSystem(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofSystem: %w", err)
+	}
+	return oldValue.ProofSystem, nil
+}
+
+// ClearProofSystem clears the value of the "proof_system" field.
+func (m *ProofRequestMutation) ClearProofSystem() {
+	m.proof_system = nil
+	m.clearedFields[proofrequest.FieldProofSystem] = struct{}{}
+}
+
+// ProofSystemCleared returns if the "proof_system" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofSystemCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProofSystem]
+	return ok
+}
+
+// ResetProofSystem resets all changes to the "proof_system" field.
+func (m *ProofRequestMutation) ResetProofSystem() {
+	m.proof_system = nil
+	delete(m.clearedFields, proofrequest.FieldProofSystem)
+}
+
+// SetVkeyHash sets the "vkey_hash" field.
+func (m *ProofRequestMutation) SetVkeyHash(s string) {
+	m.vkey_hash = &s
+}
+
+// VkeyHash returns the value of the "vkey_hash" field in the mutation.
+func (m *ProofRequestMutation) VkeyHash() (r string, exists bool) {
+	v := m.vkey_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVkeyHash returns the old "vkey_hash" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldVkeyHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVkeyHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVkeyHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVkeyHash: %w", err)
+	}
+	return oldValue.VkeyHash, nil
+}
+
+// ClearVkeyHash clears the value of the "vkey_hash" field.
+func (m *ProofRequestMutation) ClearVkeyHash() {
+	m.vkey_hash = nil
+	m.clearedFields[proofrequest.FieldVkeyHash] = struct{}{}
+}
+
+// VkeyHashCleared returns if the "vkey_hash" field was cleared in this mutation.
+func (m *ProofRequestMutation) VkeyHashCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldVkeyHash]
+	return ok
+}
+
+// ResetVkeyHash resets all changes to the "vkey_hash" field.
+func (m *ProofRequestMutation) ResetVkeyHash() {
+	m.vkey_hash = nil
+	delete(m.clearedFields, proofrequest.FieldVkeyHash)
+}
+
+// SetProofFormat sets the "proof_format" field.
+func (m *ProofRequestMutation) SetProofFormat(s string) {
+	m.proof_format = &s
+}
+
+// ProofFormat returns the value of the "proof_format" field in the mutation.
+func (m *ProofRequestMutation) ProofFormat() (r string, exists bool) {
+	v := m.proof_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProofFormat returns the old "proof_format" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProofFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProofFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProofFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProofFormat: %w", err)
+	}
+	return oldValue.ProofFormat, nil
+}
+
+// ClearProofFormat clears the value of the "proof_format" field.
+func (m *ProofRequestMutation) ClearProofFormat() {
+	m.proof_format = nil
+	m.clearedFields[proofrequest.FieldProofFormat] = struct{}{}
+}
+
+// ProofFormatCleared returns if the "proof_format" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProofFormatCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProofFormat]
+	return ok
+}
+
+// ResetProofFormat resets all changes to the "proof_format" field.
+func (m *ProofRequestMutation) ResetProofFormat() {
+	m.proof_format = nil
+	delete(m.clearedFields, proofrequest.FieldProofFormat)
+}
+
+// SetBackfill sets the "backfill" field.
+func (m *ProofRequestMutation) SetBackfill(b bool) {
+	m.backfill = &b
+}
+
+// Backfill returns the value of the "backfill" field in the mutation.
+func (m *ProofRequestMutation) Backfill() (r bool, exists bool) {
+	v := m.backfill
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBackfill returns the old "backfill" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldBackfill(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackfill is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackfill requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackfill: %w", err)
+	}
+	return oldValue.Backfill, nil
+}
+
+// ResetBackfill resets all changes to the "backfill" field.
+func (m *ProofRequestMutation) ResetBackfill() {
+	m.backfill = nil
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (m *ProofRequestMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
+}
+
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *ProofRequestMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failure_reason" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (m *ProofRequestMutation) ClearFailureReason() {
+	m.failure_reason = nil
+	m.clearedFields[proofrequest.FieldFailureReason] = struct{}{}
+}
+
+// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
+func (m *ProofRequestMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFailureReason]
+	return ok
+}
+
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *ProofRequestMutation) ResetFailureReason() {
+	m.failure_reason = nil
+	delete(m.clearedFields, proofrequest.FieldFailureReason)
+}
+
+// SetFulfilledCycles sets the "fulfilled_cycles" field.
+func (m *ProofRequestMutation) SetFulfilledCycles(u uint64) {
+	m.fulfilled_cycles = &u
+	m.addfulfilled_cycles = nil
+}
+
+// FulfilledCycles returns the value of the "fulfilled_cycles" field in the mutation.
+func (m *ProofRequestMutation) FulfilledCycles() (r uint64, exists bool) {
+	v := m.fulfilled_cycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFulfilledCycles returns the old "fulfilled_cycles" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFulfilledCycles(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFulfilledCycles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFulfilledCycles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFulfilledCycles: %w", err)
+	}
+	return oldValue.FulfilledCycles, nil
+}
+
+// AddFulfilledCycles adds u to the "fulfilled_cycles" field.
+func (m *ProofRequestMutation) AddFulfilledCycles(u int64) {
+	if m.addfulfilled_cycles != nil {
+		*m.addfulfilled_cycles += u
+	} else {
+		m.addfulfilled_cycles = &u
+	}
+}
+
+// AddedFulfilledCycles returns the value that was added to the "fulfilled_cycles" field in this mutation.
+func (m *ProofRequestMutation) AddedFulfilledCycles() (r int64, exists bool) {
+	v := m.addfulfilled_cycles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFulfilledCycles clears the value of the "fulfilled_cycles" field.
+func (m *ProofRequestMutation) ClearFulfilledCycles() {
+	m.fulfilled_cycles = nil
+	m.addfulfilled_cycles = nil
+	m.clearedFields[proofrequest.FieldFulfilledCycles] = struct{}{}
+}
+
+// FulfilledCyclesCleared returns if the "fulfilled_cycles" field was cleared in this mutation.
+func (m *ProofRequestMutation) FulfilledCyclesCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFulfilledCycles]
+	return ok
+}
+
+// ResetFulfilledCycles resets all changes to the "fulfilled_cycles" field.
+func (m *ProofRequestMutation) ResetFulfilledCycles() {
+	m.fulfilled_cycles = nil
+	m.addfulfilled_cycles = nil
+	delete(m.clearedFields, proofrequest.FieldFulfilledCycles)
+}
+
+// SetFulfilledFee sets the "fulfilled_fee" field.
+func (m *ProofRequestMutation) SetFulfilledFee(u uint64) {
+	m.fulfilled_fee = &u
+	m.addfulfilled_fee = nil
+}
+
+// FulfilledFee returns the value of the "fulfilled_fee" field in the mutation.
+func (m *ProofRequestMutation) FulfilledFee() (r uint64, exists bool) {
+	v := m.fulfilled_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFulfilledFee returns the old "fulfilled_fee" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFulfilledFee(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFulfilledFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFulfilledFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFulfilledFee: %w", err)
+	}
+	return oldValue.FulfilledFee, nil
+}
+
+// AddFulfilledFee adds u to the "fulfilled_fee" field.
+func (m *ProofRequestMutation) AddFulfilledFee(u int64) {
+	if m.addfulfilled_fee != nil {
+		*m.addfulfilled_fee += u
+	} else {
+		m.addfulfilled_fee = &u
+	}
+}
+
+// AddedFulfilledFee returns the value that was added to the "fulfilled_fee" field in this mutation.
+func (m *ProofRequestMutation) AddedFulfilledFee() (r int64, exists bool) {
+	v := m.addfulfilled_fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFulfilledFee clears the value of the "fulfilled_fee" field.
+func (m *ProofRequestMutation) ClearFulfilledFee() {
+	m.fulfilled_fee = nil
+	m.addfulfilled_fee = nil
+	m.clearedFields[proofrequest.FieldFulfilledFee] = struct{}{}
+}
+
+// FulfilledFeeCleared returns if the "fulfilled_fee" field was cleared in this mutation.
+func (m *ProofRequestMutation) FulfilledFeeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFulfilledFee]
+	return ok
+}
+
+// ResetFulfilledFee resets all changes to the "fulfilled_fee" field.
+func (m *ProofRequestMutation) ResetFulfilledFee() {
+	m.fulfilled_fee = nil
+	m.addfulfilled_fee = nil
+	delete(m.clearedFields, proofrequest.FieldFulfilledFee)
+}
+
+// SetProver sets the "prover" field.
+func (m *ProofRequestMutation) SetProver(s string) {
+	m.prover = &s
+}
+
+// Prover returns the value of the "prover" field in the mutation.
+func (m *ProofRequestMutation) Prover() (r string, exists bool) {
+	v := m.prover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProver returns the old "prover" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProver(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProver is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProver requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProver: %w", err)
+	}
+	return oldValue.Prover, nil
+}
+
+// ClearProver clears the value of the "prover" field.
+func (m *ProofRequestMutation) ClearProver() {
+	m.prover = nil
+	m.clearedFields[proofrequest.FieldProver] = struct{}{}
+}
+
+// ProverCleared returns if the "prover" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProverCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProver]
+	return ok
+}
+
+// ResetProver resets all changes to the "prover" field.
+func (m *ProofRequestMutation) ResetProver() {
+	m.prover = nil
+	delete(m.clearedFields, proofrequest.FieldProver)
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (m *ProofRequestMutation) SetFulfilledTime(u uint64) {
+	m.fulfilled_time = &u
+	m.addfulfilled_time = nil
+}
+
+// FulfilledTime returns the value of the "fulfilled_time" field in the mutation.
+func (m *ProofRequestMutation) FulfilledTime() (r uint64, exists bool) {
+	v := m.fulfilled_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFulfilledTime returns the old "fulfilled_time" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFulfilledTime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFulfilledTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFulfilledTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFulfilledTime: %w", err)
+	}
+	return oldValue.FulfilledTime, nil
+}
+
+// AddFulfilledTime adds u to the "fulfilled_time" field.
+func (m *ProofRequestMutation) AddFulfilledTime(u int64) {
+	if m.addfulfilled_time != nil {
+		*m.addfulfilled_time += u
+	} else {
+		m.addfulfilled_time = &u
+	}
+}
+
+// AddedFulfilledTime returns the value that was added to the "fulfilled_time" field in this mutation.
+func (m *ProofRequestMutation) AddedFulfilledTime() (r int64, exists bool) {
+	v := m.addfulfilled_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFulfilledTime clears the value of the "fulfilled_time" field.
+func (m *ProofRequestMutation) ClearFulfilledTime() {
+	m.fulfilled_time = nil
+	m.addfulfilled_time = nil
+	m.clearedFields[proofrequest.FieldFulfilledTime] = struct{}{}
+}
+
+// FulfilledTimeCleared returns if the "fulfilled_time" field was cleared in this mutation.
+func (m *ProofRequestMutation) FulfilledTimeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFulfilledTime]
+	return ok
+}
+
+// ResetFulfilledTime resets all changes to the "fulfilled_time" field.
+func (m *ProofRequestMutation) ResetFulfilledTime() {
+	m.fulfilled_time = nil
+	m.addfulfilled_time = nil
+	delete(m.clearedFields, proofrequest.FieldFulfilledTime)
+}
+
+// SetProgramVersion sets the "program_version" field.
+func (m *ProofRequestMutation) SetProgramVersion(s string) {
+	m.program_version = &s
+}
+
+// ProgramVersion returns the value of the "program_version" field in the mutation.
+func (m *ProofRequestMutation) ProgramVersion() (r string, exists bool) {
+	v := m.program_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgramVersion returns the old "program_version" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldProgramVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgramVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgramVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgramVersion: %w", err)
+	}
+	return oldValue.ProgramVersion, nil
+}
+
+// ClearProgramVersion clears the value of the "program_version" field.
+func (m *ProofRequestMutation) ClearProgramVersion() {
+	m.program_version = nil
+	m.clearedFields[proofrequest.FieldProgramVersion] = struct{}{}
+}
+
+// ProgramVersionCleared returns if the "program_version" field was cleared in this mutation.
+func (m *ProofRequestMutation) ProgramVersionCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldProgramVersion]
+	return ok
+}
+
+// ResetProgramVersion resets all changes to the "program_version" field.
+func (m *ProofRequestMutation) ResetProgramVersion() {
+	m.program_version = nil
+	delete(m.clearedFields, proofrequest.FieldProgramVersion)
+}
+
+// SetPublicValues sets the "public_values" field.
+func (m *ProofRequestMutation) SetPublicValues(b []byte) {
+	m.public_values = &b
+}
+
+// PublicValues returns the value of the "public_values" field in the mutation.
+func (m *ProofRequestMutation) PublicValues() (r []byte, exists bool) {
+	v := m.public_values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicValues returns the old "public_values" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldPublicValues(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicValues: %w", err)
+	}
+	return oldValue.PublicValues, nil
+}
+
+// ClearPublicValues clears the value of the "public_values" field.
+func (m *ProofRequestMutation) ClearPublicValues() {
+	m.public_values = nil
+	m.clearedFields[proofrequest.FieldPublicValues] = struct{}{}
+}
+
+// PublicValuesCleared returns if the "public_values" field was cleared in this mutation.
+func (m *ProofRequestMutation) PublicValuesCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldPublicValues]
+	return ok
+}
+
+// ResetPublicValues resets all changes to the "public_values" field.
+func (m *ProofRequestMutation) ResetPublicValues() {
+	m.public_values = nil
+	delete(m.clearedFields, proofrequest.FieldPublicValues)
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *ProofRequestMutation) SetErrorCode(s string) {
+	m.error_code = &s
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *ProofRequestMutation) ErrorCode() (r string, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldErrorCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *ProofRequestMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.clearedFields[proofrequest.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *ProofRequestMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *ProofRequestMutation) ResetErrorCode() {
+	m.error_code = nil
+	delete(m.clearedFields, proofrequest.FieldErrorCode)
+}
+
+// SetFailureStage sets the "failure_stage" field.
+func (m *ProofRequestMutation) SetFailureStage(ps proofrequest.FailureStage) {
+	m.failure_stage = &ps
+}
+
+// FailureStage returns the value of the "failure_stage" field in the mutation.
+func (m *ProofRequestMutation) FailureStage() (r proofrequest.FailureStage, exists bool) {
+	v := m.failure_stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureStage returns the old "failure_stage" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFailureStage(ctx context.Context) (v proofrequest.FailureStage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureStage: %w", err)
+	}
+	return oldValue.FailureStage, nil
+}
+
+// ClearFailureStage clears the value of the "failure_stage" field.
+func (m *ProofRequestMutation) ClearFailureStage() {
+	m.failure_stage = nil
+	m.clearedFields[proofrequest.FieldFailureStage] = struct{}{}
+}
+
+// FailureStageCleared returns if the "failure_stage" field was cleared in this mutation.
+func (m *ProofRequestMutation) FailureStageCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldFailureStage]
+	return ok
+}
+
+// ResetFailureStage resets all changes to the "failure_stage" field.
+func (m *ProofRequestMutation) ResetFailureStage() {
+	m.failure_stage = nil
+	delete(m.clearedFields, proofrequest.FieldFailureStage)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *ProofRequestMutation) SetParentID(i int) {
+	m.parent_id = &i
+	m.addparent_id = nil
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *ProofRequestMutation) ParentID() (r int, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldParentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (m *ProofRequestMutation) AddParentID(i int) {
+	if m.addparent_id != nil {
+		*m.addparent_id += i
+	} else {
+		m.addparent_id = &i
+	}
+}
+
+// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
+func (m *ProofRequestMutation) AddedParentID() (r int, exists bool) {
+	v := m.addparent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *ProofRequestMutation) ClearParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+	m.clearedFields[proofrequest.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *ProofRequestMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *ProofRequestMutation) ResetParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+	delete(m.clearedFields, proofrequest.FieldParentID)
+}
+
+// SetLabels sets the "labels" field.
+func (m *ProofRequestMutation) SetLabels(s []string) {
+	m.labels = &s
+	m.appendlabels = nil
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *ProofRequestMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// AppendLabels adds s to the "labels" field.
+func (m *ProofRequestMutation) AppendLabels(s []string) {
+	m.appendlabels = append(m.appendlabels, s...)
+}
+
+// AppendedLabels returns the list of values that were appended to the "labels" field in this mutation.
+func (m *ProofRequestMutation) AppendedLabels() ([]string, bool) {
+	if len(m.appendlabels) == 0 {
+		return nil, false
+	}
+	return m.appendlabels, true
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *ProofRequestMutation) ClearLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	m.clearedFields[proofrequest.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *ProofRequestMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *ProofRequestMutation) ResetLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	delete(m.clearedFields, proofrequest.FieldLabels)
+}
+
+// SetChallengeStatus sets the "challenge_status" field.
+func (m *ProofRequestMutation) SetChallengeStatus(ps proofrequest.ChallengeStatus) {
+	m.challenge_status = &ps
+}
+
+// ChallengeStatus returns the value of the "challenge_status" field in the mutation.
+func (m *ProofRequestMutation) ChallengeStatus() (r proofrequest.ChallengeStatus, exists bool) {
+	v := m.challenge_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChallengeStatus returns the old "challenge_status" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldChallengeStatus(ctx context.Context) (v proofrequest.ChallengeStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChallengeStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChallengeStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChallengeStatus: %w", err)
+	}
+	return oldValue.ChallengeStatus, nil
+}
+
+// ClearChallengeStatus clears the value of the "challenge_status" field.
+func (m *ProofRequestMutation) ClearChallengeStatus() {
+	m.challenge_status = nil
+	m.clearedFields[proofrequest.FieldChallengeStatus] = struct{}{}
+}
+
+// ChallengeStatusCleared returns if the "challenge_status" field was cleared in this mutation.
+func (m *ProofRequestMutation) ChallengeStatusCleared() bool {
+	_, ok := m.clearedFields[proofrequest.FieldChallengeStatus]
+	return ok
+}
+
+// ResetChallengeStatus resets all changes to the "challenge_status" field.
+func (m *ProofRequestMutation) ResetChallengeStatus() {
+	m.challenge_status = nil
+	delete(m.clearedFields, proofrequest.FieldChallengeStatus)
+}
+
+// SetFinal sets the "final" field.
+func (m *ProofRequestMutation) SetFinal(b bool) {
+	m.final = &b
+}
+
+// Final returns the value of the "final" field in the mutation.
+func (m *ProofRequestMutation) Final() (r bool, exists bool) {
+	v := m.final
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinal returns the old "final" field's value of the ProofRequest entity.
+// If the ProofRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofRequestMutation) OldFinal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinal: %w", err)
+	}
+	return oldValue.Final, nil
+}
+
+// ResetFinal resets all changes to the "final" field.
+func (m *ProofRequestMutation) ResetFinal() {
+	m.final = nil
+}
+
 // Where appends a list predicates to the ProofRequestMutation builder.
 func (m *ProofRequestMutation) Where(ps ...predicate.ProofRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProofRequestMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProofRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProofRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProofRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProofRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProofRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProofRequest).
+func (m *ProofRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProofRequestMutation) Fields() []string {
+	fields := make([]string, 0, 30)
+	if m._type != nil {
+		fields = append(fields, proofrequest.FieldType)
+	}
+	if m.start_block != nil {
+		fields = append(fields, proofrequest.FieldStartBlock)
+	}
+	if m.end_block != nil {
+		fields = append(fields, proofrequest.FieldEndBlock)
+	}
+	if m.status != nil {
+		fields = append(fields, proofrequest.FieldStatus)
+	}
+	if m.request_added_time != nil {
+		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	}
+	if m.prover_request_id != nil {
+		fields = append(fields, proofrequest.FieldProverRequestID)
+	}
+	if m.proof_request_time != nil {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.last_updated_time != nil {
+		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	}
+	if m.l1_block_number != nil {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.l1_block_hash != nil {
+		fields = append(fields, proofrequest.FieldL1BlockHash)
+	}
+	if m.proof != nil {
+		fields = append(fields, proofrequest.FieldProof)
+	}
+	if m.estimated_cycles != nil {
+		fields = append(fields, proofrequest.FieldEstimatedCycles)
+	}
+	if m.estimated_fee != nil {
+		fields = append(fields, proofrequest.FieldEstimatedFee)
+	}
+	if m.proof_system != nil {
+		fields = append(fields, proofrequest.FieldProofSystem)
+	}
+	if m.vkey_hash != nil {
+		fields = append(fields, proofrequest.FieldVkeyHash)
+	}
+	if m.proof_format != nil {
+		fields = append(fields, proofrequest.FieldProofFormat)
+	}
+	if m.backfill != nil {
+		fields = append(fields, proofrequest.FieldBackfill)
+	}
+	if m.failure_reason != nil {
+		fields = append(fields, proofrequest.FieldFailureReason)
+	}
+	if m.fulfilled_cycles != nil {
+		fields = append(fields, proofrequest.FieldFulfilledCycles)
+	}
+	if m.fulfilled_fee != nil {
+		fields = append(fields, proofrequest.FieldFulfilledFee)
+	}
+	if m.prover != nil {
+		fields = append(fields, proofrequest.FieldProver)
+	}
+	if m.fulfilled_time != nil {
+		fields = append(fields, proofrequest.FieldFulfilledTime)
+	}
+	if m.program_version != nil {
+		fields = append(fields, proofrequest.FieldProgramVersion)
+	}
+	if m.public_values != nil {
+		fields = append(fields, proofrequest.FieldPublicValues)
+	}
+	if m.error_code != nil {
+		fields = append(fields, proofrequest.FieldErrorCode)
+	}
+	if m.failure_stage != nil {
+		fields = append(fields, proofrequest.FieldFailureStage)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, proofrequest.FieldParentID)
+	}
+	if m.labels != nil {
+		fields = append(fields, proofrequest.FieldLabels)
+	}
+	if m.challenge_status != nil {
+		fields = append(fields, proofrequest.FieldChallengeStatus)
+	}
+	if m.final != nil {
+		fields = append(fields, proofrequest.FieldFinal)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequest.FieldType:
+		return m.GetType()
+	case proofrequest.FieldStartBlock:
+		return m.StartBlock()
+	case proofrequest.FieldEndBlock:
+		return m.EndBlock()
+	case proofrequest.FieldStatus:
+		return m.Status()
+	case proofrequest.FieldRequestAddedTime:
+		return m.RequestAddedTime()
+	case proofrequest.FieldProverRequestID:
+		return m.ProverRequestID()
+	case proofrequest.FieldProofRequestTime:
+		return m.ProofRequestTime()
+	case proofrequest.FieldLastUpdatedTime:
+		return m.LastUpdatedTime()
+	case proofrequest.FieldL1BlockNumber:
+		return m.L1BlockNumber()
+	case proofrequest.FieldL1BlockHash:
+		return m.L1BlockHash()
+	case proofrequest.FieldProof:
+		return m.Proof()
+	case proofrequest.FieldEstimatedCycles:
+		return m.EstimatedCycles()
+	case proofrequest.FieldEstimatedFee:
+		return m.EstimatedFee()
+	case proofrequest.FieldProofSystem:
+		return m.ProofSystem()
+	case proofrequest.FieldVkeyHash:
+		return m.VkeyHash()
+	case proofrequest.FieldProofFormat:
+		return m.ProofFormat()
+	case proofrequest.FieldBackfill:
+		return m.Backfill()
+	case proofrequest.FieldFailureReason:
+		return m.FailureReason()
+	case proofrequest.FieldFulfilledCycles:
+		return m.FulfilledCycles()
+	case proofrequest.FieldFulfilledFee:
+		return m.FulfilledFee()
+	case proofrequest.FieldProver:
+		return m.Prover()
+	case proofrequest.FieldFulfilledTime:
+		return m.FulfilledTime()
+	case proofrequest.FieldProgramVersion:
+		return m.ProgramVersion()
+	case proofrequest.FieldPublicValues:
+		return m.PublicValues()
+	case proofrequest.FieldErrorCode:
+		return m.ErrorCode()
+	case proofrequest.FieldFailureStage:
+		return m.FailureStage()
+	case proofrequest.FieldParentID:
+		return m.ParentID()
+	case proofrequest.FieldLabels:
+		return m.Labels()
+	case proofrequest.FieldChallengeStatus:
+		return m.ChallengeStatus()
+	case proofrequest.FieldFinal:
+		return m.Final()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProofRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case proofrequest.FieldType:
+		return m.OldType(ctx)
+	case proofrequest.FieldStartBlock:
+		return m.OldStartBlock(ctx)
+	case proofrequest.FieldEndBlock:
+		return m.OldEndBlock(ctx)
+	case proofrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case proofrequest.FieldRequestAddedTime:
+		return m.OldRequestAddedTime(ctx)
+	case proofrequest.FieldProverRequestID:
+		return m.OldProverRequestID(ctx)
+	case proofrequest.FieldProofRequestTime:
+		return m.OldProofRequestTime(ctx)
+	case proofrequest.FieldLastUpdatedTime:
+		return m.OldLastUpdatedTime(ctx)
+	case proofrequest.FieldL1BlockNumber:
+		return m.OldL1BlockNumber(ctx)
+	case proofrequest.FieldL1BlockHash:
+		return m.OldL1BlockHash(ctx)
+	case proofrequest.FieldProof:
+		return m.OldProof(ctx)
+	case proofrequest.FieldEstimatedCycles:
+		return m.OldEstimatedCycles(ctx)
+	case proofrequest.FieldEstimatedFee:
+		return m.OldEstimatedFee(ctx)
+	case proofrequest.FieldProofSystem:
+		return m.OldProofSystem(ctx)
+	case proofrequest.FieldVkeyHash:
+		return m.OldVkeyHash(ctx)
+	case proofrequest.FieldProofFormat:
+		return m.OldProofFormat(ctx)
+	case proofrequest.FieldBackfill:
+		return m.OldBackfill(ctx)
+	case proofrequest.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case proofrequest.FieldFulfilledCycles:
+		return m.OldFulfilledCycles(ctx)
+	case proofrequest.FieldFulfilledFee:
+		return m.OldFulfilledFee(ctx)
+	case proofrequest.FieldProver:
+		return m.OldProver(ctx)
+	case proofrequest.FieldFulfilledTime:
+		return m.OldFulfilledTime(ctx)
+	case proofrequest.FieldProgramVersion:
+		return m.OldProgramVersion(ctx)
+	case proofrequest.FieldPublicValues:
+		return m.OldPublicValues(ctx)
+	case proofrequest.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	case proofrequest.FieldFailureStage:
+		return m.OldFailureStage(ctx)
+	case proofrequest.FieldParentID:
+		return m.OldParentID(ctx)
+	case proofrequest.FieldLabels:
+		return m.OldLabels(ctx)
+	case proofrequest.FieldChallengeStatus:
+		return m.OldChallengeStatus(ctx)
+	case proofrequest.FieldFinal:
+		return m.OldFinal(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case proofrequest.FieldType:
+		v, ok := value.(proofrequest.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case proofrequest.FieldStartBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartBlock(v)
+		return nil
+	case proofrequest.FieldEndBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndBlock(v)
+		return nil
+	case proofrequest.FieldStatus:
+		v, ok := value.(proofrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestAddedTime(v)
+		return nil
+	case proofrequest.FieldProverRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProverRequestID(v)
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofRequestTime(v)
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdatedTime(v)
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL1BlockNumber(v)
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetL1BlockHash(v)
+		return nil
+	case proofrequest.FieldProof:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProof(v)
+		return nil
+	case proofrequest.FieldEstimatedCycles:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstimatedCycles(v)
+		return nil
+	case proofrequest.FieldEstimatedFee:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstimatedFee(v)
+		return nil
+	case proofrequest.FieldProofSystem:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofSystem(v)
+		return nil
+	case proofrequest.FieldVkeyHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVkeyHash(v)
+		return nil
+	case proofrequest.FieldProofFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProofFormat(v)
+		return nil
+	case proofrequest.FieldBackfill:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackfill(v)
+		return nil
+	case proofrequest.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
+	case proofrequest.FieldFulfilledCycles:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFulfilledCycles(v)
+		return nil
+	case proofrequest.FieldFulfilledFee:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFulfilledFee(v)
+		return nil
+	case proofrequest.FieldProver:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProver(v)
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFulfilledTime(v)
+		return nil
+	case proofrequest.FieldProgramVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgramVersion(v)
+		return nil
+	case proofrequest.FieldPublicValues:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicValues(v)
+		return nil
+	case proofrequest.FieldErrorCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	case proofrequest.FieldFailureStage:
+		v, ok := value.(proofrequest.FailureStage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureStage(v)
+		return nil
+	case proofrequest.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case proofrequest.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case proofrequest.FieldChallengeStatus:
+		v, ok := value.(proofrequest.ChallengeStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChallengeStatus(v)
+		return nil
+	case proofrequest.FieldFinal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProofRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_block != nil {
+		fields = append(fields, proofrequest.FieldStartBlock)
+	}
+	if m.addend_block != nil {
+		fields = append(fields, proofrequest.FieldEndBlock)
+	}
+	if m.addrequest_added_time != nil {
+		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	}
+	if m.addproof_request_time != nil {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.addlast_updated_time != nil {
+		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	}
+	if m.addl1_block_number != nil {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.addestimated_cycles != nil {
+		fields = append(fields, proofrequest.FieldEstimatedCycles)
+	}
+	if m.addestimated_fee != nil {
+		fields = append(fields, proofrequest.FieldEstimatedFee)
+	}
+	if m.addfulfilled_cycles != nil {
+		fields = append(fields, proofrequest.FieldFulfilledCycles)
+	}
+	if m.addfulfilled_fee != nil {
+		fields = append(fields, proofrequest.FieldFulfilledFee)
+	}
+	if m.addfulfilled_time != nil {
+		fields = append(fields, proofrequest.FieldFulfilledTime)
+	}
+	if m.addparent_id != nil {
+		fields = append(fields, proofrequest.FieldParentID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case proofrequest.FieldStartBlock:
+		return m.AddedStartBlock()
+	case proofrequest.FieldEndBlock:
+		return m.AddedEndBlock()
+	case proofrequest.FieldRequestAddedTime:
+		return m.AddedRequestAddedTime()
+	case proofrequest.FieldProofRequestTime:
+		return m.AddedProofRequestTime()
+	case proofrequest.FieldLastUpdatedTime:
+		return m.AddedLastUpdatedTime()
+	case proofrequest.FieldL1BlockNumber:
+		return m.AddedL1BlockNumber()
+	case proofrequest.FieldEstimatedCycles:
+		return m.AddedEstimatedCycles()
+	case proofrequest.FieldEstimatedFee:
+		return m.AddedEstimatedFee()
+	case proofrequest.FieldFulfilledCycles:
+		return m.AddedFulfilledCycles()
+	case proofrequest.FieldFulfilledFee:
+		return m.AddedFulfilledFee()
+	case proofrequest.FieldFulfilledTime:
+		return m.AddedFulfilledTime()
+	case proofrequest.FieldParentID:
+		return m.AddedParentID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProofRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case proofrequest.FieldStartBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartBlock(v)
+		return nil
+	case proofrequest.FieldEndBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndBlock(v)
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequestAddedTime(v)
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProofRequestTime(v)
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastUpdatedTime(v)
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddL1BlockNumber(v)
+		return nil
+	case proofrequest.FieldEstimatedCycles:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEstimatedCycles(v)
+		return nil
+	case proofrequest.FieldEstimatedFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEstimatedFee(v)
+		return nil
+	case proofrequest.FieldFulfilledCycles:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFulfilledCycles(v)
+		return nil
+	case proofrequest.FieldFulfilledFee:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFulfilledFee(v)
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFulfilledTime(v)
+		return nil
+	case proofrequest.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProofRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(proofrequest.FieldProverRequestID) {
+		fields = append(fields, proofrequest.FieldProverRequestID)
+	}
+	if m.FieldCleared(proofrequest.FieldProofRequestTime) {
+		fields = append(fields, proofrequest.FieldProofRequestTime)
+	}
+	if m.FieldCleared(proofrequest.FieldL1BlockNumber) {
+		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	}
+	if m.FieldCleared(proofrequest.FieldL1BlockHash) {
+		fields = append(fields, proofrequest.FieldL1BlockHash)
+	}
+	if m.FieldCleared(proofrequest.FieldProof) {
+		fields = append(fields, proofrequest.FieldProof)
+	}
+	if m.FieldCleared(proofrequest.FieldEstimatedCycles) {
+		fields = append(fields, proofrequest.FieldEstimatedCycles)
+	}
+	if m.FieldCleared(proofrequest.FieldEstimatedFee) {
+		fields = append(fields, proofrequest.FieldEstimatedFee)
+	}
+	if m.FieldCleared(proofrequest.FieldProofSystem) {
+		fields = append(fields, proofrequest.FieldProofSystem)
+	}
+	if m.FieldCleared(proofrequest.FieldVkeyHash) {
+		fields = append(fields, proofrequest.FieldVkeyHash)
+	}
+	if m.FieldCleared(proofrequest.FieldProofFormat) {
+		fields = append(fields, proofrequest.FieldProofFormat)
+	}
+	if m.FieldCleared(proofrequest.FieldFailureReason) {
+		fields = append(fields, proofrequest.FieldFailureReason)
+	}
+	if m.FieldCleared(proofrequest.FieldFulfilledCycles) {
+		fields = append(fields, proofrequest.FieldFulfilledCycles)
+	}
+	if m.FieldCleared(proofrequest.FieldFulfilledFee) {
+		fields = append(fields, proofrequest.FieldFulfilledFee)
+	}
+	if m.FieldCleared(proofrequest.FieldProver) {
+		fields = append(fields, proofrequest.FieldProver)
+	}
+	if m.FieldCleared(proofrequest.FieldFulfilledTime) {
+		fields = append(fields, proofrequest.FieldFulfilledTime)
+	}
+	if m.FieldCleared(proofrequest.FieldProgramVersion) {
+		fields = append(fields, proofrequest.FieldProgramVersion)
+	}
+	if m.FieldCleared(proofrequest.FieldPublicValues) {
+		fields = append(fields, proofrequest.FieldPublicValues)
+	}
+	if m.FieldCleared(proofrequest.FieldErrorCode) {
+		fields = append(fields, proofrequest.FieldErrorCode)
+	}
+	if m.FieldCleared(proofrequest.FieldFailureStage) {
+		fields = append(fields, proofrequest.FieldFailureStage)
+	}
+	if m.FieldCleared(proofrequest.FieldParentID) {
+		fields = append(fields, proofrequest.FieldParentID)
+	}
+	if m.FieldCleared(proofrequest.FieldLabels) {
+		fields = append(fields, proofrequest.FieldLabels)
+	}
+	if m.FieldCleared(proofrequest.FieldChallengeStatus) {
+		fields = append(fields, proofrequest.FieldChallengeStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProofRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProofRequestMutation) ClearField(name string) error {
+	switch name {
+	case proofrequest.FieldProverRequestID:
+		m.ClearProverRequestID()
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		m.ClearProofRequestTime()
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		m.ClearL1BlockNumber()
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		m.ClearL1BlockHash()
+		return nil
+	case proofrequest.FieldProof:
+		m.ClearProof()
+		return nil
+	case proofrequest.FieldEstimatedCycles:
+		m.ClearEstimatedCycles()
+		return nil
+	case proofrequest.FieldEstimatedFee:
+		m.ClearEstimatedFee()
+		return nil
+	case proofrequest.FieldProofSystem:
+		m.ClearProofSystem()
+		return nil
+	case proofrequest.FieldVkeyHash:
+		m.ClearVkeyHash()
+		return nil
+	case proofrequest.FieldProofFormat:
+		m.ClearProofFormat()
+		return nil
+	case proofrequest.FieldFailureReason:
+		m.ClearFailureReason()
+		return nil
+	case proofrequest.FieldFulfilledCycles:
+		m.ClearFulfilledCycles()
+		return nil
+	case proofrequest.FieldFulfilledFee:
+		m.ClearFulfilledFee()
+		return nil
+	case proofrequest.FieldProver:
+		m.ClearProver()
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		m.ClearFulfilledTime()
+		return nil
+	case proofrequest.FieldProgramVersion:
+		m.ClearProgramVersion()
+		return nil
+	case proofrequest.FieldPublicValues:
+		m.ClearPublicValues()
+		return nil
+	case proofrequest.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	case proofrequest.FieldFailureStage:
+		m.ClearFailureStage()
+		return nil
+	case proofrequest.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case proofrequest.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case proofrequest.FieldChallengeStatus:
+		m.ClearChallengeStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProofRequestMutation) ResetField(name string) error {
+	switch name {
+	case proofrequest.FieldType:
+		m.ResetType()
+		return nil
+	case proofrequest.FieldStartBlock:
+		m.ResetStartBlock()
+		return nil
+	case proofrequest.FieldEndBlock:
+		m.ResetEndBlock()
+		return nil
+	case proofrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case proofrequest.FieldRequestAddedTime:
+		m.ResetRequestAddedTime()
+		return nil
+	case proofrequest.FieldProverRequestID:
+		m.ResetProverRequestID()
+		return nil
+	case proofrequest.FieldProofRequestTime:
+		m.ResetProofRequestTime()
+		return nil
+	case proofrequest.FieldLastUpdatedTime:
+		m.ResetLastUpdatedTime()
+		return nil
+	case proofrequest.FieldL1BlockNumber:
+		m.ResetL1BlockNumber()
+		return nil
+	case proofrequest.FieldL1BlockHash:
+		m.ResetL1BlockHash()
+		return nil
+	case proofrequest.FieldProof:
+		m.ResetProof()
+		return nil
+	case proofrequest.FieldEstimatedCycles:
+		m.ResetEstimatedCycles()
+		return nil
+	case proofrequest.FieldEstimatedFee:
+		m.ResetEstimatedFee()
+		return nil
+	case proofrequest.FieldProofSystem:
+		m.ResetProofSystem()
+		return nil
+	case proofrequest.FieldVkeyHash:
+		m.ResetVkeyHash()
+		return nil
+	case proofrequest.FieldProofFormat:
+		m.ResetProofFormat()
+		return nil
+	case proofrequest.FieldBackfill:
+		m.ResetBackfill()
+		return nil
+	case proofrequest.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
+	case proofrequest.FieldFulfilledCycles:
+		m.ResetFulfilledCycles()
+		return nil
+	case proofrequest.FieldFulfilledFee:
+		m.ResetFulfilledFee()
+		return nil
+	case proofrequest.FieldProver:
+		m.ResetProver()
+		return nil
+	case proofrequest.FieldFulfilledTime:
+		m.ResetFulfilledTime()
+		return nil
+	case proofrequest.FieldProgramVersion:
+		m.ResetProgramVersion()
+		return nil
+	case proofrequest.FieldPublicValues:
+		m.ResetPublicValues()
+		return nil
+	case proofrequest.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	case proofrequest.FieldFailureStage:
+		m.ResetFailureStage()
+		return nil
+	case proofrequest.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case proofrequest.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case proofrequest.FieldChallengeStatus:
+		m.ResetChallengeStatus()
+		return nil
+	case proofrequest.FieldFinal:
+		m.ResetFinal()
+		return nil
+	}
+	return fmt.Errorf("unknown ProofRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProofRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProofRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProofRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProofRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProofRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProofRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProofRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProofRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProofRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProofRequest edge %s", name)
+}
+
+// ProofStatMutation represents an operation that mutates the ProofStat nodes in the graph.
+type ProofStatMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	period             *proofstat.Period
+	period_start       *uint64
+	addperiod_start    *int64
+	requested          *int
+	addrequested       *int
+	fulfilled          *int
+	addfulfilled       *int
+	failed             *int
+	addfailed          *int
+	blocks_proven      *uint64
+	addblocks_proven   *int64
+	average_latency    *float64
+	addaverage_latency *float64
+	spend              *uint64
+	addspend           *int64
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ProofStat, error)
+	predicates         []predicate.ProofStat
+}
+
+var _ ent.Mutation = (*ProofStatMutation)(nil)
+
+// proofstatOption allows management of the mutation configuration using functional options.
+type proofstatOption func(*ProofStatMutation)
+
+// newProofStatMutation creates new mutation for the ProofStat entity.
+func newProofStatMutation(c config, op Op, opts ...proofstatOption) *ProofStatMutation {
+	m := &ProofStatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProofStat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProofStatID sets the ID field of the mutation.
+func withProofStatID(id int) proofstatOption {
+	return func(m *ProofStatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProofStat
+		)
+		m.oldValue = func(ctx context.Context) (*ProofStat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProofStat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProofStat sets the old ProofStat of the mutation.
+func withProofStat(node *ProofStat) proofstatOption {
+	return func(m *ProofStatMutation) {
+		m.oldValue = func(context.Context) (*ProofStat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProofStatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProofStatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProofStatMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProofStatMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProofStat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPeriod sets the "period" field.
+func (m *ProofStatMutation) SetPeriod(pr proofstat.Period) {
+	m.period = &pr
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *ProofStatMutation) Period() (r proofstat.Period, exists bool) {
+	v := m.period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriod returns the old "period" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldPeriod(ctx context.Context) (v proofstat.Period, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
+}
+
+// ResetPeriod resets all changes to the "period" field.
+func (m *ProofStatMutation) ResetPeriod() {
+	m.period = nil
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *ProofStatMutation) SetPeriodStart(u uint64) {
+	m.period_start = &u
+	m.addperiod_start = nil
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *ProofStatMutation) PeriodStart() (r uint64, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodStart returns the old "period_start" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldPeriodStart(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// AddPeriodStart adds u to the "period_start" field.
+func (m *ProofStatMutation) AddPeriodStart(u int64) {
+	if m.addperiod_start != nil {
+		*m.addperiod_start += u
+	} else {
+		m.addperiod_start = &u
+	}
+}
+
+// AddedPeriodStart returns the value that was added to the "period_start" field in this mutation.
+func (m *ProofStatMutation) AddedPeriodStart() (r int64, exists bool) {
+	v := m.addperiod_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *ProofStatMutation) ResetPeriodStart() {
+	m.period_start = nil
+	m.addperiod_start = nil
+}
+
+// SetRequested sets the "requested" field.
+func (m *ProofStatMutation) SetRequested(i int) {
+	m.requested = &i
+	m.addrequested = nil
+}
+
+// Requested returns the value of the "requested" field in the mutation.
+func (m *ProofStatMutation) Requested() (r int, exists bool) {
+	v := m.requested
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequested returns the old "requested" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldRequested(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequested is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequested requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequested: %w", err)
+	}
+	return oldValue.Requested, nil
+}
+
+// AddRequested adds i to the "requested" field.
+func (m *ProofStatMutation) AddRequested(i int) {
+	if m.addrequested != nil {
+		*m.addrequested += i
+	} else {
+		m.addrequested = &i
+	}
+}
+
+// AddedRequested returns the value that was added to the "requested" field in this mutation.
+func (m *ProofStatMutation) AddedRequested() (r int, exists bool) {
+	v := m.addrequested
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequested resets all changes to the "requested" field.
+func (m *ProofStatMutation) ResetRequested() {
+	m.requested = nil
+	m.addrequested = nil
+}
+
+// SetFulfilled sets the "fulfilled" field.
+func (m *ProofStatMutation) SetFulfilled(i int) {
+	m.fulfilled = &i
+	m.addfulfilled = nil
+}
+
+// Fulfilled returns the value of the "fulfilled" field in the mutation.
+func (m *ProofStatMutation) Fulfilled() (r int, exists bool) {
+	v := m.fulfilled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFulfilled returns the old "fulfilled" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldFulfilled(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFulfilled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFulfilled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFulfilled: %w", err)
+	}
+	return oldValue.Fulfilled, nil
+}
+
+// AddFulfilled adds i to the "fulfilled" field.
+func (m *ProofStatMutation) AddFulfilled(i int) {
+	if m.addfulfilled != nil {
+		*m.addfulfilled += i
+	} else {
+		m.addfulfilled = &i
+	}
+}
+
+// AddedFulfilled returns the value that was added to the "fulfilled" field in this mutation.
+func (m *ProofStatMutation) AddedFulfilled() (r int, exists bool) {
+	v := m.addfulfilled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFulfilled resets all changes to the "fulfilled" field.
+func (m *ProofStatMutation) ResetFulfilled() {
+	m.fulfilled = nil
+	m.addfulfilled = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *ProofStatMutation) SetFailed(i int) {
+	m.failed = &i
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *ProofStatMutation) Failed() (r int, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldFailed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds i to the "failed" field.
+func (m *ProofStatMutation) AddFailed(i int) {
+	if m.addfailed != nil {
+		*m.addfailed += i
+	} else {
+		m.addfailed = &i
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *ProofStatMutation) AddedFailed() (r int, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *ProofStatMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetBlocksProven sets the "blocks_proven" field.
+func (m *ProofStatMutation) SetBlocksProven(u uint64) {
+	m.blocks_proven = &u
+	m.addblocks_proven = nil
+}
+
+// BlocksProven returns the value of the "blocks_proven" field in the mutation.
+func (m *ProofStatMutation) BlocksProven() (r uint64, exists bool) {
+	v := m.blocks_proven
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlocksProven returns the old "blocks_proven" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldBlocksProven(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlocksProven is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlocksProven requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlocksProven: %w", err)
+	}
+	return oldValue.BlocksProven, nil
+}
+
+// AddBlocksProven adds u to the "blocks_proven" field.
+func (m *ProofStatMutation) AddBlocksProven(u int64) {
+	if m.addblocks_proven != nil {
+		*m.addblocks_proven += u
+	} else {
+		m.addblocks_proven = &u
+	}
+}
+
+// AddedBlocksProven returns the value that was added to the "blocks_proven" field in this mutation.
+func (m *ProofStatMutation) AddedBlocksProven() (r int64, exists bool) {
+	v := m.addblocks_proven
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlocksProven resets all changes to the "blocks_proven" field.
+func (m *ProofStatMutation) ResetBlocksProven() {
+	m.blocks_proven = nil
+	m.addblocks_proven = nil
+}
+
+// SetAverageLatency sets the "average_latency" field.
+func (m *ProofStatMutation) SetAverageLatency(f float64) {
+	m.average_latency = &f
+	m.addaverage_latency = nil
+}
+
+// AverageLatency returns the value of the "average_latency" field in the mutation.
+func (m *ProofStatMutation) AverageLatency() (r float64, exists bool) {
+	v := m.average_latency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAverageLatency returns the old "average_latency" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldAverageLatency(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAverageLatency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAverageLatency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAverageLatency: %w", err)
+	}
+	return oldValue.AverageLatency, nil
+}
+
+// AddAverageLatency adds f to the "average_latency" field.
+func (m *ProofStatMutation) AddAverageLatency(f float64) {
+	if m.addaverage_latency != nil {
+		*m.addaverage_latency += f
+	} else {
+		m.addaverage_latency = &f
+	}
+}
+
+// AddedAverageLatency returns the value that was added to the "average_latency" field in this mutation.
+func (m *ProofStatMutation) AddedAverageLatency() (r float64, exists bool) {
+	v := m.addaverage_latency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAverageLatency resets all changes to the "average_latency" field.
+func (m *ProofStatMutation) ResetAverageLatency() {
+	m.average_latency = nil
+	m.addaverage_latency = nil
+}
+
+// SetSpend sets the "spend" field.
+func (m *ProofStatMutation) SetSpend(u uint64) {
+	m.spend = &u
+	m.addspend = nil
+}
+
+// Spend returns the value of the "spend" field in the mutation.
+func (m *ProofStatMutation) Spend() (r uint64, exists bool) {
+	v := m.spend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpend returns the old "spend" field's value of the ProofStat entity.
+// If the ProofStat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProofStatMutation) OldSpend(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpend is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpend requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpend: %w", err)
+	}
+	return oldValue.Spend, nil
+}
+
+// AddSpend adds u to the "spend" field.
+func (m *ProofStatMutation) AddSpend(u int64) {
+	if m.addspend != nil {
+		*m.addspend += u
+	} else {
+		m.addspend = &u
+	}
+}
+
+// AddedSpend returns the value that was added to the "spend" field in this mutation.
+func (m *ProofStatMutation) AddedSpend() (r int64, exists bool) {
+	v := m.addspend
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpend resets all changes to the "spend" field.
+func (m *ProofStatMutation) ResetSpend() {
+	m.spend = nil
+	m.addspend = nil
+}
+
+// Where appends a list predicates to the ProofStatMutation builder.
+func (m *ProofStatMutation) Where(ps ...predicate.ProofStat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProofStatMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProofRequestMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProofRequest, len(ps))
+func (m *ProofStatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProofStat, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -752,57 +3334,48 @@ func (m *ProofRequestMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProofRequestMutation) Op() Op {
+func (m *ProofStatMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProofRequestMutation) SetOp(op Op) {
+func (m *ProofStatMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProofRequest).
-func (m *ProofRequestMutation) Type() string {
+// Type returns the node type of this mutation (ProofStat).
+func (m *ProofStatMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProofRequestMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m._type != nil {
-		fields = append(fields, proofrequest.FieldType)
-	}
-	if m.start_block != nil {
-		fields = append(fields, proofrequest.FieldStartBlock)
-	}
-	if m.end_block != nil {
-		fields = append(fields, proofrequest.FieldEndBlock)
-	}
-	if m.status != nil {
-		fields = append(fields, proofrequest.FieldStatus)
+func (m *ProofStatMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.period != nil {
+		fields = append(fields, proofstat.FieldPeriod)
 	}
-	if m.request_added_time != nil {
-		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	if m.period_start != nil {
+		fields = append(fields, proofstat.FieldPeriodStart)
 	}
-	if m.prover_request_id != nil {
-		fields = append(fields, proofrequest.FieldProverRequestID)
+	if m.requested != nil {
+		fields = append(fields, proofstat.FieldRequested)
 	}
-	if m.proof_request_time != nil {
-		fields = append(fields, proofrequest.FieldProofRequestTime)
+	if m.fulfilled != nil {
+		fields = append(fields, proofstat.FieldFulfilled)
 	}
-	if m.last_updated_time != nil {
-		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	if m.failed != nil {
+		fields = append(fields, proofstat.FieldFailed)
 	}
-	if m.l1_block_number != nil {
-		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	if m.blocks_proven != nil {
+		fields = append(fields, proofstat.FieldBlocksProven)
 	}
-	if m.l1_block_hash != nil {
-		fields = append(fields, proofrequest.FieldL1BlockHash)
+	if m.average_latency != nil {
+		fields = append(fields, proofstat.FieldAverageLatency)
 	}
-	if m.proof != nil {
-		fields = append(fields, proofrequest.FieldProof)
+	if m.spend != nil {
+		fields = append(fields, proofstat.FieldSpend)
 	}
 	return fields
 }
@@ -810,30 +3383,24 @@ func (m *ProofRequestMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
+func (m *ProofStatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case proofrequest.FieldType:
-		return m.GetType()
-	case proofrequest.FieldStartBlock:
-		return m.StartBlock()
-	case proofrequest.FieldEndBlock:
-		return m.EndBlock()
-	case proofrequest.FieldStatus:
-		return m.Status()
-	case proofrequest.FieldRequestAddedTime:
-		return m.RequestAddedTime()
-	case proofrequest.FieldProverRequestID:
-		return m.ProverRequestID()
-	case proofrequest.FieldProofRequestTime:
-		return m.ProofRequestTime()
-	case proofrequest.FieldLastUpdatedTime:
-		return m.LastUpdatedTime()
-	case proofrequest.FieldL1BlockNumber:
-		return m.L1BlockNumber()
-	case proofrequest.FieldL1BlockHash:
-		return m.L1BlockHash()
-	case proofrequest.FieldProof:
-		return m.Proof()
+	case proofstat.FieldPeriod:
+		return m.Period()
+	case proofstat.FieldPeriodStart:
+		return m.PeriodStart()
+	case proofstat.FieldRequested:
+		return m.Requested()
+	case proofstat.FieldFulfilled:
+		return m.Fulfilled()
+	case proofstat.FieldFailed:
+		return m.Failed()
+	case proofstat.FieldBlocksProven:
+		return m.BlocksProven()
+	case proofstat.FieldAverageLatency:
+		return m.AverageLatency()
+	case proofstat.FieldSpend:
+		return m.Spend()
 	}
 	return nil, false
 }
@@ -841,141 +3408,117 @@ func (m *ProofRequestMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProofRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProofStatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case proofrequest.FieldType:
-		return m.OldType(ctx)
-	case proofrequest.FieldStartBlock:
-		return m.OldStartBlock(ctx)
-	case proofrequest.FieldEndBlock:
-		return m.OldEndBlock(ctx)
-	case proofrequest.FieldStatus:
-		return m.OldStatus(ctx)
-	case proofrequest.FieldRequestAddedTime:
-		return m.OldRequestAddedTime(ctx)
-	case proofrequest.FieldProverRequestID:
-		return m.OldProverRequestID(ctx)
-	case proofrequest.FieldProofRequestTime:
-		return m.OldProofRequestTime(ctx)
-	case proofrequest.FieldLastUpdatedTime:
-		return m.OldLastUpdatedTime(ctx)
-	case proofrequest.FieldL1BlockNumber:
-		return m.OldL1BlockNumber(ctx)
-	case proofrequest.FieldL1BlockHash:
-		return m.OldL1BlockHash(ctx)
-	case proofrequest.FieldProof:
-		return m.OldProof(ctx)
-	}
-	return nil, fmt.Errorf("unknown ProofRequest field %s", name)
+	case proofstat.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case proofstat.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case proofstat.FieldRequested:
+		return m.OldRequested(ctx)
+	case proofstat.FieldFulfilled:
+		return m.OldFulfilled(ctx)
+	case proofstat.FieldFailed:
+		return m.OldFailed(ctx)
+	case proofstat.FieldBlocksProven:
+		return m.OldBlocksProven(ctx)
+	case proofstat.FieldAverageLatency:
+		return m.OldAverageLatency(ctx)
+	case proofstat.FieldSpend:
+		return m.OldSpend(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProofStat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProofRequestMutation) SetField(name string, value ent.Value) error {
+func (m *ProofStatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case proofrequest.FieldType:
-		v, ok := value.(proofrequest.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case proofrequest.FieldStartBlock:
-		v, ok := value.(uint64)
+	case proofstat.FieldPeriod:
+		v, ok := value.(proofstat.Period)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartBlock(v)
+		m.SetPeriod(v)
 		return nil
-	case proofrequest.FieldEndBlock:
+	case proofstat.FieldPeriodStart:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndBlock(v)
+		m.SetPeriodStart(v)
 		return nil
-	case proofrequest.FieldStatus:
-		v, ok := value.(proofrequest.Status)
+	case proofstat.FieldRequested:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetRequested(v)
 		return nil
-	case proofrequest.FieldRequestAddedTime:
-		v, ok := value.(uint64)
+	case proofstat.FieldFulfilled:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequestAddedTime(v)
+		m.SetFulfilled(v)
 		return nil
-	case proofrequest.FieldProverRequestID:
-		v, ok := value.(string)
+	case proofstat.FieldFailed:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProverRequestID(v)
+		m.SetFailed(v)
 		return nil
-	case proofrequest.FieldProofRequestTime:
+	case proofstat.FieldBlocksProven:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProofRequestTime(v)
+		m.SetBlocksProven(v)
 		return nil
-	case proofrequest.FieldLastUpdatedTime:
-		v, ok := value.(uint64)
+	case proofstat.FieldAverageLatency:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUpdatedTime(v)
+		m.SetAverageLatency(v)
 		return nil
-	case proofrequest.FieldL1BlockNumber:
+	case proofstat.FieldSpend:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetL1BlockNumber(v)
-		return nil
-	case proofrequest.FieldL1BlockHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetL1BlockHash(v)
-		return nil
-	case proofrequest.FieldProof:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProof(v)
+		m.SetSpend(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProofRequest field %s", name)
+	return fmt.Errorf("unknown ProofStat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProofRequestMutation) AddedFields() []string {
+func (m *ProofStatMutation) AddedFields() []string {
 	var fields []string
-	if m.addstart_block != nil {
-		fields = append(fields, proofrequest.FieldStartBlock)
+	if m.addperiod_start != nil {
+		fields = append(fields, proofstat.FieldPeriodStart)
 	}
-	if m.addend_block != nil {
-		fields = append(fields, proofrequest.FieldEndBlock)
+	if m.addrequested != nil {
+		fields = append(fields, proofstat.FieldRequested)
 	}
-	if m.addrequest_added_time != nil {
-		fields = append(fields, proofrequest.FieldRequestAddedTime)
+	if m.addfulfilled != nil {
+		fields = append(fields, proofstat.FieldFulfilled)
 	}
-	if m.addproof_request_time != nil {
-		fields = append(fields, proofrequest.FieldProofRequestTime)
+	if m.addfailed != nil {
+		fields = append(fields, proofstat.FieldFailed)
 	}
-	if m.addlast_updated_time != nil {
-		fields = append(fields, proofrequest.FieldLastUpdatedTime)
+	if m.addblocks_proven != nil {
+		fields = append(fields, proofstat.FieldBlocksProven)
 	}
-	if m.addl1_block_number != nil {
-		fields = append(fields, proofrequest.FieldL1BlockNumber)
+	if m.addaverage_latency != nil {
+		fields = append(fields, proofstat.FieldAverageLatency)
+	}
+	if m.addspend != nil {
+		fields = append(fields, proofstat.FieldSpend)
 	}
 	return fields
 }
@@ -983,20 +3526,22 @@ func (m *ProofRequestMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProofStatMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case proofrequest.FieldStartBlock:
-		return m.AddedStartBlock()
-	case proofrequest.FieldEndBlock:
-		return m.AddedEndBlock()
-	case proofrequest.FieldRequestAddedTime:
-		return m.AddedRequestAddedTime()
-	case proofrequest.FieldProofRequestTime:
-		return m.AddedProofRequestTime()
-	case proofrequest.FieldLastUpdatedTime:
-		return m.AddedLastUpdatedTime()
-	case proofrequest.FieldL1BlockNumber:
-		return m.AddedL1BlockNumber()
+	case proofstat.FieldPeriodStart:
+		return m.AddedPeriodStart()
+	case proofstat.FieldRequested:
+		return m.AddedRequested()
+	case proofstat.FieldFulfilled:
+		return m.AddedFulfilled()
+	case proofstat.FieldFailed:
+		return m.AddedFailed()
+	case proofstat.FieldBlocksProven:
+		return m.AddedBlocksProven()
+	case proofstat.FieldAverageLatency:
+		return m.AddedAverageLatency()
+	case proofstat.FieldSpend:
+		return m.AddedSpend()
 	}
 	return nil, false
 }
@@ -1004,191 +3549,156 @@ func (m *ProofRequestMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProofRequestMutation) AddField(name string, value ent.Value) error {
+func (m *ProofStatMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case proofrequest.FieldStartBlock:
+	case proofstat.FieldPeriodStart:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStartBlock(v)
+		m.AddPeriodStart(v)
 		return nil
-	case proofrequest.FieldEndBlock:
-		v, ok := value.(int64)
+	case proofstat.FieldRequested:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEndBlock(v)
+		m.AddRequested(v)
 		return nil
-	case proofrequest.FieldRequestAddedTime:
-		v, ok := value.(int64)
+	case proofstat.FieldFulfilled:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRequestAddedTime(v)
+		m.AddFulfilled(v)
 		return nil
-	case proofrequest.FieldProofRequestTime:
-		v, ok := value.(int64)
+	case proofstat.FieldFailed:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddProofRequestTime(v)
+		m.AddFailed(v)
 		return nil
-	case proofrequest.FieldLastUpdatedTime:
+	case proofstat.FieldBlocksProven:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLastUpdatedTime(v)
+		m.AddBlocksProven(v)
 		return nil
-	case proofrequest.FieldL1BlockNumber:
+	case proofstat.FieldAverageLatency:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAverageLatency(v)
+		return nil
+	case proofstat.FieldSpend:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddL1BlockNumber(v)
+		m.AddSpend(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProofRequest numeric field %s", name)
+	return fmt.Errorf("unknown ProofStat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProofRequestMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(proofrequest.FieldProverRequestID) {
-		fields = append(fields, proofrequest.FieldProverRequestID)
-	}
-	if m.FieldCleared(proofrequest.FieldProofRequestTime) {
-		fields = append(fields, proofrequest.FieldProofRequestTime)
-	}
-	if m.FieldCleared(proofrequest.FieldL1BlockNumber) {
-		fields = append(fields, proofrequest.FieldL1BlockNumber)
-	}
-	if m.FieldCleared(proofrequest.FieldL1BlockHash) {
-		fields = append(fields, proofrequest.FieldL1BlockHash)
-	}
-	if m.FieldCleared(proofrequest.FieldProof) {
-		fields = append(fields, proofrequest.FieldProof)
-	}
-	return fields
+func (m *ProofStatMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProofRequestMutation) FieldCleared(name string) bool {
+func (m *ProofStatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProofRequestMutation) ClearField(name string) error {
-	switch name {
-	case proofrequest.FieldProverRequestID:
-		m.ClearProverRequestID()
-		return nil
-	case proofrequest.FieldProofRequestTime:
-		m.ClearProofRequestTime()
-		return nil
-	case proofrequest.FieldL1BlockNumber:
-		m.ClearL1BlockNumber()
-		return nil
-	case proofrequest.FieldL1BlockHash:
-		m.ClearL1BlockHash()
-		return nil
-	case proofrequest.FieldProof:
-		m.ClearProof()
-		return nil
-	}
-	return fmt.Errorf("unknown ProofRequest nullable field %s", name)
+func (m *ProofStatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProofStat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProofRequestMutation) ResetField(name string) error {
+func (m *ProofStatMutation) ResetField(name string) error {
 	switch name {
-	case proofrequest.FieldType:
-		m.ResetType()
-		return nil
-	case proofrequest.FieldStartBlock:
-		m.ResetStartBlock()
-		return nil
-	case proofrequest.FieldEndBlock:
-		m.ResetEndBlock()
+	case proofstat.FieldPeriod:
+		m.ResetPeriod()
 		return nil
-	case proofrequest.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case proofrequest.FieldRequestAddedTime:
-		m.ResetRequestAddedTime()
+	case proofstat.FieldPeriodStart:
+		m.ResetPeriodStart()
 		return nil
-	case proofrequest.FieldProverRequestID:
-		m.ResetProverRequestID()
+	case proofstat.FieldRequested:
+		m.ResetRequested()
 		return nil
-	case proofrequest.FieldProofRequestTime:
-		m.ResetProofRequestTime()
+	case proofstat.FieldFulfilled:
+		m.ResetFulfilled()
 		return nil
-	case proofrequest.FieldLastUpdatedTime:
-		m.ResetLastUpdatedTime()
+	case proofstat.FieldFailed:
+		m.ResetFailed()
 		return nil
-	case proofrequest.FieldL1BlockNumber:
-		m.ResetL1BlockNumber()
+	case proofstat.FieldBlocksProven:
+		m.ResetBlocksProven()
 		return nil
-	case proofrequest.FieldL1BlockHash:
-		m.ResetL1BlockHash()
+	case proofstat.FieldAverageLatency:
+		m.ResetAverageLatency()
 		return nil
-	case proofrequest.FieldProof:
-		m.ResetProof()
+	case proofstat.FieldSpend:
+		m.ResetSpend()
 		return nil
 	}
-	return fmt.Errorf("unknown ProofRequest field %s", name)
+	return fmt.Errorf("unknown ProofStat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProofRequestMutation) AddedEdges() []string {
+func (m *ProofStatMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProofRequestMutation) AddedIDs(name string) []ent.Value {
+func (m *ProofStatMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProofRequestMutation) RemovedEdges() []string {
+func (m *ProofStatMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProofRequestMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProofStatMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProofRequestMutation) ClearedEdges() []string {
+func (m *ProofStatMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProofRequestMutation) EdgeCleared(name string) bool {
+func (m *ProofStatMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProofRequestMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProofRequest unique edge %s", name)
+func (m *ProofStatMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProofStat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProofRequestMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProofRequest edge %s", name)
+func (m *ProofStatMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProofStat edge %s", name)
 }
@@ -8,3 +8,6 @@ import (
 
 // ProofRequest is the predicate function for proofrequest builders.
 type ProofRequest func(*sql.Selector)
+
+// ProofStat is the predicate function for proofstat builders.
+type ProofStat func(*sql.Selector)
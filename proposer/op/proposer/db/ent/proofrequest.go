@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -37,7 +38,45 @@ type ProofRequest struct {
 	// L1BlockHash holds the value of the "l1_block_hash" field.
 	L1BlockHash string `json:"l1_block_hash,omitempty"`
 	// Proof holds the value of the "proof" field.
-	Proof        []byte `json:"proof,omitempty"`
+	Proof []byte `json:"proof,omitempty"`
+	// EstimatedCycles holds the value of the "estimated_cycles" field.
+	EstimatedCycles uint64 `json:"estimated_cycles,omitempty"`
+	// EstimatedFee holds the value of the "estimated_fee" field.
+	EstimatedFee uint64 `json:"estimated_fee,omitempty"`
+	// ProofSystem holds the value of the "proof_system" field.
+	ProofSystem string `json:"proof_system,omitempty"`
+	// VkeyHash holds the value of the "vkey_hash" field.
+	VkeyHash string `json:"vkey_hash,omitempty"`
+	// ProofFormat holds the value of the "proof_format" field.
+	ProofFormat string `json:"proof_format,omitempty"`
+	// Backfill holds the value of the "backfill" field.
+	Backfill bool `json:"backfill,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason string `json:"failure_reason,omitempty"`
+	// FulfilledCycles holds the value of the "fulfilled_cycles" field.
+	FulfilledCycles uint64 `json:"fulfilled_cycles,omitempty"`
+	// FulfilledFee holds the value of the "fulfilled_fee" field.
+	FulfilledFee uint64 `json:"fulfilled_fee,omitempty"`
+	// Prover holds the value of the "prover" field.
+	Prover string `json:"prover,omitempty"`
+	// FulfilledTime holds the value of the "fulfilled_time" field.
+	FulfilledTime uint64 `json:"fulfilled_time,omitempty"`
+	// ProgramVersion holds the value of the "program_version" field.
+	ProgramVersion string `json:"program_version,omitempty"`
+	// PublicValues holds the value of the "public_values" field.
+	PublicValues []byte `json:"public_values,omitempty"`
+	// ErrorCode holds the value of the "error_code" field.
+	ErrorCode string `json:"error_code,omitempty"`
+	// FailureStage holds the value of the "failure_stage" field.
+	FailureStage proofrequest.FailureStage `json:"failure_stage,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID int `json:"parent_id,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
+	// ChallengeStatus holds the value of the "challenge_status" field.
+	ChallengeStatus proofrequest.ChallengeStatus `json:"challenge_status,omitempty"`
+	// Final holds the value of the "final" field.
+	Final        bool `json:"final,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -46,11 +85,13 @@ func (*ProofRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case proofrequest.FieldProof:
+		case proofrequest.FieldProof, proofrequest.FieldPublicValues, proofrequest.FieldLabels:
 			values[i] = new([]byte)
-		case proofrequest.FieldID, proofrequest.FieldStartBlock, proofrequest.FieldEndBlock, proofrequest.FieldRequestAddedTime, proofrequest.FieldProofRequestTime, proofrequest.FieldLastUpdatedTime, proofrequest.FieldL1BlockNumber:
+		case proofrequest.FieldBackfill, proofrequest.FieldFinal:
+			values[i] = new(sql.NullBool)
+		case proofrequest.FieldID, proofrequest.FieldStartBlock, proofrequest.FieldEndBlock, proofrequest.FieldRequestAddedTime, proofrequest.FieldProofRequestTime, proofrequest.FieldLastUpdatedTime, proofrequest.FieldL1BlockNumber, proofrequest.FieldEstimatedCycles, proofrequest.FieldEstimatedFee, proofrequest.FieldFulfilledCycles, proofrequest.FieldFulfilledFee, proofrequest.FieldFulfilledTime, proofrequest.FieldParentID:
 			values[i] = new(sql.NullInt64)
-		case proofrequest.FieldType, proofrequest.FieldStatus, proofrequest.FieldProverRequestID, proofrequest.FieldL1BlockHash:
+		case proofrequest.FieldType, proofrequest.FieldStatus, proofrequest.FieldProverRequestID, proofrequest.FieldL1BlockHash, proofrequest.FieldProofSystem, proofrequest.FieldVkeyHash, proofrequest.FieldProofFormat, proofrequest.FieldFailureReason, proofrequest.FieldProver, proofrequest.FieldProgramVersion, proofrequest.FieldErrorCode, proofrequest.FieldFailureStage, proofrequest.FieldChallengeStatus:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -139,6 +180,122 @@ func (pr *ProofRequest) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pr.Proof = *value
 			}
+		case proofrequest.FieldEstimatedCycles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated_cycles", values[i])
+			} else if value.Valid {
+				pr.EstimatedCycles = uint64(value.Int64)
+			}
+		case proofrequest.FieldEstimatedFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated_fee", values[i])
+			} else if value.Valid {
+				pr.EstimatedFee = uint64(value.Int64)
+			}
+		case proofrequest.FieldProofSystem:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proof_system", values[i])
+			} else if value.Valid {
+				pr.ProofSystem = value.String
+			}
+		case proofrequest.FieldVkeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vkey_hash", values[i])
+			} else if value.Valid {
+				pr.VkeyHash = value.String
+			}
+		case proofrequest.FieldProofFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proof_format", values[i])
+			} else if value.Valid {
+				pr.ProofFormat = value.String
+			}
+		case proofrequest.FieldBackfill:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field backfill", values[i])
+			} else if value.Valid {
+				pr.Backfill = value.Bool
+			}
+		case proofrequest.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				pr.FailureReason = value.String
+			}
+		case proofrequest.FieldFulfilledCycles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled_cycles", values[i])
+			} else if value.Valid {
+				pr.FulfilledCycles = uint64(value.Int64)
+			}
+		case proofrequest.FieldFulfilledFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled_fee", values[i])
+			} else if value.Valid {
+				pr.FulfilledFee = uint64(value.Int64)
+			}
+		case proofrequest.FieldProver:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prover", values[i])
+			} else if value.Valid {
+				pr.Prover = value.String
+			}
+		case proofrequest.FieldFulfilledTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled_time", values[i])
+			} else if value.Valid {
+				pr.FulfilledTime = uint64(value.Int64)
+			}
+		case proofrequest.FieldProgramVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field program_version", values[i])
+			} else if value.Valid {
+				pr.ProgramVersion = value.String
+			}
+		case proofrequest.FieldPublicValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_values", values[i])
+			} else if value != nil {
+				pr.PublicValues = *value
+			}
+		case proofrequest.FieldErrorCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_code", values[i])
+			} else if value.Valid {
+				pr.ErrorCode = value.String
+			}
+		case proofrequest.FieldFailureStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_stage", values[i])
+			} else if value.Valid {
+				pr.FailureStage = proofrequest.FailureStage(value.String)
+			}
+		case proofrequest.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				pr.ParentID = int(value.Int64)
+			}
+		case proofrequest.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case proofrequest.FieldChallengeStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge_status", values[i])
+			} else if value.Valid {
+				pr.ChallengeStatus = proofrequest.ChallengeStatus(value.String)
+			}
+		case proofrequest.FieldFinal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field final", values[i])
+			} else if value.Valid {
+				pr.Final = value.Bool
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -207,6 +364,63 @@ func (pr *ProofRequest) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("proof=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Proof))
+	builder.WriteString(", ")
+	builder.WriteString("estimated_cycles=")
+	builder.WriteString(fmt.Sprintf("%v", pr.EstimatedCycles))
+	builder.WriteString(", ")
+	builder.WriteString("estimated_fee=")
+	builder.WriteString(fmt.Sprintf("%v", pr.EstimatedFee))
+	builder.WriteString(", ")
+	builder.WriteString("proof_system=")
+	builder.WriteString(pr.ProofSystem)
+	builder.WriteString(", ")
+	builder.WriteString("vkey_hash=")
+	builder.WriteString(pr.VkeyHash)
+	builder.WriteString(", ")
+	builder.WriteString("proof_format=")
+	builder.WriteString(pr.ProofFormat)
+	builder.WriteString(", ")
+	builder.WriteString("backfill=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Backfill))
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(pr.FailureReason)
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled_cycles=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FulfilledCycles))
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled_fee=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FulfilledFee))
+	builder.WriteString(", ")
+	builder.WriteString("prover=")
+	builder.WriteString(pr.Prover)
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled_time=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FulfilledTime))
+	builder.WriteString(", ")
+	builder.WriteString("program_version=")
+	builder.WriteString(pr.ProgramVersion)
+	builder.WriteString(", ")
+	builder.WriteString("public_values=")
+	builder.WriteString(fmt.Sprintf("%v", pr.PublicValues))
+	builder.WriteString(", ")
+	builder.WriteString("error_code=")
+	builder.WriteString(pr.ErrorCode)
+	builder.WriteString(", ")
+	builder.WriteString("failure_stage=")
+	builder.WriteString(fmt.Sprintf("%v", pr.FailureStage))
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("challenge_status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ChallengeStatus))
+	builder.WriteString(", ")
+	builder.WriteString("final=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Final))
 	builder.WriteByte(')')
 	return builder.String()
 }
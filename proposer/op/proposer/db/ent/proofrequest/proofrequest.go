@@ -35,6 +35,44 @@ const (
 	FieldL1BlockHash = "l1_block_hash"
 	// FieldProof holds the string denoting the proof field in the database.
 	FieldProof = "proof"
+	// FieldEstimatedCycles holds the string denoting the estimated_cycles field in the database.
+	FieldEstimatedCycles = "estimated_cycles"
+	// FieldEstimatedFee holds the string denoting the estimated_fee field in the database.
+	FieldEstimatedFee = "estimated_fee"
+	// FieldProofSystem holds the string denoting the proof_system field in the database.
+	FieldProofSystem = "proof_system"
+	// FieldVkeyHash holds the string denoting the vkey_hash field in the database.
+	FieldVkeyHash = "vkey_hash"
+	// FieldProofFormat holds the string denoting the proof_format field in the database.
+	FieldProofFormat = "proof_format"
+	// FieldBackfill holds the string denoting the backfill field in the database.
+	FieldBackfill = "backfill"
+	// FieldFailureReason holds the string denoting the failure_reason field in the database.
+	FieldFailureReason = "failure_reason"
+	// FieldFulfilledCycles holds the string denoting the fulfilled_cycles field in the database.
+	FieldFulfilledCycles = "fulfilled_cycles"
+	// FieldFulfilledFee holds the string denoting the fulfilled_fee field in the database.
+	FieldFulfilledFee = "fulfilled_fee"
+	// FieldProver holds the string denoting the prover field in the database.
+	FieldProver = "prover"
+	// FieldFulfilledTime holds the string denoting the fulfilled_time field in the database.
+	FieldFulfilledTime = "fulfilled_time"
+	// FieldProgramVersion holds the string denoting the program_version field in the database.
+	FieldProgramVersion = "program_version"
+	// FieldPublicValues holds the string denoting the public_values field in the database.
+	FieldPublicValues = "public_values"
+	// FieldErrorCode holds the string denoting the error_code field in the database.
+	FieldErrorCode = "error_code"
+	// FieldFailureStage holds the string denoting the failure_stage field in the database.
+	FieldFailureStage = "failure_stage"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldChallengeStatus holds the string denoting the challenge_status field in the database.
+	FieldChallengeStatus = "challenge_status"
+	// FieldFinal holds the string denoting the final field in the database.
+	FieldFinal = "final"
 	// Table holds the table name of the proofrequest in the database.
 	Table = "proof_requests"
 )
@@ -53,6 +91,25 @@ var Columns = []string{
 	FieldL1BlockNumber,
 	FieldL1BlockHash,
 	FieldProof,
+	FieldEstimatedCycles,
+	FieldEstimatedFee,
+	FieldProofSystem,
+	FieldVkeyHash,
+	FieldProofFormat,
+	FieldBackfill,
+	FieldFailureReason,
+	FieldFulfilledCycles,
+	FieldFulfilledFee,
+	FieldProver,
+	FieldFulfilledTime,
+	FieldProgramVersion,
+	FieldPublicValues,
+	FieldErrorCode,
+	FieldFailureStage,
+	FieldParentID,
+	FieldLabels,
+	FieldChallengeStatus,
+	FieldFinal,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -65,6 +122,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultBackfill holds the default value on creation for the "backfill" field.
+	DefaultBackfill bool
+	// DefaultFinal holds the default value on creation for the "final" field.
+	DefaultFinal bool
+)
+
 // Type defines the type for the "type" enum field.
 type Type string
 
@@ -114,6 +178,54 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// FailureStage defines the type for the "failure_stage" enum field.
+type FailureStage string
+
+// FailureStage values.
+const (
+	FailureStageREQUEST FailureStage = "REQUEST"
+	FailureStagePROVE   FailureStage = "PROVE"
+	FailureStageVERIFY  FailureStage = "VERIFY"
+)
+
+func (fs FailureStage) String() string {
+	return string(fs)
+}
+
+// FailureStageValidator is a validator for the "failure_stage" field enum values. It is called by the builders before save.
+func FailureStageValidator(fs FailureStage) error {
+	switch fs {
+	case FailureStageREQUEST, FailureStagePROVE, FailureStageVERIFY:
+		return nil
+	default:
+		return fmt.Errorf("proofrequest: invalid enum value for failure_stage field: %q", fs)
+	}
+}
+
+// ChallengeStatus defines the type for the "challenge_status" enum field.
+type ChallengeStatus string
+
+// ChallengeStatus values.
+const (
+	ChallengeStatusCHALLENGED      ChallengeStatus = "CHALLENGED"
+	ChallengeStatusCHALLENGER_WINS ChallengeStatus = "CHALLENGER_WINS"
+	ChallengeStatusDEFENDER_WINS   ChallengeStatus = "DEFENDER_WINS"
+)
+
+func (cs ChallengeStatus) String() string {
+	return string(cs)
+}
+
+// ChallengeStatusValidator is a validator for the "challenge_status" field enum values. It is called by the builders before save.
+func ChallengeStatusValidator(cs ChallengeStatus) error {
+	switch cs {
+	case ChallengeStatusCHALLENGED, ChallengeStatusCHALLENGER_WINS, ChallengeStatusDEFENDER_WINS:
+		return nil
+	default:
+		return fmt.Errorf("proofrequest: invalid enum value for challenge_status field: %q", cs)
+	}
+}
+
 // OrderOption defines the ordering options for the ProofRequest queries.
 type OrderOption func(*sql.Selector)
 
@@ -171,3 +283,88 @@ func ByL1BlockNumber(opts ...sql.OrderTermOption) OrderOption {
 func ByL1BlockHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldL1BlockHash, opts...).ToFunc()
 }
+
+// ByEstimatedCycles orders the results by the estimated_cycles field.
+func ByEstimatedCycles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstimatedCycles, opts...).ToFunc()
+}
+
+// ByEstimatedFee orders the results by the estimated_fee field.
+func ByEstimatedFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstimatedFee, opts...).ToFunc()
+}
+
+// ByProofSystem orders the results by the proof_system field.
+func ByProofSystem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProofSystem, opts...).ToFunc()
+}
+
+// ByVkeyHash orders the results by the vkey_hash field.
+func ByVkeyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVkeyHash, opts...).ToFunc()
+}
+
+// ByProofFormat orders the results by the proof_format field.
+func ByProofFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProofFormat, opts...).ToFunc()
+}
+
+// ByBackfill orders the results by the backfill field.
+func ByBackfill(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackfill, opts...).ToFunc()
+}
+
+// ByFailureReason orders the results by the failure_reason field.
+func ByFailureReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureReason, opts...).ToFunc()
+}
+
+// ByFulfilledCycles orders the results by the fulfilled_cycles field.
+func ByFulfilledCycles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilledCycles, opts...).ToFunc()
+}
+
+// ByFulfilledFee orders the results by the fulfilled_fee field.
+func ByFulfilledFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilledFee, opts...).ToFunc()
+}
+
+// ByProver orders the results by the prover field.
+func ByProver(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProver, opts...).ToFunc()
+}
+
+// ByFulfilledTime orders the results by the fulfilled_time field.
+func ByFulfilledTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilledTime, opts...).ToFunc()
+}
+
+// ByProgramVersion orders the results by the program_version field.
+func ByProgramVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgramVersion, opts...).ToFunc()
+}
+
+// ByErrorCode orders the results by the error_code field.
+func ByErrorCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorCode, opts...).ToFunc()
+}
+
+// ByFailureStage orders the results by the failure_stage field.
+func ByFailureStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureStage, opts...).ToFunc()
+}
+
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByChallengeStatus orders the results by the challenge_status field.
+func ByChallengeStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChallengeStatus, opts...).ToFunc()
+}
+
+// ByFinal orders the results by the final field.
+func ByFinal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinal, opts...).ToFunc()
+}
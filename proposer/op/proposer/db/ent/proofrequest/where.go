@@ -97,6 +97,86 @@ func Proof(v []byte) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldProof, v))
 }
 
+// EstimatedCycles applies equality check predicate on the "estimated_cycles" field. It's identical to EstimatedCyclesEQ.
+func EstimatedCycles(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldEstimatedCycles, v))
+}
+
+// EstimatedFee applies equality check predicate on the "estimated_fee" field. It's identical to EstimatedFeeEQ.
+func EstimatedFee(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldEstimatedFee, v))
+}
+
+// ProofSystem applies equality check predicate on the "proof_system" field. It's identical to ProofSystemEQ.
+func ProofSystem(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofSystem, v))
+}
+
+// VkeyHash applies equality check predicate on the "vkey_hash" field. It's identical to VkeyHashEQ.
+func VkeyHash(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldVkeyHash, v))
+}
+
+// ProofFormat applies equality check predicate on the "proof_format" field. It's identical to ProofFormatEQ.
+func ProofFormat(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofFormat, v))
+}
+
+// Backfill applies equality check predicate on the "backfill" field. It's identical to BackfillEQ.
+func Backfill(v bool) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldBackfill, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FulfilledCycles applies equality check predicate on the "fulfilled_cycles" field. It's identical to FulfilledCyclesEQ.
+func FulfilledCycles(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledCycles, v))
+}
+
+// FulfilledFee applies equality check predicate on the "fulfilled_fee" field. It's identical to FulfilledFeeEQ.
+func FulfilledFee(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledFee, v))
+}
+
+// Prover applies equality check predicate on the "prover" field. It's identical to ProverEQ.
+func Prover(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProver, v))
+}
+
+// FulfilledTime applies equality check predicate on the "fulfilled_time" field. It's identical to FulfilledTimeEQ.
+func FulfilledTime(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledTime, v))
+}
+
+// ProgramVersion applies equality check predicate on the "program_version" field. It's identical to ProgramVersionEQ.
+func ProgramVersion(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProgramVersion, v))
+}
+
+// PublicValues applies equality check predicate on the "public_values" field. It's identical to PublicValuesEQ.
+func PublicValues(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldPublicValues, v))
+}
+
+// ErrorCode applies equality check predicate on the "error_code" field. It's identical to ErrorCodeEQ.
+func ErrorCode(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldErrorCode, v))
+}
+
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldParentID, v))
+}
+
+// Final applies equality check predicate on the "final" field. It's identical to FinalEQ.
+func Final(v bool) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFinal, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldEQ(FieldType, v))
@@ -597,6 +677,971 @@ func ProofNotNil() predicate.ProofRequest {
 	return predicate.ProofRequest(sql.FieldNotNull(FieldProof))
 }
 
+// EstimatedCyclesEQ applies the EQ predicate on the "estimated_cycles" field.
+func EstimatedCyclesEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldEstimatedCycles, v))
+}
+
+// EstimatedCyclesNEQ applies the NEQ predicate on the "estimated_cycles" field.
+func EstimatedCyclesNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldEstimatedCycles, v))
+}
+
+// EstimatedCyclesIn applies the In predicate on the "estimated_cycles" field.
+func EstimatedCyclesIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldEstimatedCycles, vs...))
+}
+
+// EstimatedCyclesNotIn applies the NotIn predicate on the "estimated_cycles" field.
+func EstimatedCyclesNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldEstimatedCycles, vs...))
+}
+
+// EstimatedCyclesGT applies the GT predicate on the "estimated_cycles" field.
+func EstimatedCyclesGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldEstimatedCycles, v))
+}
+
+// EstimatedCyclesGTE applies the GTE predicate on the "estimated_cycles" field.
+func EstimatedCyclesGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldEstimatedCycles, v))
+}
+
+// EstimatedCyclesLT applies the LT predicate on the "estimated_cycles" field.
+func EstimatedCyclesLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldEstimatedCycles, v))
+}
+
+// EstimatedCyclesLTE applies the LTE predicate on the "estimated_cycles" field.
+func EstimatedCyclesLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldEstimatedCycles, v))
+}
+
+// EstimatedCyclesIsNil applies the IsNil predicate on the "estimated_cycles" field.
+func EstimatedCyclesIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldEstimatedCycles))
+}
+
+// EstimatedCyclesNotNil applies the NotNil predicate on the "estimated_cycles" field.
+func EstimatedCyclesNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldEstimatedCycles))
+}
+
+// EstimatedFeeEQ applies the EQ predicate on the "estimated_fee" field.
+func EstimatedFeeEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldEstimatedFee, v))
+}
+
+// EstimatedFeeNEQ applies the NEQ predicate on the "estimated_fee" field.
+func EstimatedFeeNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldEstimatedFee, v))
+}
+
+// EstimatedFeeIn applies the In predicate on the "estimated_fee" field.
+func EstimatedFeeIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldEstimatedFee, vs...))
+}
+
+// EstimatedFeeNotIn applies the NotIn predicate on the "estimated_fee" field.
+func EstimatedFeeNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldEstimatedFee, vs...))
+}
+
+// EstimatedFeeGT applies the GT predicate on the "estimated_fee" field.
+func EstimatedFeeGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldEstimatedFee, v))
+}
+
+// EstimatedFeeGTE applies the GTE predicate on the "estimated_fee" field.
+func EstimatedFeeGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldEstimatedFee, v))
+}
+
+// EstimatedFeeLT applies the LT predicate on the "estimated_fee" field.
+func EstimatedFeeLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldEstimatedFee, v))
+}
+
+// EstimatedFeeLTE applies the LTE predicate on the "estimated_fee" field.
+func EstimatedFeeLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldEstimatedFee, v))
+}
+
+// EstimatedFeeIsNil applies the IsNil predicate on the "estimated_fee" field.
+func EstimatedFeeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldEstimatedFee))
+}
+
+// EstimatedFeeNotNil applies the NotNil predicate on the "estimated_fee" field.
+func EstimatedFeeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldEstimatedFee))
+}
+
+// ProofSystemEQ applies the EQ predicate on the "proof_system" field.
+func ProofSystemEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofSystem, v))
+}
+
+// ProofSystemNEQ applies the NEQ predicate on the "proof_system" field.
+func ProofSystemNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProofSystem, v))
+}
+
+// ProofSystemIn applies the In predicate on the "proof_system" field.
+func ProofSystemIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProofSystem, vs...))
+}
+
+// ProofSystemNotIn applies the NotIn predicate on the "proof_system" field.
+func ProofSystemNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProofSystem, vs...))
+}
+
+// ProofSystemGT applies the GT predicate on the "proof_system" field.
+func ProofSystemGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProofSystem, v))
+}
+
+// ProofSystemGTE applies the GTE predicate on the "proof_system" field.
+func ProofSystemGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProofSystem, v))
+}
+
+// ProofSystemLT applies the LT predicate on the "proof_system" field.
+func ProofSystemLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProofSystem, v))
+}
+
+// ProofSystemLTE applies the LTE predicate on the "proof_system" field.
+func ProofSystemLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProofSystem, v))
+}
+
+// ProofSystemContains applies the Contains predicate on the "proof_system" field.
+func ProofSystemContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldProofSystem, v))
+}
+
+// ProofSystemHasPrefix applies the HasPrefix predicate on the "proof_system" field.
+func ProofSystemHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldProofSystem, v))
+}
+
+// ProofSystemHasSuffix applies the HasSuffix predicate on the "proof_system" field.
+func ProofSystemHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldProofSystem, v))
+}
+
+// ProofSystemIsNil applies the IsNil predicate on the "proof_system" field.
+func ProofSystemIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProofSystem))
+}
+
+// ProofSystemNotNil applies the NotNil predicate on the "proof_system" field.
+func ProofSystemNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProofSystem))
+}
+
+// ProofSystemEqualFold applies the EqualFold predicate on the "proof_system" field.
+func ProofSystemEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldProofSystem, v))
+}
+
+// ProofSystemContainsFold applies the ContainsFold predicate on the "proof_system" field.
+func ProofSystemContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldProofSystem, v))
+}
+
+// VkeyHashEQ applies the EQ predicate on the "vkey_hash" field.
+func VkeyHashEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldVkeyHash, v))
+}
+
+// VkeyHashNEQ applies the NEQ predicate on the "vkey_hash" field.
+func VkeyHashNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldVkeyHash, v))
+}
+
+// VkeyHashIn applies the In predicate on the "vkey_hash" field.
+func VkeyHashIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldVkeyHash, vs...))
+}
+
+// VkeyHashNotIn applies the NotIn predicate on the "vkey_hash" field.
+func VkeyHashNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldVkeyHash, vs...))
+}
+
+// VkeyHashGT applies the GT predicate on the "vkey_hash" field.
+func VkeyHashGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldVkeyHash, v))
+}
+
+// VkeyHashGTE applies the GTE predicate on the "vkey_hash" field.
+func VkeyHashGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldVkeyHash, v))
+}
+
+// VkeyHashLT applies the LT predicate on the "vkey_hash" field.
+func VkeyHashLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldVkeyHash, v))
+}
+
+// VkeyHashLTE applies the LTE predicate on the "vkey_hash" field.
+func VkeyHashLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldVkeyHash, v))
+}
+
+// VkeyHashContains applies the Contains predicate on the "vkey_hash" field.
+func VkeyHashContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldVkeyHash, v))
+}
+
+// VkeyHashHasPrefix applies the HasPrefix predicate on the "vkey_hash" field.
+func VkeyHashHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldVkeyHash, v))
+}
+
+// VkeyHashHasSuffix applies the HasSuffix predicate on the "vkey_hash" field.
+func VkeyHashHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldVkeyHash, v))
+}
+
+// VkeyHashIsNil applies the IsNil predicate on the "vkey_hash" field.
+func VkeyHashIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldVkeyHash))
+}
+
+// VkeyHashNotNil applies the NotNil predicate on the "vkey_hash" field.
+func VkeyHashNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldVkeyHash))
+}
+
+// VkeyHashEqualFold applies the EqualFold predicate on the "vkey_hash" field.
+func VkeyHashEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldVkeyHash, v))
+}
+
+// VkeyHashContainsFold applies the ContainsFold predicate on the "vkey_hash" field.
+func VkeyHashContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldVkeyHash, v))
+}
+
+// ProofFormatEQ applies the EQ predicate on the "proof_format" field.
+func ProofFormatEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProofFormat, v))
+}
+
+// ProofFormatNEQ applies the NEQ predicate on the "proof_format" field.
+func ProofFormatNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProofFormat, v))
+}
+
+// ProofFormatIn applies the In predicate on the "proof_format" field.
+func ProofFormatIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProofFormat, vs...))
+}
+
+// ProofFormatNotIn applies the NotIn predicate on the "proof_format" field.
+func ProofFormatNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProofFormat, vs...))
+}
+
+// ProofFormatGT applies the GT predicate on the "proof_format" field.
+func ProofFormatGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProofFormat, v))
+}
+
+// ProofFormatGTE applies the GTE predicate on the "proof_format" field.
+func ProofFormatGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProofFormat, v))
+}
+
+// ProofFormatLT applies the LT predicate on the "proof_format" field.
+func ProofFormatLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProofFormat, v))
+}
+
+// ProofFormatLTE applies the LTE predicate on the "proof_format" field.
+func ProofFormatLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProofFormat, v))
+}
+
+// ProofFormatContains applies the Contains predicate on the "proof_format" field.
+func ProofFormatContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldProofFormat, v))
+}
+
+// ProofFormatHasPrefix applies the HasPrefix predicate on the "proof_format" field.
+func ProofFormatHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldProofFormat, v))
+}
+
+// ProofFormatHasSuffix applies the HasSuffix predicate on the "proof_format" field.
+func ProofFormatHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldProofFormat, v))
+}
+
+// ProofFormatIsNil applies the IsNil predicate on the "proof_format" field.
+func ProofFormatIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProofFormat))
+}
+
+// ProofFormatNotNil applies the NotNil predicate on the "proof_format" field.
+func ProofFormatNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProofFormat))
+}
+
+// ProofFormatEqualFold applies the EqualFold predicate on the "proof_format" field.
+func ProofFormatEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldProofFormat, v))
+}
+
+// ProofFormatContainsFold applies the ContainsFold predicate on the "proof_format" field.
+func ProofFormatContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldProofFormat, v))
+}
+
+// BackfillEQ applies the EQ predicate on the "backfill" field.
+func BackfillEQ(v bool) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldBackfill, v))
+}
+
+// BackfillNEQ applies the NEQ predicate on the "backfill" field.
+func BackfillNEQ(v bool) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldBackfill, v))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// FulfilledCyclesEQ applies the EQ predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledCycles, v))
+}
+
+// FulfilledCyclesNEQ applies the NEQ predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFulfilledCycles, v))
+}
+
+// FulfilledCyclesIn applies the In predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFulfilledCycles, vs...))
+}
+
+// FulfilledCyclesNotIn applies the NotIn predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFulfilledCycles, vs...))
+}
+
+// FulfilledCyclesGT applies the GT predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFulfilledCycles, v))
+}
+
+// FulfilledCyclesGTE applies the GTE predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFulfilledCycles, v))
+}
+
+// FulfilledCyclesLT applies the LT predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFulfilledCycles, v))
+}
+
+// FulfilledCyclesLTE applies the LTE predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFulfilledCycles, v))
+}
+
+// FulfilledCyclesIsNil applies the IsNil predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFulfilledCycles))
+}
+
+// FulfilledCyclesNotNil applies the NotNil predicate on the "fulfilled_cycles" field.
+func FulfilledCyclesNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFulfilledCycles))
+}
+
+// FulfilledFeeEQ applies the EQ predicate on the "fulfilled_fee" field.
+func FulfilledFeeEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledFee, v))
+}
+
+// FulfilledFeeNEQ applies the NEQ predicate on the "fulfilled_fee" field.
+func FulfilledFeeNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFulfilledFee, v))
+}
+
+// FulfilledFeeIn applies the In predicate on the "fulfilled_fee" field.
+func FulfilledFeeIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFulfilledFee, vs...))
+}
+
+// FulfilledFeeNotIn applies the NotIn predicate on the "fulfilled_fee" field.
+func FulfilledFeeNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFulfilledFee, vs...))
+}
+
+// FulfilledFeeGT applies the GT predicate on the "fulfilled_fee" field.
+func FulfilledFeeGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFulfilledFee, v))
+}
+
+// FulfilledFeeGTE applies the GTE predicate on the "fulfilled_fee" field.
+func FulfilledFeeGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFulfilledFee, v))
+}
+
+// FulfilledFeeLT applies the LT predicate on the "fulfilled_fee" field.
+func FulfilledFeeLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFulfilledFee, v))
+}
+
+// FulfilledFeeLTE applies the LTE predicate on the "fulfilled_fee" field.
+func FulfilledFeeLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFulfilledFee, v))
+}
+
+// FulfilledFeeIsNil applies the IsNil predicate on the "fulfilled_fee" field.
+func FulfilledFeeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFulfilledFee))
+}
+
+// FulfilledFeeNotNil applies the NotNil predicate on the "fulfilled_fee" field.
+func FulfilledFeeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFulfilledFee))
+}
+
+// ProverEQ applies the EQ predicate on the "prover" field.
+func ProverEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProver, v))
+}
+
+// ProverNEQ applies the NEQ predicate on the "prover" field.
+func ProverNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProver, v))
+}
+
+// ProverIn applies the In predicate on the "prover" field.
+func ProverIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProver, vs...))
+}
+
+// ProverNotIn applies the NotIn predicate on the "prover" field.
+func ProverNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProver, vs...))
+}
+
+// ProverGT applies the GT predicate on the "prover" field.
+func ProverGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProver, v))
+}
+
+// ProverGTE applies the GTE predicate on the "prover" field.
+func ProverGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProver, v))
+}
+
+// ProverLT applies the LT predicate on the "prover" field.
+func ProverLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProver, v))
+}
+
+// ProverLTE applies the LTE predicate on the "prover" field.
+func ProverLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProver, v))
+}
+
+// ProverContains applies the Contains predicate on the "prover" field.
+func ProverContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldProver, v))
+}
+
+// ProverHasPrefix applies the HasPrefix predicate on the "prover" field.
+func ProverHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldProver, v))
+}
+
+// ProverHasSuffix applies the HasSuffix predicate on the "prover" field.
+func ProverHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldProver, v))
+}
+
+// ProverIsNil applies the IsNil predicate on the "prover" field.
+func ProverIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProver))
+}
+
+// ProverNotNil applies the NotNil predicate on the "prover" field.
+func ProverNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProver))
+}
+
+// ProverEqualFold applies the EqualFold predicate on the "prover" field.
+func ProverEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldProver, v))
+}
+
+// ProverContainsFold applies the ContainsFold predicate on the "prover" field.
+func ProverContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldProver, v))
+}
+
+// FulfilledTimeEQ applies the EQ predicate on the "fulfilled_time" field.
+func FulfilledTimeEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeNEQ applies the NEQ predicate on the "fulfilled_time" field.
+func FulfilledTimeNEQ(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeIn applies the In predicate on the "fulfilled_time" field.
+func FulfilledTimeIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFulfilledTime, vs...))
+}
+
+// FulfilledTimeNotIn applies the NotIn predicate on the "fulfilled_time" field.
+func FulfilledTimeNotIn(vs ...uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFulfilledTime, vs...))
+}
+
+// FulfilledTimeGT applies the GT predicate on the "fulfilled_time" field.
+func FulfilledTimeGT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeGTE applies the GTE predicate on the "fulfilled_time" field.
+func FulfilledTimeGTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeLT applies the LT predicate on the "fulfilled_time" field.
+func FulfilledTimeLT(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeLTE applies the LTE predicate on the "fulfilled_time" field.
+func FulfilledTimeLTE(v uint64) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldFulfilledTime, v))
+}
+
+// FulfilledTimeIsNil applies the IsNil predicate on the "fulfilled_time" field.
+func FulfilledTimeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFulfilledTime))
+}
+
+// FulfilledTimeNotNil applies the NotNil predicate on the "fulfilled_time" field.
+func FulfilledTimeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFulfilledTime))
+}
+
+// ProgramVersionEQ applies the EQ predicate on the "program_version" field.
+func ProgramVersionEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldProgramVersion, v))
+}
+
+// ProgramVersionNEQ applies the NEQ predicate on the "program_version" field.
+func ProgramVersionNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldProgramVersion, v))
+}
+
+// ProgramVersionIn applies the In predicate on the "program_version" field.
+func ProgramVersionIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldProgramVersion, vs...))
+}
+
+// ProgramVersionNotIn applies the NotIn predicate on the "program_version" field.
+func ProgramVersionNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldProgramVersion, vs...))
+}
+
+// ProgramVersionGT applies the GT predicate on the "program_version" field.
+func ProgramVersionGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldProgramVersion, v))
+}
+
+// ProgramVersionGTE applies the GTE predicate on the "program_version" field.
+func ProgramVersionGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldProgramVersion, v))
+}
+
+// ProgramVersionLT applies the LT predicate on the "program_version" field.
+func ProgramVersionLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldProgramVersion, v))
+}
+
+// ProgramVersionLTE applies the LTE predicate on the "program_version" field.
+func ProgramVersionLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldProgramVersion, v))
+}
+
+// ProgramVersionContains applies the Contains predicate on the "program_version" field.
+func ProgramVersionContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldProgramVersion, v))
+}
+
+// ProgramVersionHasPrefix applies the HasPrefix predicate on the "program_version" field.
+func ProgramVersionHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldProgramVersion, v))
+}
+
+// ProgramVersionHasSuffix applies the HasSuffix predicate on the "program_version" field.
+func ProgramVersionHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldProgramVersion, v))
+}
+
+// ProgramVersionIsNil applies the IsNil predicate on the "program_version" field.
+func ProgramVersionIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldProgramVersion))
+}
+
+// ProgramVersionNotNil applies the NotNil predicate on the "program_version" field.
+func ProgramVersionNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldProgramVersion))
+}
+
+// ProgramVersionEqualFold applies the EqualFold predicate on the "program_version" field.
+func ProgramVersionEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldProgramVersion, v))
+}
+
+// ProgramVersionContainsFold applies the ContainsFold predicate on the "program_version" field.
+func ProgramVersionContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldProgramVersion, v))
+}
+
+// PublicValuesEQ applies the EQ predicate on the "public_values" field.
+func PublicValuesEQ(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldPublicValues, v))
+}
+
+// PublicValuesNEQ applies the NEQ predicate on the "public_values" field.
+func PublicValuesNEQ(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldPublicValues, v))
+}
+
+// PublicValuesIn applies the In predicate on the "public_values" field.
+func PublicValuesIn(vs ...[]byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldPublicValues, vs...))
+}
+
+// PublicValuesNotIn applies the NotIn predicate on the "public_values" field.
+func PublicValuesNotIn(vs ...[]byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldPublicValues, vs...))
+}
+
+// PublicValuesGT applies the GT predicate on the "public_values" field.
+func PublicValuesGT(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldPublicValues, v))
+}
+
+// PublicValuesGTE applies the GTE predicate on the "public_values" field.
+func PublicValuesGTE(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldPublicValues, v))
+}
+
+// PublicValuesLT applies the LT predicate on the "public_values" field.
+func PublicValuesLT(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldPublicValues, v))
+}
+
+// PublicValuesLTE applies the LTE predicate on the "public_values" field.
+func PublicValuesLTE(v []byte) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldPublicValues, v))
+}
+
+// PublicValuesIsNil applies the IsNil predicate on the "public_values" field.
+func PublicValuesIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldPublicValues))
+}
+
+// PublicValuesNotNil applies the NotNil predicate on the "public_values" field.
+func PublicValuesNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldPublicValues))
+}
+
+// ErrorCodeEQ applies the EQ predicate on the "error_code" field.
+func ErrorCodeEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldErrorCode, v))
+}
+
+// ErrorCodeNEQ applies the NEQ predicate on the "error_code" field.
+func ErrorCodeNEQ(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldErrorCode, v))
+}
+
+// ErrorCodeIn applies the In predicate on the "error_code" field.
+func ErrorCodeIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldErrorCode, vs...))
+}
+
+// ErrorCodeNotIn applies the NotIn predicate on the "error_code" field.
+func ErrorCodeNotIn(vs ...string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldErrorCode, vs...))
+}
+
+// ErrorCodeGT applies the GT predicate on the "error_code" field.
+func ErrorCodeGT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldErrorCode, v))
+}
+
+// ErrorCodeGTE applies the GTE predicate on the "error_code" field.
+func ErrorCodeGTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldErrorCode, v))
+}
+
+// ErrorCodeLT applies the LT predicate on the "error_code" field.
+func ErrorCodeLT(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldErrorCode, v))
+}
+
+// ErrorCodeLTE applies the LTE predicate on the "error_code" field.
+func ErrorCodeLTE(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldErrorCode, v))
+}
+
+// ErrorCodeContains applies the Contains predicate on the "error_code" field.
+func ErrorCodeContains(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContains(FieldErrorCode, v))
+}
+
+// ErrorCodeHasPrefix applies the HasPrefix predicate on the "error_code" field.
+func ErrorCodeHasPrefix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasPrefix(FieldErrorCode, v))
+}
+
+// ErrorCodeHasSuffix applies the HasSuffix predicate on the "error_code" field.
+func ErrorCodeHasSuffix(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldHasSuffix(FieldErrorCode, v))
+}
+
+// ErrorCodeIsNil applies the IsNil predicate on the "error_code" field.
+func ErrorCodeIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldErrorCode))
+}
+
+// ErrorCodeNotNil applies the NotNil predicate on the "error_code" field.
+func ErrorCodeNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldErrorCode))
+}
+
+// ErrorCodeEqualFold applies the EqualFold predicate on the "error_code" field.
+func ErrorCodeEqualFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEqualFold(FieldErrorCode, v))
+}
+
+// ErrorCodeContainsFold applies the ContainsFold predicate on the "error_code" field.
+func ErrorCodeContainsFold(v string) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldContainsFold(FieldErrorCode, v))
+}
+
+// FailureStageEQ applies the EQ predicate on the "failure_stage" field.
+func FailureStageEQ(v FailureStage) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFailureStage, v))
+}
+
+// FailureStageNEQ applies the NEQ predicate on the "failure_stage" field.
+func FailureStageNEQ(v FailureStage) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFailureStage, v))
+}
+
+// FailureStageIn applies the In predicate on the "failure_stage" field.
+func FailureStageIn(vs ...FailureStage) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldFailureStage, vs...))
+}
+
+// FailureStageNotIn applies the NotIn predicate on the "failure_stage" field.
+func FailureStageNotIn(vs ...FailureStage) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldFailureStage, vs...))
+}
+
+// FailureStageIsNil applies the IsNil predicate on the "failure_stage" field.
+func FailureStageIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldFailureStage))
+}
+
+// FailureStageNotNil applies the NotNil predicate on the "failure_stage" field.
+func FailureStageNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldFailureStage))
+}
+
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v int) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldParentID))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldLabels))
+}
+
+// ChallengeStatusEQ applies the EQ predicate on the "challenge_status" field.
+func ChallengeStatusEQ(v ChallengeStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldChallengeStatus, v))
+}
+
+// ChallengeStatusNEQ applies the NEQ predicate on the "challenge_status" field.
+func ChallengeStatusNEQ(v ChallengeStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldChallengeStatus, v))
+}
+
+// ChallengeStatusIn applies the In predicate on the "challenge_status" field.
+func ChallengeStatusIn(vs ...ChallengeStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIn(FieldChallengeStatus, vs...))
+}
+
+// ChallengeStatusNotIn applies the NotIn predicate on the "challenge_status" field.
+func ChallengeStatusNotIn(vs ...ChallengeStatus) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotIn(FieldChallengeStatus, vs...))
+}
+
+// ChallengeStatusIsNil applies the IsNil predicate on the "challenge_status" field.
+func ChallengeStatusIsNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldIsNull(FieldChallengeStatus))
+}
+
+// ChallengeStatusNotNil applies the NotNil predicate on the "challenge_status" field.
+func ChallengeStatusNotNil() predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNotNull(FieldChallengeStatus))
+}
+
+// FinalEQ applies the EQ predicate on the "final" field.
+func FinalEQ(v bool) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldEQ(FieldFinal, v))
+}
+
+// FinalNEQ applies the NEQ predicate on the "final" field.
+func FinalNEQ(v bool) predicate.ProofRequest {
+	return predicate.ProofRequest(sql.FieldNEQ(FieldFinal, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProofRequest) predicate.ProofRequest {
 	return predicate.ProofRequest(sql.AndPredicates(predicates...))
@@ -117,6 +117,256 @@ func (prc *ProofRequestCreate) SetProof(b []byte) *ProofRequestCreate {
 	return prc
 }
 
+// SetEstimatedCycles sets the "estimated_cycles" field.
+func (prc *ProofRequestCreate) SetEstimatedCycles(u uint64) *ProofRequestCreate {
+	prc.mutation.SetEstimatedCycles(u)
+	return prc
+}
+
+// SetNillableEstimatedCycles sets the "estimated_cycles" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableEstimatedCycles(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetEstimatedCycles(*u)
+	}
+	return prc
+}
+
+// SetEstimatedFee sets the "estimated_fee" field.
+func (prc *ProofRequestCreate) SetEstimatedFee(u uint64) *ProofRequestCreate {
+	prc.mutation.SetEstimatedFee(u)
+	return prc
+}
+
+// SetNillableEstimatedFee sets the "estimated_fee" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableEstimatedFee(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetEstimatedFee(*u)
+	}
+	return prc
+}
+
+// SetProofSystem sets the "proof_system" field.
+func (prc *ProofRequestCreate) SetProofSystem(s string) *ProofRequestCreate {
+	prc.mutation.SetProofSystem(s)
+	return prc
+}
+
+// SetNillableProofSystem sets the "proof_system" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProofSystem(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetProofSystem(*s)
+	}
+	return prc
+}
+
+// SetVkeyHash sets the "vkey_hash" field.
+func (prc *ProofRequestCreate) SetVkeyHash(s string) *ProofRequestCreate {
+	prc.mutation.SetVkeyHash(s)
+	return prc
+}
+
+// SetNillableVkeyHash sets the "vkey_hash" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableVkeyHash(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetVkeyHash(*s)
+	}
+	return prc
+}
+
+// SetProofFormat sets the "proof_format" field.
+func (prc *ProofRequestCreate) SetProofFormat(s string) *ProofRequestCreate {
+	prc.mutation.SetProofFormat(s)
+	return prc
+}
+
+// SetNillableProofFormat sets the "proof_format" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProofFormat(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetProofFormat(*s)
+	}
+	return prc
+}
+
+// SetBackfill sets the "backfill" field.
+func (prc *ProofRequestCreate) SetBackfill(b bool) *ProofRequestCreate {
+	prc.mutation.SetBackfill(b)
+	return prc
+}
+
+// SetNillableBackfill sets the "backfill" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableBackfill(b *bool) *ProofRequestCreate {
+	if b != nil {
+		prc.SetBackfill(*b)
+	}
+	return prc
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (prc *ProofRequestCreate) SetFailureReason(s string) *ProofRequestCreate {
+	prc.mutation.SetFailureReason(s)
+	return prc
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFailureReason(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetFailureReason(*s)
+	}
+	return prc
+}
+
+// SetFulfilledCycles sets the "fulfilled_cycles" field.
+func (prc *ProofRequestCreate) SetFulfilledCycles(u uint64) *ProofRequestCreate {
+	prc.mutation.SetFulfilledCycles(u)
+	return prc
+}
+
+// SetNillableFulfilledCycles sets the "fulfilled_cycles" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFulfilledCycles(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetFulfilledCycles(*u)
+	}
+	return prc
+}
+
+// SetFulfilledFee sets the "fulfilled_fee" field.
+func (prc *ProofRequestCreate) SetFulfilledFee(u uint64) *ProofRequestCreate {
+	prc.mutation.SetFulfilledFee(u)
+	return prc
+}
+
+// SetNillableFulfilledFee sets the "fulfilled_fee" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFulfilledFee(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetFulfilledFee(*u)
+	}
+	return prc
+}
+
+// SetProver sets the "prover" field.
+func (prc *ProofRequestCreate) SetProver(s string) *ProofRequestCreate {
+	prc.mutation.SetProver(s)
+	return prc
+}
+
+// SetNillableProver sets the "prover" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProver(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetProver(*s)
+	}
+	return prc
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (prc *ProofRequestCreate) SetFulfilledTime(u uint64) *ProofRequestCreate {
+	prc.mutation.SetFulfilledTime(u)
+	return prc
+}
+
+// SetNillableFulfilledTime sets the "fulfilled_time" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFulfilledTime(u *uint64) *ProofRequestCreate {
+	if u != nil {
+		prc.SetFulfilledTime(*u)
+	}
+	return prc
+}
+
+// SetProgramVersion sets the "program_version" field.
+func (prc *ProofRequestCreate) SetProgramVersion(s string) *ProofRequestCreate {
+	prc.mutation.SetProgramVersion(s)
+	return prc
+}
+
+// SetNillableProgramVersion sets the "program_version" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableProgramVersion(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetProgramVersion(*s)
+	}
+	return prc
+}
+
+// SetPublicValues sets the "public_values" field.
+func (prc *ProofRequestCreate) SetPublicValues(b []byte) *ProofRequestCreate {
+	prc.mutation.SetPublicValues(b)
+	return prc
+}
+
+// SetErrorCode sets the "error_code" field.
+func (prc *ProofRequestCreate) SetErrorCode(s string) *ProofRequestCreate {
+	prc.mutation.SetErrorCode(s)
+	return prc
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableErrorCode(s *string) *ProofRequestCreate {
+	if s != nil {
+		prc.SetErrorCode(*s)
+	}
+	return prc
+}
+
+// SetFailureStage sets the "failure_stage" field.
+func (prc *ProofRequestCreate) SetFailureStage(ps proofrequest.FailureStage) *ProofRequestCreate {
+	prc.mutation.SetFailureStage(ps)
+	return prc
+}
+
+// SetNillableFailureStage sets the "failure_stage" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFailureStage(ps *proofrequest.FailureStage) *ProofRequestCreate {
+	if ps != nil {
+		prc.SetFailureStage(*ps)
+	}
+	return prc
+}
+
+// SetParentID sets the "parent_id" field.
+func (prc *ProofRequestCreate) SetParentID(i int) *ProofRequestCreate {
+	prc.mutation.SetParentID(i)
+	return prc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableParentID(i *int) *ProofRequestCreate {
+	if i != nil {
+		prc.SetParentID(*i)
+	}
+	return prc
+}
+
+// SetLabels sets the "labels" field.
+func (prc *ProofRequestCreate) SetLabels(s []string) *ProofRequestCreate {
+	prc.mutation.SetLabels(s)
+	return prc
+}
+
+// SetChallengeStatus sets the "challenge_status" field.
+func (prc *ProofRequestCreate) SetChallengeStatus(ps proofrequest.ChallengeStatus) *ProofRequestCreate {
+	prc.mutation.SetChallengeStatus(ps)
+	return prc
+}
+
+// SetNillableChallengeStatus sets the "challenge_status" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableChallengeStatus(ps *proofrequest.ChallengeStatus) *ProofRequestCreate {
+	if ps != nil {
+		prc.SetChallengeStatus(*ps)
+	}
+	return prc
+}
+
+// SetFinal sets the "final" field.
+func (prc *ProofRequestCreate) SetFinal(b bool) *ProofRequestCreate {
+	prc.mutation.SetFinal(b)
+	return prc
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (prc *ProofRequestCreate) SetNillableFinal(b *bool) *ProofRequestCreate {
+	if b != nil {
+		prc.SetFinal(*b)
+	}
+	return prc
+}
+
 // Mutation returns the ProofRequestMutation object of the builder.
 func (prc *ProofRequestCreate) Mutation() *ProofRequestMutation {
 	return prc.mutation
@@ -124,6 +374,7 @@ func (prc *ProofRequestCreate) Mutation() *ProofRequestMutation {
 
 // Save creates the ProofRequest in the database.
 func (prc *ProofRequestCreate) Save(ctx context.Context) (*ProofRequest, error) {
+	prc.defaults()
 	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
 }
 
@@ -149,6 +400,18 @@ func (prc *ProofRequestCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (prc *ProofRequestCreate) defaults() {
+	if _, ok := prc.mutation.Backfill(); !ok {
+		v := proofrequest.DefaultBackfill
+		prc.mutation.SetBackfill(v)
+	}
+	if _, ok := prc.mutation.Final(); !ok {
+		v := proofrequest.DefaultFinal
+		prc.mutation.SetFinal(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (prc *ProofRequestCreate) check() error {
 	if _, ok := prc.mutation.GetType(); !ok {
@@ -179,6 +442,22 @@ func (prc *ProofRequestCreate) check() error {
 	if _, ok := prc.mutation.LastUpdatedTime(); !ok {
 		return &ValidationError{Name: "last_updated_time", err: errors.New(`ent: missing required field "ProofRequest.last_updated_time"`)}
 	}
+	if _, ok := prc.mutation.Backfill(); !ok {
+		return &ValidationError{Name: "backfill", err: errors.New(`ent: missing required field "ProofRequest.backfill"`)}
+	}
+	if v, ok := prc.mutation.FailureStage(); ok {
+		if err := proofrequest.FailureStageValidator(v); err != nil {
+			return &ValidationError{Name: "failure_stage", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.failure_stage": %w`, err)}
+		}
+	}
+	if v, ok := prc.mutation.ChallengeStatus(); ok {
+		if err := proofrequest.ChallengeStatusValidator(v); err != nil {
+			return &ValidationError{Name: "challenge_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.challenge_status": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Final(); !ok {
+		return &ValidationError{Name: "final", err: errors.New(`ent: missing required field "ProofRequest.final"`)}
+	}
 	return nil
 }
 
@@ -249,6 +528,82 @@ func (prc *ProofRequestCreate) createSpec() (*ProofRequest, *sqlgraph.CreateSpec
 		_spec.SetField(proofrequest.FieldProof, field.TypeBytes, value)
 		_node.Proof = value
 	}
+	if value, ok := prc.mutation.EstimatedCycles(); ok {
+		_spec.SetField(proofrequest.FieldEstimatedCycles, field.TypeUint64, value)
+		_node.EstimatedCycles = value
+	}
+	if value, ok := prc.mutation.EstimatedFee(); ok {
+		_spec.SetField(proofrequest.FieldEstimatedFee, field.TypeUint64, value)
+		_node.EstimatedFee = value
+	}
+	if value, ok := prc.mutation.ProofSystem(); ok {
+		_spec.SetField(proofrequest.FieldProofSystem, field.TypeString, value)
+		_node.ProofSystem = value
+	}
+	if value, ok := prc.mutation.VkeyHash(); ok {
+		_spec.SetField(proofrequest.FieldVkeyHash, field.TypeString, value)
+		_node.VkeyHash = value
+	}
+	if value, ok := prc.mutation.ProofFormat(); ok {
+		_spec.SetField(proofrequest.FieldProofFormat, field.TypeString, value)
+		_node.ProofFormat = value
+	}
+	if value, ok := prc.mutation.Backfill(); ok {
+		_spec.SetField(proofrequest.FieldBackfill, field.TypeBool, value)
+		_node.Backfill = value
+	}
+	if value, ok := prc.mutation.FailureReason(); ok {
+		_spec.SetField(proofrequest.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if value, ok := prc.mutation.FulfilledCycles(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledCycles, field.TypeUint64, value)
+		_node.FulfilledCycles = value
+	}
+	if value, ok := prc.mutation.FulfilledFee(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledFee, field.TypeUint64, value)
+		_node.FulfilledFee = value
+	}
+	if value, ok := prc.mutation.Prover(); ok {
+		_spec.SetField(proofrequest.FieldProver, field.TypeString, value)
+		_node.Prover = value
+	}
+	if value, ok := prc.mutation.FulfilledTime(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+		_node.FulfilledTime = value
+	}
+	if value, ok := prc.mutation.ProgramVersion(); ok {
+		_spec.SetField(proofrequest.FieldProgramVersion, field.TypeString, value)
+		_node.ProgramVersion = value
+	}
+	if value, ok := prc.mutation.PublicValues(); ok {
+		_spec.SetField(proofrequest.FieldPublicValues, field.TypeBytes, value)
+		_node.PublicValues = value
+	}
+	if value, ok := prc.mutation.ErrorCode(); ok {
+		_spec.SetField(proofrequest.FieldErrorCode, field.TypeString, value)
+		_node.ErrorCode = value
+	}
+	if value, ok := prc.mutation.FailureStage(); ok {
+		_spec.SetField(proofrequest.FieldFailureStage, field.TypeEnum, value)
+		_node.FailureStage = value
+	}
+	if value, ok := prc.mutation.ParentID(); ok {
+		_spec.SetField(proofrequest.FieldParentID, field.TypeInt, value)
+		_node.ParentID = value
+	}
+	if value, ok := prc.mutation.Labels(); ok {
+		_spec.SetField(proofrequest.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := prc.mutation.ChallengeStatus(); ok {
+		_spec.SetField(proofrequest.FieldChallengeStatus, field.TypeEnum, value)
+		_node.ChallengeStatus = value
+	}
+	if value, ok := prc.mutation.Final(); ok {
+		_spec.SetField(proofrequest.FieldFinal, field.TypeBool, value)
+		_node.Final = value
+	}
 	return _node, _spec
 }
 
@@ -270,6 +625,7 @@ func (prcb *ProofRequestCreateBulk) Save(ctx context.Context) ([]*ProofRequest,
 	for i := range prcb.builders {
 		func(i int, root context.Context) {
 			builder := prcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ProofRequestMutation)
 				if !ok {
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
@@ -245,6 +246,406 @@ func (pru *ProofRequestUpdate) ClearProof() *ProofRequestUpdate {
 	return pru
 }
 
+// SetEstimatedCycles sets the "estimated_cycles" field.
+func (pru *ProofRequestUpdate) SetEstimatedCycles(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetEstimatedCycles()
+	pru.mutation.SetEstimatedCycles(u)
+	return pru
+}
+
+// SetNillableEstimatedCycles sets the "estimated_cycles" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableEstimatedCycles(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetEstimatedCycles(*u)
+	}
+	return pru
+}
+
+// AddEstimatedCycles adds u to the "estimated_cycles" field.
+func (pru *ProofRequestUpdate) AddEstimatedCycles(u int64) *ProofRequestUpdate {
+	pru.mutation.AddEstimatedCycles(u)
+	return pru
+}
+
+// ClearEstimatedCycles clears the value of the "estimated_cycles" field.
+func (pru *ProofRequestUpdate) ClearEstimatedCycles() *ProofRequestUpdate {
+	pru.mutation.ClearEstimatedCycles()
+	return pru
+}
+
+// SetEstimatedFee sets the "estimated_fee" field.
+func (pru *ProofRequestUpdate) SetEstimatedFee(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetEstimatedFee()
+	pru.mutation.SetEstimatedFee(u)
+	return pru
+}
+
+// SetNillableEstimatedFee sets the "estimated_fee" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableEstimatedFee(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetEstimatedFee(*u)
+	}
+	return pru
+}
+
+// AddEstimatedFee adds u to the "estimated_fee" field.
+func (pru *ProofRequestUpdate) AddEstimatedFee(u int64) *ProofRequestUpdate {
+	pru.mutation.AddEstimatedFee(u)
+	return pru
+}
+
+// ClearEstimatedFee clears the value of the "estimated_fee" field.
+func (pru *ProofRequestUpdate) ClearEstimatedFee() *ProofRequestUpdate {
+	pru.mutation.ClearEstimatedFee()
+	return pru
+}
+
+// SetProofSystem sets the "proof_system" field.
+func (pru *ProofRequestUpdate) SetProofSystem(s string) *ProofRequestUpdate {
+	pru.mutation.SetProofSystem(s)
+	return pru
+}
+
+// SetNillableProofSystem sets the "proof_system" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProofSystem(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetProofSystem(*s)
+	}
+	return pru
+}
+
+// ClearProofSystem clears the value of the "proof_system" field.
+func (pru *ProofRequestUpdate) ClearProofSystem() *ProofRequestUpdate {
+	pru.mutation.ClearProofSystem()
+	return pru
+}
+
+// SetVkeyHash sets the "vkey_hash" field.
+func (pru *ProofRequestUpdate) SetVkeyHash(s string) *ProofRequestUpdate {
+	pru.mutation.SetVkeyHash(s)
+	return pru
+}
+
+// SetNillableVkeyHash sets the "vkey_hash" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableVkeyHash(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetVkeyHash(*s)
+	}
+	return pru
+}
+
+// ClearVkeyHash clears the value of the "vkey_hash" field.
+func (pru *ProofRequestUpdate) ClearVkeyHash() *ProofRequestUpdate {
+	pru.mutation.ClearVkeyHash()
+	return pru
+}
+
+// SetProofFormat sets the "proof_format" field.
+func (pru *ProofRequestUpdate) SetProofFormat(s string) *ProofRequestUpdate {
+	pru.mutation.SetProofFormat(s)
+	return pru
+}
+
+// SetNillableProofFormat sets the "proof_format" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProofFormat(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetProofFormat(*s)
+	}
+	return pru
+}
+
+// ClearProofFormat clears the value of the "proof_format" field.
+func (pru *ProofRequestUpdate) ClearProofFormat() *ProofRequestUpdate {
+	pru.mutation.ClearProofFormat()
+	return pru
+}
+
+// SetBackfill sets the "backfill" field.
+func (pru *ProofRequestUpdate) SetBackfill(b bool) *ProofRequestUpdate {
+	pru.mutation.SetBackfill(b)
+	return pru
+}
+
+// SetNillableBackfill sets the "backfill" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableBackfill(b *bool) *ProofRequestUpdate {
+	if b != nil {
+		pru.SetBackfill(*b)
+	}
+	return pru
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (pru *ProofRequestUpdate) SetFailureReason(s string) *ProofRequestUpdate {
+	pru.mutation.SetFailureReason(s)
+	return pru
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFailureReason(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetFailureReason(*s)
+	}
+	return pru
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (pru *ProofRequestUpdate) ClearFailureReason() *ProofRequestUpdate {
+	pru.mutation.ClearFailureReason()
+	return pru
+}
+
+// SetFulfilledCycles sets the "fulfilled_cycles" field.
+func (pru *ProofRequestUpdate) SetFulfilledCycles(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetFulfilledCycles()
+	pru.mutation.SetFulfilledCycles(u)
+	return pru
+}
+
+// SetNillableFulfilledCycles sets the "fulfilled_cycles" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFulfilledCycles(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetFulfilledCycles(*u)
+	}
+	return pru
+}
+
+// AddFulfilledCycles adds u to the "fulfilled_cycles" field.
+func (pru *ProofRequestUpdate) AddFulfilledCycles(u int64) *ProofRequestUpdate {
+	pru.mutation.AddFulfilledCycles(u)
+	return pru
+}
+
+// ClearFulfilledCycles clears the value of the "fulfilled_cycles" field.
+func (pru *ProofRequestUpdate) ClearFulfilledCycles() *ProofRequestUpdate {
+	pru.mutation.ClearFulfilledCycles()
+	return pru
+}
+
+// SetFulfilledFee sets the "fulfilled_fee" field.
+func (pru *ProofRequestUpdate) SetFulfilledFee(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetFulfilledFee()
+	pru.mutation.SetFulfilledFee(u)
+	return pru
+}
+
+// SetNillableFulfilledFee sets the "fulfilled_fee" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFulfilledFee(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetFulfilledFee(*u)
+	}
+	return pru
+}
+
+// AddFulfilledFee adds u to the "fulfilled_fee" field.
+func (pru *ProofRequestUpdate) AddFulfilledFee(u int64) *ProofRequestUpdate {
+	pru.mutation.AddFulfilledFee(u)
+	return pru
+}
+
+// ClearFulfilledFee clears the value of the "fulfilled_fee" field.
+func (pru *ProofRequestUpdate) ClearFulfilledFee() *ProofRequestUpdate {
+	pru.mutation.ClearFulfilledFee()
+	return pru
+}
+
+// SetProver sets the "prover" field.
+func (pru *ProofRequestUpdate) SetProver(s string) *ProofRequestUpdate {
+	pru.mutation.SetProver(s)
+	return pru
+}
+
+// SetNillableProver sets the "prover" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProver(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetProver(*s)
+	}
+	return pru
+}
+
+// ClearProver clears the value of the "prover" field.
+func (pru *ProofRequestUpdate) ClearProver() *ProofRequestUpdate {
+	pru.mutation.ClearProver()
+	return pru
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (pru *ProofRequestUpdate) SetFulfilledTime(u uint64) *ProofRequestUpdate {
+	pru.mutation.ResetFulfilledTime()
+	pru.mutation.SetFulfilledTime(u)
+	return pru
+}
+
+// SetNillableFulfilledTime sets the "fulfilled_time" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFulfilledTime(u *uint64) *ProofRequestUpdate {
+	if u != nil {
+		pru.SetFulfilledTime(*u)
+	}
+	return pru
+}
+
+// AddFulfilledTime adds u to the "fulfilled_time" field.
+func (pru *ProofRequestUpdate) AddFulfilledTime(u int64) *ProofRequestUpdate {
+	pru.mutation.AddFulfilledTime(u)
+	return pru
+}
+
+// ClearFulfilledTime clears the value of the "fulfilled_time" field.
+func (pru *ProofRequestUpdate) ClearFulfilledTime() *ProofRequestUpdate {
+	pru.mutation.ClearFulfilledTime()
+	return pru
+}
+
+// SetProgramVersion sets the "program_version" field.
+func (pru *ProofRequestUpdate) SetProgramVersion(s string) *ProofRequestUpdate {
+	pru.mutation.SetProgramVersion(s)
+	return pru
+}
+
+// SetNillableProgramVersion sets the "program_version" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableProgramVersion(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetProgramVersion(*s)
+	}
+	return pru
+}
+
+// ClearProgramVersion clears the value of the "program_version" field.
+func (pru *ProofRequestUpdate) ClearProgramVersion() *ProofRequestUpdate {
+	pru.mutation.ClearProgramVersion()
+	return pru
+}
+
+// SetPublicValues sets the "public_values" field.
+func (pru *ProofRequestUpdate) SetPublicValues(b []byte) *ProofRequestUpdate {
+	pru.mutation.SetPublicValues(b)
+	return pru
+}
+
+// ClearPublicValues clears the value of the "public_values" field.
+func (pru *ProofRequestUpdate) ClearPublicValues() *ProofRequestUpdate {
+	pru.mutation.ClearPublicValues()
+	return pru
+}
+
+// SetErrorCode sets the "error_code" field.
+func (pru *ProofRequestUpdate) SetErrorCode(s string) *ProofRequestUpdate {
+	pru.mutation.SetErrorCode(s)
+	return pru
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableErrorCode(s *string) *ProofRequestUpdate {
+	if s != nil {
+		pru.SetErrorCode(*s)
+	}
+	return pru
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (pru *ProofRequestUpdate) ClearErrorCode() *ProofRequestUpdate {
+	pru.mutation.ClearErrorCode()
+	return pru
+}
+
+// SetFailureStage sets the "failure_stage" field.
+func (pru *ProofRequestUpdate) SetFailureStage(ps proofrequest.FailureStage) *ProofRequestUpdate {
+	pru.mutation.SetFailureStage(ps)
+	return pru
+}
+
+// SetNillableFailureStage sets the "failure_stage" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFailureStage(ps *proofrequest.FailureStage) *ProofRequestUpdate {
+	if ps != nil {
+		pru.SetFailureStage(*ps)
+	}
+	return pru
+}
+
+// ClearFailureStage clears the value of the "failure_stage" field.
+func (pru *ProofRequestUpdate) ClearFailureStage() *ProofRequestUpdate {
+	pru.mutation.ClearFailureStage()
+	return pru
+}
+
+// SetParentID sets the "parent_id" field.
+func (pru *ProofRequestUpdate) SetParentID(i int) *ProofRequestUpdate {
+	pru.mutation.ResetParentID()
+	pru.mutation.SetParentID(i)
+	return pru
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableParentID(i *int) *ProofRequestUpdate {
+	if i != nil {
+		pru.SetParentID(*i)
+	}
+	return pru
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (pru *ProofRequestUpdate) AddParentID(i int) *ProofRequestUpdate {
+	pru.mutation.AddParentID(i)
+	return pru
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (pru *ProofRequestUpdate) ClearParentID() *ProofRequestUpdate {
+	pru.mutation.ClearParentID()
+	return pru
+}
+
+// SetLabels sets the "labels" field.
+func (pru *ProofRequestUpdate) SetLabels(s []string) *ProofRequestUpdate {
+	pru.mutation.SetLabels(s)
+	return pru
+}
+
+// AppendLabels appends s to the "labels" field.
+func (pru *ProofRequestUpdate) AppendLabels(s []string) *ProofRequestUpdate {
+	pru.mutation.AppendLabels(s)
+	return pru
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (pru *ProofRequestUpdate) ClearLabels() *ProofRequestUpdate {
+	pru.mutation.ClearLabels()
+	return pru
+}
+
+// SetChallengeStatus sets the "challenge_status" field.
+func (pru *ProofRequestUpdate) SetChallengeStatus(ps proofrequest.ChallengeStatus) *ProofRequestUpdate {
+	pru.mutation.SetChallengeStatus(ps)
+	return pru
+}
+
+// SetNillableChallengeStatus sets the "challenge_status" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableChallengeStatus(ps *proofrequest.ChallengeStatus) *ProofRequestUpdate {
+	if ps != nil {
+		pru.SetChallengeStatus(*ps)
+	}
+	return pru
+}
+
+// ClearChallengeStatus clears the value of the "challenge_status" field.
+func (pru *ProofRequestUpdate) ClearChallengeStatus() *ProofRequestUpdate {
+	pru.mutation.ClearChallengeStatus()
+	return pru
+}
+
+// SetFinal sets the "final" field.
+func (pru *ProofRequestUpdate) SetFinal(b bool) *ProofRequestUpdate {
+	pru.mutation.SetFinal(b)
+	return pru
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (pru *ProofRequestUpdate) SetNillableFinal(b *bool) *ProofRequestUpdate {
+	if b != nil {
+		pru.SetFinal(*b)
+	}
+	return pru
+}
+
 // Mutation returns the ProofRequestMutation object of the builder.
 func (pru *ProofRequestUpdate) Mutation() *ProofRequestMutation {
 	return pru.mutation
@@ -289,6 +690,16 @@ func (pru *ProofRequestUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := pru.mutation.FailureStage(); ok {
+		if err := proofrequest.FailureStageValidator(v); err != nil {
+			return &ValidationError{Name: "failure_stage", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.failure_stage": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.ChallengeStatus(); ok {
+		if err := proofrequest.ChallengeStatusValidator(v); err != nil {
+			return &ValidationError{Name: "challenge_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.challenge_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -370,6 +781,137 @@ func (pru *ProofRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pru.mutation.ProofCleared() {
 		_spec.ClearField(proofrequest.FieldProof, field.TypeBytes)
 	}
+	if value, ok := pru.mutation.EstimatedCycles(); ok {
+		_spec.SetField(proofrequest.FieldEstimatedCycles, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedEstimatedCycles(); ok {
+		_spec.AddField(proofrequest.FieldEstimatedCycles, field.TypeUint64, value)
+	}
+	if pru.mutation.EstimatedCyclesCleared() {
+		_spec.ClearField(proofrequest.FieldEstimatedCycles, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.EstimatedFee(); ok {
+		_spec.SetField(proofrequest.FieldEstimatedFee, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedEstimatedFee(); ok {
+		_spec.AddField(proofrequest.FieldEstimatedFee, field.TypeUint64, value)
+	}
+	if pru.mutation.EstimatedFeeCleared() {
+		_spec.ClearField(proofrequest.FieldEstimatedFee, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.ProofSystem(); ok {
+		_spec.SetField(proofrequest.FieldProofSystem, field.TypeString, value)
+	}
+	if pru.mutation.ProofSystemCleared() {
+		_spec.ClearField(proofrequest.FieldProofSystem, field.TypeString)
+	}
+	if value, ok := pru.mutation.VkeyHash(); ok {
+		_spec.SetField(proofrequest.FieldVkeyHash, field.TypeString, value)
+	}
+	if pru.mutation.VkeyHashCleared() {
+		_spec.ClearField(proofrequest.FieldVkeyHash, field.TypeString)
+	}
+	if value, ok := pru.mutation.ProofFormat(); ok {
+		_spec.SetField(proofrequest.FieldProofFormat, field.TypeString, value)
+	}
+	if pru.mutation.ProofFormatCleared() {
+		_spec.ClearField(proofrequest.FieldProofFormat, field.TypeString)
+	}
+	if value, ok := pru.mutation.Backfill(); ok {
+		_spec.SetField(proofrequest.FieldBackfill, field.TypeBool, value)
+	}
+	if value, ok := pru.mutation.FailureReason(); ok {
+		_spec.SetField(proofrequest.FieldFailureReason, field.TypeString, value)
+	}
+	if pru.mutation.FailureReasonCleared() {
+		_spec.ClearField(proofrequest.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := pru.mutation.FulfilledCycles(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledCycles, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedFulfilledCycles(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledCycles, field.TypeUint64, value)
+	}
+	if pru.mutation.FulfilledCyclesCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledCycles, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.FulfilledFee(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledFee, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedFulfilledFee(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledFee, field.TypeUint64, value)
+	}
+	if pru.mutation.FulfilledFeeCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledFee, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.Prover(); ok {
+		_spec.SetField(proofrequest.FieldProver, field.TypeString, value)
+	}
+	if pru.mutation.ProverCleared() {
+		_spec.ClearField(proofrequest.FieldProver, field.TypeString)
+	}
+	if value, ok := pru.mutation.FulfilledTime(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if value, ok := pru.mutation.AddedFulfilledTime(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if pru.mutation.FulfilledTimeCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledTime, field.TypeUint64)
+	}
+	if value, ok := pru.mutation.ProgramVersion(); ok {
+		_spec.SetField(proofrequest.FieldProgramVersion, field.TypeString, value)
+	}
+	if pru.mutation.ProgramVersionCleared() {
+		_spec.ClearField(proofrequest.FieldProgramVersion, field.TypeString)
+	}
+	if value, ok := pru.mutation.PublicValues(); ok {
+		_spec.SetField(proofrequest.FieldPublicValues, field.TypeBytes, value)
+	}
+	if pru.mutation.PublicValuesCleared() {
+		_spec.ClearField(proofrequest.FieldPublicValues, field.TypeBytes)
+	}
+	if value, ok := pru.mutation.ErrorCode(); ok {
+		_spec.SetField(proofrequest.FieldErrorCode, field.TypeString, value)
+	}
+	if pru.mutation.ErrorCodeCleared() {
+		_spec.ClearField(proofrequest.FieldErrorCode, field.TypeString)
+	}
+	if value, ok := pru.mutation.FailureStage(); ok {
+		_spec.SetField(proofrequest.FieldFailureStage, field.TypeEnum, value)
+	}
+	if pru.mutation.FailureStageCleared() {
+		_spec.ClearField(proofrequest.FieldFailureStage, field.TypeEnum)
+	}
+	if value, ok := pru.mutation.ParentID(); ok {
+		_spec.SetField(proofrequest.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedParentID(); ok {
+		_spec.AddField(proofrequest.FieldParentID, field.TypeInt, value)
+	}
+	if pru.mutation.ParentIDCleared() {
+		_spec.ClearField(proofrequest.FieldParentID, field.TypeInt)
+	}
+	if value, ok := pru.mutation.Labels(); ok {
+		_spec.SetField(proofrequest.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := pru.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, proofrequest.FieldLabels, value)
+		})
+	}
+	if pru.mutation.LabelsCleared() {
+		_spec.ClearField(proofrequest.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := pru.mutation.ChallengeStatus(); ok {
+		_spec.SetField(proofrequest.FieldChallengeStatus, field.TypeEnum, value)
+	}
+	if pru.mutation.ChallengeStatusCleared() {
+		_spec.ClearField(proofrequest.FieldChallengeStatus, field.TypeEnum)
+	}
+	if value, ok := pru.mutation.Final(); ok {
+		_spec.SetField(proofrequest.FieldFinal, field.TypeBool, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{proofrequest.Label}
@@ -608,6 +1150,406 @@ func (pruo *ProofRequestUpdateOne) ClearProof() *ProofRequestUpdateOne {
 	return pruo
 }
 
+// SetEstimatedCycles sets the "estimated_cycles" field.
+func (pruo *ProofRequestUpdateOne) SetEstimatedCycles(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetEstimatedCycles()
+	pruo.mutation.SetEstimatedCycles(u)
+	return pruo
+}
+
+// SetNillableEstimatedCycles sets the "estimated_cycles" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableEstimatedCycles(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetEstimatedCycles(*u)
+	}
+	return pruo
+}
+
+// AddEstimatedCycles adds u to the "estimated_cycles" field.
+func (pruo *ProofRequestUpdateOne) AddEstimatedCycles(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddEstimatedCycles(u)
+	return pruo
+}
+
+// ClearEstimatedCycles clears the value of the "estimated_cycles" field.
+func (pruo *ProofRequestUpdateOne) ClearEstimatedCycles() *ProofRequestUpdateOne {
+	pruo.mutation.ClearEstimatedCycles()
+	return pruo
+}
+
+// SetEstimatedFee sets the "estimated_fee" field.
+func (pruo *ProofRequestUpdateOne) SetEstimatedFee(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetEstimatedFee()
+	pruo.mutation.SetEstimatedFee(u)
+	return pruo
+}
+
+// SetNillableEstimatedFee sets the "estimated_fee" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableEstimatedFee(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetEstimatedFee(*u)
+	}
+	return pruo
+}
+
+// AddEstimatedFee adds u to the "estimated_fee" field.
+func (pruo *ProofRequestUpdateOne) AddEstimatedFee(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddEstimatedFee(u)
+	return pruo
+}
+
+// ClearEstimatedFee clears the value of the "estimated_fee" field.
+func (pruo *ProofRequestUpdateOne) ClearEstimatedFee() *ProofRequestUpdateOne {
+	pruo.mutation.ClearEstimatedFee()
+	return pruo
+}
+
+// SetProofSystem sets the "proof_system" field.
+func (pruo *ProofRequestUpdateOne) SetProofSystem(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProofSystem(s)
+	return pruo
+}
+
+// SetNillableProofSystem sets the "proof_system" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProofSystem(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProofSystem(*s)
+	}
+	return pruo
+}
+
+// ClearProofSystem clears the value of the "proof_system" field.
+func (pruo *ProofRequestUpdateOne) ClearProofSystem() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProofSystem()
+	return pruo
+}
+
+// SetVkeyHash sets the "vkey_hash" field.
+func (pruo *ProofRequestUpdateOne) SetVkeyHash(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetVkeyHash(s)
+	return pruo
+}
+
+// SetNillableVkeyHash sets the "vkey_hash" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableVkeyHash(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetVkeyHash(*s)
+	}
+	return pruo
+}
+
+// ClearVkeyHash clears the value of the "vkey_hash" field.
+func (pruo *ProofRequestUpdateOne) ClearVkeyHash() *ProofRequestUpdateOne {
+	pruo.mutation.ClearVkeyHash()
+	return pruo
+}
+
+// SetProofFormat sets the "proof_format" field.
+func (pruo *ProofRequestUpdateOne) SetProofFormat(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProofFormat(s)
+	return pruo
+}
+
+// SetNillableProofFormat sets the "proof_format" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProofFormat(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProofFormat(*s)
+	}
+	return pruo
+}
+
+// ClearProofFormat clears the value of the "proof_format" field.
+func (pruo *ProofRequestUpdateOne) ClearProofFormat() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProofFormat()
+	return pruo
+}
+
+// SetBackfill sets the "backfill" field.
+func (pruo *ProofRequestUpdateOne) SetBackfill(b bool) *ProofRequestUpdateOne {
+	pruo.mutation.SetBackfill(b)
+	return pruo
+}
+
+// SetNillableBackfill sets the "backfill" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableBackfill(b *bool) *ProofRequestUpdateOne {
+	if b != nil {
+		pruo.SetBackfill(*b)
+	}
+	return pruo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (pruo *ProofRequestUpdateOne) SetFailureReason(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetFailureReason(s)
+	return pruo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFailureReason(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetFailureReason(*s)
+	}
+	return pruo
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (pruo *ProofRequestUpdateOne) ClearFailureReason() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFailureReason()
+	return pruo
+}
+
+// SetFulfilledCycles sets the "fulfilled_cycles" field.
+func (pruo *ProofRequestUpdateOne) SetFulfilledCycles(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetFulfilledCycles()
+	pruo.mutation.SetFulfilledCycles(u)
+	return pruo
+}
+
+// SetNillableFulfilledCycles sets the "fulfilled_cycles" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFulfilledCycles(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetFulfilledCycles(*u)
+	}
+	return pruo
+}
+
+// AddFulfilledCycles adds u to the "fulfilled_cycles" field.
+func (pruo *ProofRequestUpdateOne) AddFulfilledCycles(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddFulfilledCycles(u)
+	return pruo
+}
+
+// ClearFulfilledCycles clears the value of the "fulfilled_cycles" field.
+func (pruo *ProofRequestUpdateOne) ClearFulfilledCycles() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFulfilledCycles()
+	return pruo
+}
+
+// SetFulfilledFee sets the "fulfilled_fee" field.
+func (pruo *ProofRequestUpdateOne) SetFulfilledFee(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetFulfilledFee()
+	pruo.mutation.SetFulfilledFee(u)
+	return pruo
+}
+
+// SetNillableFulfilledFee sets the "fulfilled_fee" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFulfilledFee(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetFulfilledFee(*u)
+	}
+	return pruo
+}
+
+// AddFulfilledFee adds u to the "fulfilled_fee" field.
+func (pruo *ProofRequestUpdateOne) AddFulfilledFee(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddFulfilledFee(u)
+	return pruo
+}
+
+// ClearFulfilledFee clears the value of the "fulfilled_fee" field.
+func (pruo *ProofRequestUpdateOne) ClearFulfilledFee() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFulfilledFee()
+	return pruo
+}
+
+// SetProver sets the "prover" field.
+func (pruo *ProofRequestUpdateOne) SetProver(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProver(s)
+	return pruo
+}
+
+// SetNillableProver sets the "prover" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProver(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProver(*s)
+	}
+	return pruo
+}
+
+// ClearProver clears the value of the "prover" field.
+func (pruo *ProofRequestUpdateOne) ClearProver() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProver()
+	return pruo
+}
+
+// SetFulfilledTime sets the "fulfilled_time" field.
+func (pruo *ProofRequestUpdateOne) SetFulfilledTime(u uint64) *ProofRequestUpdateOne {
+	pruo.mutation.ResetFulfilledTime()
+	pruo.mutation.SetFulfilledTime(u)
+	return pruo
+}
+
+// SetNillableFulfilledTime sets the "fulfilled_time" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFulfilledTime(u *uint64) *ProofRequestUpdateOne {
+	if u != nil {
+		pruo.SetFulfilledTime(*u)
+	}
+	return pruo
+}
+
+// AddFulfilledTime adds u to the "fulfilled_time" field.
+func (pruo *ProofRequestUpdateOne) AddFulfilledTime(u int64) *ProofRequestUpdateOne {
+	pruo.mutation.AddFulfilledTime(u)
+	return pruo
+}
+
+// ClearFulfilledTime clears the value of the "fulfilled_time" field.
+func (pruo *ProofRequestUpdateOne) ClearFulfilledTime() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFulfilledTime()
+	return pruo
+}
+
+// SetProgramVersion sets the "program_version" field.
+func (pruo *ProofRequestUpdateOne) SetProgramVersion(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetProgramVersion(s)
+	return pruo
+}
+
+// SetNillableProgramVersion sets the "program_version" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableProgramVersion(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetProgramVersion(*s)
+	}
+	return pruo
+}
+
+// ClearProgramVersion clears the value of the "program_version" field.
+func (pruo *ProofRequestUpdateOne) ClearProgramVersion() *ProofRequestUpdateOne {
+	pruo.mutation.ClearProgramVersion()
+	return pruo
+}
+
+// SetPublicValues sets the "public_values" field.
+func (pruo *ProofRequestUpdateOne) SetPublicValues(b []byte) *ProofRequestUpdateOne {
+	pruo.mutation.SetPublicValues(b)
+	return pruo
+}
+
+// ClearPublicValues clears the value of the "public_values" field.
+func (pruo *ProofRequestUpdateOne) ClearPublicValues() *ProofRequestUpdateOne {
+	pruo.mutation.ClearPublicValues()
+	return pruo
+}
+
+// SetErrorCode sets the "error_code" field.
+func (pruo *ProofRequestUpdateOne) SetErrorCode(s string) *ProofRequestUpdateOne {
+	pruo.mutation.SetErrorCode(s)
+	return pruo
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableErrorCode(s *string) *ProofRequestUpdateOne {
+	if s != nil {
+		pruo.SetErrorCode(*s)
+	}
+	return pruo
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (pruo *ProofRequestUpdateOne) ClearErrorCode() *ProofRequestUpdateOne {
+	pruo.mutation.ClearErrorCode()
+	return pruo
+}
+
+// SetFailureStage sets the "failure_stage" field.
+func (pruo *ProofRequestUpdateOne) SetFailureStage(ps proofrequest.FailureStage) *ProofRequestUpdateOne {
+	pruo.mutation.SetFailureStage(ps)
+	return pruo
+}
+
+// SetNillableFailureStage sets the "failure_stage" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFailureStage(ps *proofrequest.FailureStage) *ProofRequestUpdateOne {
+	if ps != nil {
+		pruo.SetFailureStage(*ps)
+	}
+	return pruo
+}
+
+// ClearFailureStage clears the value of the "failure_stage" field.
+func (pruo *ProofRequestUpdateOne) ClearFailureStage() *ProofRequestUpdateOne {
+	pruo.mutation.ClearFailureStage()
+	return pruo
+}
+
+// SetParentID sets the "parent_id" field.
+func (pruo *ProofRequestUpdateOne) SetParentID(i int) *ProofRequestUpdateOne {
+	pruo.mutation.ResetParentID()
+	pruo.mutation.SetParentID(i)
+	return pruo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableParentID(i *int) *ProofRequestUpdateOne {
+	if i != nil {
+		pruo.SetParentID(*i)
+	}
+	return pruo
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (pruo *ProofRequestUpdateOne) AddParentID(i int) *ProofRequestUpdateOne {
+	pruo.mutation.AddParentID(i)
+	return pruo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (pruo *ProofRequestUpdateOne) ClearParentID() *ProofRequestUpdateOne {
+	pruo.mutation.ClearParentID()
+	return pruo
+}
+
+// SetLabels sets the "labels" field.
+func (pruo *ProofRequestUpdateOne) SetLabels(s []string) *ProofRequestUpdateOne {
+	pruo.mutation.SetLabels(s)
+	return pruo
+}
+
+// AppendLabels appends s to the "labels" field.
+func (pruo *ProofRequestUpdateOne) AppendLabels(s []string) *ProofRequestUpdateOne {
+	pruo.mutation.AppendLabels(s)
+	return pruo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (pruo *ProofRequestUpdateOne) ClearLabels() *ProofRequestUpdateOne {
+	pruo.mutation.ClearLabels()
+	return pruo
+}
+
+// SetChallengeStatus sets the "challenge_status" field.
+func (pruo *ProofRequestUpdateOne) SetChallengeStatus(ps proofrequest.ChallengeStatus) *ProofRequestUpdateOne {
+	pruo.mutation.SetChallengeStatus(ps)
+	return pruo
+}
+
+// SetNillableChallengeStatus sets the "challenge_status" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableChallengeStatus(ps *proofrequest.ChallengeStatus) *ProofRequestUpdateOne {
+	if ps != nil {
+		pruo.SetChallengeStatus(*ps)
+	}
+	return pruo
+}
+
+// ClearChallengeStatus clears the value of the "challenge_status" field.
+func (pruo *ProofRequestUpdateOne) ClearChallengeStatus() *ProofRequestUpdateOne {
+	pruo.mutation.ClearChallengeStatus()
+	return pruo
+}
+
+// SetFinal sets the "final" field.
+func (pruo *ProofRequestUpdateOne) SetFinal(b bool) *ProofRequestUpdateOne {
+	pruo.mutation.SetFinal(b)
+	return pruo
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (pruo *ProofRequestUpdateOne) SetNillableFinal(b *bool) *ProofRequestUpdateOne {
+	if b != nil {
+		pruo.SetFinal(*b)
+	}
+	return pruo
+}
+
 // Mutation returns the ProofRequestMutation object of the builder.
 func (pruo *ProofRequestUpdateOne) Mutation() *ProofRequestMutation {
 	return pruo.mutation
@@ -665,6 +1607,16 @@ func (pruo *ProofRequestUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := pruo.mutation.FailureStage(); ok {
+		if err := proofrequest.FailureStageValidator(v); err != nil {
+			return &ValidationError{Name: "failure_stage", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.failure_stage": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.ChallengeStatus(); ok {
+		if err := proofrequest.ChallengeStatusValidator(v); err != nil {
+			return &ValidationError{Name: "challenge_status", err: fmt.Errorf(`ent: validator failed for field "ProofRequest.challenge_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -763,6 +1715,137 @@ func (pruo *ProofRequestUpdateOne) sqlSave(ctx context.Context) (_node *ProofReq
 	if pruo.mutation.ProofCleared() {
 		_spec.ClearField(proofrequest.FieldProof, field.TypeBytes)
 	}
+	if value, ok := pruo.mutation.EstimatedCycles(); ok {
+		_spec.SetField(proofrequest.FieldEstimatedCycles, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedEstimatedCycles(); ok {
+		_spec.AddField(proofrequest.FieldEstimatedCycles, field.TypeUint64, value)
+	}
+	if pruo.mutation.EstimatedCyclesCleared() {
+		_spec.ClearField(proofrequest.FieldEstimatedCycles, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.EstimatedFee(); ok {
+		_spec.SetField(proofrequest.FieldEstimatedFee, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedEstimatedFee(); ok {
+		_spec.AddField(proofrequest.FieldEstimatedFee, field.TypeUint64, value)
+	}
+	if pruo.mutation.EstimatedFeeCleared() {
+		_spec.ClearField(proofrequest.FieldEstimatedFee, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.ProofSystem(); ok {
+		_spec.SetField(proofrequest.FieldProofSystem, field.TypeString, value)
+	}
+	if pruo.mutation.ProofSystemCleared() {
+		_spec.ClearField(proofrequest.FieldProofSystem, field.TypeString)
+	}
+	if value, ok := pruo.mutation.VkeyHash(); ok {
+		_spec.SetField(proofrequest.FieldVkeyHash, field.TypeString, value)
+	}
+	if pruo.mutation.VkeyHashCleared() {
+		_spec.ClearField(proofrequest.FieldVkeyHash, field.TypeString)
+	}
+	if value, ok := pruo.mutation.ProofFormat(); ok {
+		_spec.SetField(proofrequest.FieldProofFormat, field.TypeString, value)
+	}
+	if pruo.mutation.ProofFormatCleared() {
+		_spec.ClearField(proofrequest.FieldProofFormat, field.TypeString)
+	}
+	if value, ok := pruo.mutation.Backfill(); ok {
+		_spec.SetField(proofrequest.FieldBackfill, field.TypeBool, value)
+	}
+	if value, ok := pruo.mutation.FailureReason(); ok {
+		_spec.SetField(proofrequest.FieldFailureReason, field.TypeString, value)
+	}
+	if pruo.mutation.FailureReasonCleared() {
+		_spec.ClearField(proofrequest.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := pruo.mutation.FulfilledCycles(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledCycles, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedFulfilledCycles(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledCycles, field.TypeUint64, value)
+	}
+	if pruo.mutation.FulfilledCyclesCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledCycles, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.FulfilledFee(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledFee, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedFulfilledFee(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledFee, field.TypeUint64, value)
+	}
+	if pruo.mutation.FulfilledFeeCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledFee, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.Prover(); ok {
+		_spec.SetField(proofrequest.FieldProver, field.TypeString, value)
+	}
+	if pruo.mutation.ProverCleared() {
+		_spec.ClearField(proofrequest.FieldProver, field.TypeString)
+	}
+	if value, ok := pruo.mutation.FulfilledTime(); ok {
+		_spec.SetField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if value, ok := pruo.mutation.AddedFulfilledTime(); ok {
+		_spec.AddField(proofrequest.FieldFulfilledTime, field.TypeUint64, value)
+	}
+	if pruo.mutation.FulfilledTimeCleared() {
+		_spec.ClearField(proofrequest.FieldFulfilledTime, field.TypeUint64)
+	}
+	if value, ok := pruo.mutation.ProgramVersion(); ok {
+		_spec.SetField(proofrequest.FieldProgramVersion, field.TypeString, value)
+	}
+	if pruo.mutation.ProgramVersionCleared() {
+		_spec.ClearField(proofrequest.FieldProgramVersion, field.TypeString)
+	}
+	if value, ok := pruo.mutation.PublicValues(); ok {
+		_spec.SetField(proofrequest.FieldPublicValues, field.TypeBytes, value)
+	}
+	if pruo.mutation.PublicValuesCleared() {
+		_spec.ClearField(proofrequest.FieldPublicValues, field.TypeBytes)
+	}
+	if value, ok := pruo.mutation.ErrorCode(); ok {
+		_spec.SetField(proofrequest.FieldErrorCode, field.TypeString, value)
+	}
+	if pruo.mutation.ErrorCodeCleared() {
+		_spec.ClearField(proofrequest.FieldErrorCode, field.TypeString)
+	}
+	if value, ok := pruo.mutation.FailureStage(); ok {
+		_spec.SetField(proofrequest.FieldFailureStage, field.TypeEnum, value)
+	}
+	if pruo.mutation.FailureStageCleared() {
+		_spec.ClearField(proofrequest.FieldFailureStage, field.TypeEnum)
+	}
+	if value, ok := pruo.mutation.ParentID(); ok {
+		_spec.SetField(proofrequest.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedParentID(); ok {
+		_spec.AddField(proofrequest.FieldParentID, field.TypeInt, value)
+	}
+	if pruo.mutation.ParentIDCleared() {
+		_spec.ClearField(proofrequest.FieldParentID, field.TypeInt)
+	}
+	if value, ok := pruo.mutation.Labels(); ok {
+		_spec.SetField(proofrequest.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := pruo.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, proofrequest.FieldLabels, value)
+		})
+	}
+	if pruo.mutation.LabelsCleared() {
+		_spec.ClearField(proofrequest.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := pruo.mutation.ChallengeStatus(); ok {
+		_spec.SetField(proofrequest.FieldChallengeStatus, field.TypeEnum, value)
+	}
+	if pruo.mutation.ChallengeStatusCleared() {
+		_spec.ClearField(proofrequest.FieldChallengeStatus, field.TypeEnum)
+	}
+	if value, ok := pruo.mutation.Final(); ok {
+		_spec.SetField(proofrequest.FieldFinal, field.TypeBool, value)
+	}
 	_node = &ProofRequest{config: pruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// ProofStat is the model entity for the ProofStat schema.
+type ProofStat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Period holds the value of the "period" field.
+	Period proofstat.Period `json:"period,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart uint64 `json:"period_start,omitempty"`
+	// Requested holds the value of the "requested" field.
+	Requested int `json:"requested,omitempty"`
+	// Fulfilled holds the value of the "fulfilled" field.
+	Fulfilled int `json:"fulfilled,omitempty"`
+	// Failed holds the value of the "failed" field.
+	Failed int `json:"failed,omitempty"`
+	// BlocksProven holds the value of the "blocks_proven" field.
+	BlocksProven uint64 `json:"blocks_proven,omitempty"`
+	// AverageLatency holds the value of the "average_latency" field.
+	AverageLatency float64 `json:"average_latency,omitempty"`
+	// Spend holds the value of the "spend" field.
+	Spend        uint64 `json:"spend,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProofStat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case proofstat.FieldAverageLatency:
+			values[i] = new(sql.NullFloat64)
+		case proofstat.FieldID, proofstat.FieldPeriodStart, proofstat.FieldRequested, proofstat.FieldFulfilled, proofstat.FieldFailed, proofstat.FieldBlocksProven, proofstat.FieldSpend:
+			values[i] = new(sql.NullInt64)
+		case proofstat.FieldPeriod:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProofStat fields.
+func (ps *ProofStat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case proofstat.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ps.ID = int(value.Int64)
+		case proofstat.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				ps.Period = proofstat.Period(value.String)
+			}
+		case proofstat.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				ps.PeriodStart = uint64(value.Int64)
+			}
+		case proofstat.FieldRequested:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requested", values[i])
+			} else if value.Valid {
+				ps.Requested = int(value.Int64)
+			}
+		case proofstat.FieldFulfilled:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled", values[i])
+			} else if value.Valid {
+				ps.Fulfilled = int(value.Int64)
+			}
+		case proofstat.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				ps.Failed = int(value.Int64)
+			}
+		case proofstat.FieldBlocksProven:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blocks_proven", values[i])
+			} else if value.Valid {
+				ps.BlocksProven = uint64(value.Int64)
+			}
+		case proofstat.FieldAverageLatency:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field average_latency", values[i])
+			} else if value.Valid {
+				ps.AverageLatency = value.Float64
+			}
+		case proofstat.FieldSpend:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spend", values[i])
+			} else if value.Valid {
+				ps.Spend = uint64(value.Int64)
+			}
+		default:
+			ps.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProofStat.
+// This includes values selected through modifiers, order, etc.
+func (ps *ProofStat) Value(name string) (ent.Value, error) {
+	return ps.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProofStat.
+// Note that you need to call ProofStat.Unwrap() before calling this method if this ProofStat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ps *ProofStat) Update() *ProofStatUpdateOne {
+	return NewProofStatClient(ps.config).UpdateOne(ps)
+}
+
+// Unwrap unwraps the ProofStat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ps *ProofStat) Unwrap() *ProofStat {
+	_tx, ok := ps.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProofStat is not a transactional entity")
+	}
+	ps.config.driver = _tx.drv
+	return ps
+}
+
+// String implements the fmt.Stringer.
+func (ps *ProofStat) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProofStat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ps.ID))
+	builder.WriteString("period=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Period))
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(fmt.Sprintf("%v", ps.PeriodStart))
+	builder.WriteString(", ")
+	builder.WriteString("requested=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Requested))
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Fulfilled))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("blocks_proven=")
+	builder.WriteString(fmt.Sprintf("%v", ps.BlocksProven))
+	builder.WriteString(", ")
+	builder.WriteString("average_latency=")
+	builder.WriteString(fmt.Sprintf("%v", ps.AverageLatency))
+	builder.WriteString(", ")
+	builder.WriteString("spend=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Spend))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProofStats is a parsable slice of ProofStat.
+type ProofStats []*ProofStat
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proofstat
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the proofstat type in the database.
+	Label = "proof_stat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldRequested holds the string denoting the requested field in the database.
+	FieldRequested = "requested"
+	// FieldFulfilled holds the string denoting the fulfilled field in the database.
+	FieldFulfilled = "fulfilled"
+	// FieldFailed holds the string denoting the failed field in the database.
+	FieldFailed = "failed"
+	// FieldBlocksProven holds the string denoting the blocks_proven field in the database.
+	FieldBlocksProven = "blocks_proven"
+	// FieldAverageLatency holds the string denoting the average_latency field in the database.
+	FieldAverageLatency = "average_latency"
+	// FieldSpend holds the string denoting the spend field in the database.
+	FieldSpend = "spend"
+	// Table holds the table name of the proofstat in the database.
+	Table = "proof_stats"
+)
+
+// Columns holds all SQL columns for proofstat fields.
+var Columns = []string{
+	FieldID,
+	FieldPeriod,
+	FieldPeriodStart,
+	FieldRequested,
+	FieldFulfilled,
+	FieldFailed,
+	FieldBlocksProven,
+	FieldAverageLatency,
+	FieldSpend,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Period defines the type for the "period" enum field.
+type Period string
+
+// Period values.
+const (
+	PeriodHOUR Period = "HOUR"
+	PeriodDAY  Period = "DAY"
+)
+
+func (pe Period) String() string {
+	return string(pe)
+}
+
+// PeriodValidator is a validator for the "period" field enum values. It is called by the builders before save.
+func PeriodValidator(pe Period) error {
+	switch pe {
+	case PeriodHOUR, PeriodDAY:
+		return nil
+	default:
+		return fmt.Errorf("proofstat: invalid enum value for period field: %q", pe)
+	}
+}
+
+// OrderOption defines the ordering options for the ProofStat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByRequested orders the results by the requested field.
+func ByRequested(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequested, opts...).ToFunc()
+}
+
+// ByFulfilled orders the results by the fulfilled field.
+func ByFulfilled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilled, opts...).ToFunc()
+}
+
+// ByFailed orders the results by the failed field.
+func ByFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailed, opts...).ToFunc()
+}
+
+// ByBlocksProven orders the results by the blocks_proven field.
+func ByBlocksProven(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlocksProven, opts...).ToFunc()
+}
+
+// ByAverageLatency orders the results by the average_latency field.
+func ByAverageLatency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAverageLatency, opts...).ToFunc()
+}
+
+// BySpend orders the results by the spend field.
+func BySpend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpend, opts...).ToFunc()
+}
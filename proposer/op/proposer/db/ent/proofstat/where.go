@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package proofstat
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldID, id))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// Requested applies equality check predicate on the "requested" field. It's identical to RequestedEQ.
+func Requested(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldRequested, v))
+}
+
+// Fulfilled applies equality check predicate on the "fulfilled" field. It's identical to FulfilledEQ.
+func Fulfilled(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldFulfilled, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldFailed, v))
+}
+
+// BlocksProven applies equality check predicate on the "blocks_proven" field. It's identical to BlocksProvenEQ.
+func BlocksProven(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldBlocksProven, v))
+}
+
+// AverageLatency applies equality check predicate on the "average_latency" field. It's identical to AverageLatencyEQ.
+func AverageLatency(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldAverageLatency, v))
+}
+
+// Spend applies equality check predicate on the "spend" field. It's identical to SpendEQ.
+func Spend(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldSpend, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v Period) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v Period) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...Period) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...Period) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// RequestedEQ applies the EQ predicate on the "requested" field.
+func RequestedEQ(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldRequested, v))
+}
+
+// RequestedNEQ applies the NEQ predicate on the "requested" field.
+func RequestedNEQ(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldRequested, v))
+}
+
+// RequestedIn applies the In predicate on the "requested" field.
+func RequestedIn(vs ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldRequested, vs...))
+}
+
+// RequestedNotIn applies the NotIn predicate on the "requested" field.
+func RequestedNotIn(vs ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldRequested, vs...))
+}
+
+// RequestedGT applies the GT predicate on the "requested" field.
+func RequestedGT(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldRequested, v))
+}
+
+// RequestedGTE applies the GTE predicate on the "requested" field.
+func RequestedGTE(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldRequested, v))
+}
+
+// RequestedLT applies the LT predicate on the "requested" field.
+func RequestedLT(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldRequested, v))
+}
+
+// RequestedLTE applies the LTE predicate on the "requested" field.
+func RequestedLTE(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldRequested, v))
+}
+
+// FulfilledEQ applies the EQ predicate on the "fulfilled" field.
+func FulfilledEQ(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldFulfilled, v))
+}
+
+// FulfilledNEQ applies the NEQ predicate on the "fulfilled" field.
+func FulfilledNEQ(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldFulfilled, v))
+}
+
+// FulfilledIn applies the In predicate on the "fulfilled" field.
+func FulfilledIn(vs ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldFulfilled, vs...))
+}
+
+// FulfilledNotIn applies the NotIn predicate on the "fulfilled" field.
+func FulfilledNotIn(vs ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldFulfilled, vs...))
+}
+
+// FulfilledGT applies the GT predicate on the "fulfilled" field.
+func FulfilledGT(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldFulfilled, v))
+}
+
+// FulfilledGTE applies the GTE predicate on the "fulfilled" field.
+func FulfilledGTE(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldFulfilled, v))
+}
+
+// FulfilledLT applies the LT predicate on the "fulfilled" field.
+func FulfilledLT(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldFulfilled, v))
+}
+
+// FulfilledLTE applies the LTE predicate on the "fulfilled" field.
+func FulfilledLTE(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldFulfilled, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldFailed, v))
+}
+
+// BlocksProvenEQ applies the EQ predicate on the "blocks_proven" field.
+func BlocksProvenEQ(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldBlocksProven, v))
+}
+
+// BlocksProvenNEQ applies the NEQ predicate on the "blocks_proven" field.
+func BlocksProvenNEQ(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldBlocksProven, v))
+}
+
+// BlocksProvenIn applies the In predicate on the "blocks_proven" field.
+func BlocksProvenIn(vs ...uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldBlocksProven, vs...))
+}
+
+// BlocksProvenNotIn applies the NotIn predicate on the "blocks_proven" field.
+func BlocksProvenNotIn(vs ...uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldBlocksProven, vs...))
+}
+
+// BlocksProvenGT applies the GT predicate on the "blocks_proven" field.
+func BlocksProvenGT(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldBlocksProven, v))
+}
+
+// BlocksProvenGTE applies the GTE predicate on the "blocks_proven" field.
+func BlocksProvenGTE(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldBlocksProven, v))
+}
+
+// BlocksProvenLT applies the LT predicate on the "blocks_proven" field.
+func BlocksProvenLT(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldBlocksProven, v))
+}
+
+// BlocksProvenLTE applies the LTE predicate on the "blocks_proven" field.
+func BlocksProvenLTE(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldBlocksProven, v))
+}
+
+// AverageLatencyEQ applies the EQ predicate on the "average_latency" field.
+func AverageLatencyEQ(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldAverageLatency, v))
+}
+
+// AverageLatencyNEQ applies the NEQ predicate on the "average_latency" field.
+func AverageLatencyNEQ(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldAverageLatency, v))
+}
+
+// AverageLatencyIn applies the In predicate on the "average_latency" field.
+func AverageLatencyIn(vs ...float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldAverageLatency, vs...))
+}
+
+// AverageLatencyNotIn applies the NotIn predicate on the "average_latency" field.
+func AverageLatencyNotIn(vs ...float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldAverageLatency, vs...))
+}
+
+// AverageLatencyGT applies the GT predicate on the "average_latency" field.
+func AverageLatencyGT(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldAverageLatency, v))
+}
+
+// AverageLatencyGTE applies the GTE predicate on the "average_latency" field.
+func AverageLatencyGTE(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldAverageLatency, v))
+}
+
+// AverageLatencyLT applies the LT predicate on the "average_latency" field.
+func AverageLatencyLT(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldAverageLatency, v))
+}
+
+// AverageLatencyLTE applies the LTE predicate on the "average_latency" field.
+func AverageLatencyLTE(v float64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldAverageLatency, v))
+}
+
+// SpendEQ applies the EQ predicate on the "spend" field.
+func SpendEQ(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldEQ(FieldSpend, v))
+}
+
+// SpendNEQ applies the NEQ predicate on the "spend" field.
+func SpendNEQ(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNEQ(FieldSpend, v))
+}
+
+// SpendIn applies the In predicate on the "spend" field.
+func SpendIn(vs ...uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldIn(FieldSpend, vs...))
+}
+
+// SpendNotIn applies the NotIn predicate on the "spend" field.
+func SpendNotIn(vs ...uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldNotIn(FieldSpend, vs...))
+}
+
+// SpendGT applies the GT predicate on the "spend" field.
+func SpendGT(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGT(FieldSpend, v))
+}
+
+// SpendGTE applies the GTE predicate on the "spend" field.
+func SpendGTE(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldGTE(FieldSpend, v))
+}
+
+// SpendLT applies the LT predicate on the "spend" field.
+func SpendLT(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLT(FieldSpend, v))
+}
+
+// SpendLTE applies the LTE predicate on the "spend" field.
+func SpendLTE(v uint64) predicate.ProofStat {
+	return predicate.ProofStat(sql.FieldLTE(FieldSpend, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProofStat) predicate.ProofStat {
+	return predicate.ProofStat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProofStat) predicate.ProofStat {
+	return predicate.ProofStat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProofStat) predicate.ProofStat {
+	return predicate.ProofStat(sql.NotPredicates(p))
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// ProofStatCreate is the builder for creating a ProofStat entity.
+type ProofStatCreate struct {
+	config
+	mutation *ProofStatMutation
+	hooks    []Hook
+}
+
+// SetPeriod sets the "period" field.
+func (psc *ProofStatCreate) SetPeriod(pr proofstat.Period) *ProofStatCreate {
+	psc.mutation.SetPeriod(pr)
+	return psc
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (psc *ProofStatCreate) SetPeriodStart(u uint64) *ProofStatCreate {
+	psc.mutation.SetPeriodStart(u)
+	return psc
+}
+
+// SetRequested sets the "requested" field.
+func (psc *ProofStatCreate) SetRequested(i int) *ProofStatCreate {
+	psc.mutation.SetRequested(i)
+	return psc
+}
+
+// SetFulfilled sets the "fulfilled" field.
+func (psc *ProofStatCreate) SetFulfilled(i int) *ProofStatCreate {
+	psc.mutation.SetFulfilled(i)
+	return psc
+}
+
+// SetFailed sets the "failed" field.
+func (psc *ProofStatCreate) SetFailed(i int) *ProofStatCreate {
+	psc.mutation.SetFailed(i)
+	return psc
+}
+
+// SetBlocksProven sets the "blocks_proven" field.
+func (psc *ProofStatCreate) SetBlocksProven(u uint64) *ProofStatCreate {
+	psc.mutation.SetBlocksProven(u)
+	return psc
+}
+
+// SetAverageLatency sets the "average_latency" field.
+func (psc *ProofStatCreate) SetAverageLatency(f float64) *ProofStatCreate {
+	psc.mutation.SetAverageLatency(f)
+	return psc
+}
+
+// SetSpend sets the "spend" field.
+func (psc *ProofStatCreate) SetSpend(u uint64) *ProofStatCreate {
+	psc.mutation.SetSpend(u)
+	return psc
+}
+
+// Mutation returns the ProofStatMutation object of the builder.
+func (psc *ProofStatCreate) Mutation() *ProofStatMutation {
+	return psc.mutation
+}
+
+// Save creates the ProofStat in the database.
+func (psc *ProofStatCreate) Save(ctx context.Context) (*ProofStat, error) {
+	return withHooks(ctx, psc.sqlSave, psc.mutation, psc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psc *ProofStatCreate) SaveX(ctx context.Context) *ProofStat {
+	v, err := psc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psc *ProofStatCreate) Exec(ctx context.Context) error {
+	_, err := psc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psc *ProofStatCreate) ExecX(ctx context.Context) {
+	if err := psc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psc *ProofStatCreate) check() error {
+	if _, ok := psc.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "ProofStat.period"`)}
+	}
+	if v, ok := psc.mutation.Period(); ok {
+		if err := proofstat.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "ProofStat.period": %w`, err)}
+		}
+	}
+	if _, ok := psc.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "ProofStat.period_start"`)}
+	}
+	if _, ok := psc.mutation.Requested(); !ok {
+		return &ValidationError{Name: "requested", err: errors.New(`ent: missing required field "ProofStat.requested"`)}
+	}
+	if _, ok := psc.mutation.Fulfilled(); !ok {
+		return &ValidationError{Name: "fulfilled", err: errors.New(`ent: missing required field "ProofStat.fulfilled"`)}
+	}
+	if _, ok := psc.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "ProofStat.failed"`)}
+	}
+	if _, ok := psc.mutation.BlocksProven(); !ok {
+		return &ValidationError{Name: "blocks_proven", err: errors.New(`ent: missing required field "ProofStat.blocks_proven"`)}
+	}
+	if _, ok := psc.mutation.AverageLatency(); !ok {
+		return &ValidationError{Name: "average_latency", err: errors.New(`ent: missing required field "ProofStat.average_latency"`)}
+	}
+	if _, ok := psc.mutation.Spend(); !ok {
+		return &ValidationError{Name: "spend", err: errors.New(`ent: missing required field "ProofStat.spend"`)}
+	}
+	return nil
+}
+
+func (psc *ProofStatCreate) sqlSave(ctx context.Context) (*ProofStat, error) {
+	if err := psc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := psc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	psc.mutation.id = &_node.ID
+	psc.mutation.done = true
+	return _node, nil
+}
+
+func (psc *ProofStatCreate) createSpec() (*ProofStat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProofStat{config: psc.config}
+		_spec = sqlgraph.NewCreateSpec(proofstat.Table, sqlgraph.NewFieldSpec(proofstat.FieldID, field.TypeInt))
+	)
+	if value, ok := psc.mutation.Period(); ok {
+		_spec.SetField(proofstat.FieldPeriod, field.TypeEnum, value)
+		_node.Period = value
+	}
+	if value, ok := psc.mutation.PeriodStart(); ok {
+		_spec.SetField(proofstat.FieldPeriodStart, field.TypeUint64, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := psc.mutation.Requested(); ok {
+		_spec.SetField(proofstat.FieldRequested, field.TypeInt, value)
+		_node.Requested = value
+	}
+	if value, ok := psc.mutation.Fulfilled(); ok {
+		_spec.SetField(proofstat.FieldFulfilled, field.TypeInt, value)
+		_node.Fulfilled = value
+	}
+	if value, ok := psc.mutation.Failed(); ok {
+		_spec.SetField(proofstat.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := psc.mutation.BlocksProven(); ok {
+		_spec.SetField(proofstat.FieldBlocksProven, field.TypeUint64, value)
+		_node.BlocksProven = value
+	}
+	if value, ok := psc.mutation.AverageLatency(); ok {
+		_spec.SetField(proofstat.FieldAverageLatency, field.TypeFloat64, value)
+		_node.AverageLatency = value
+	}
+	if value, ok := psc.mutation.Spend(); ok {
+		_spec.SetField(proofstat.FieldSpend, field.TypeUint64, value)
+		_node.Spend = value
+	}
+	return _node, _spec
+}
+
+// ProofStatCreateBulk is the builder for creating many ProofStat entities in bulk.
+type ProofStatCreateBulk struct {
+	config
+	err      error
+	builders []*ProofStatCreate
+}
+
+// Save creates the ProofStat entities in the database.
+func (pscb *ProofStatCreateBulk) Save(ctx context.Context) ([]*ProofStat, error) {
+	if pscb.err != nil {
+		return nil, pscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pscb.builders))
+	nodes := make([]*ProofStat, len(pscb.builders))
+	mutators := make([]Mutator, len(pscb.builders))
+	for i := range pscb.builders {
+		func(i int, root context.Context) {
+			builder := pscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProofStatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pscb *ProofStatCreateBulk) SaveX(ctx context.Context) []*ProofStat {
+	v, err := pscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pscb *ProofStatCreateBulk) Exec(ctx context.Context) error {
+	_, err := pscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pscb *ProofStatCreateBulk) ExecX(ctx context.Context) {
+	if err := pscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// ProofStatDelete is the builder for deleting a ProofStat entity.
+type ProofStatDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProofStatMutation
+}
+
+// Where appends a list predicates to the ProofStatDelete builder.
+func (psd *ProofStatDelete) Where(ps ...predicate.ProofStat) *ProofStatDelete {
+	psd.mutation.Where(ps...)
+	return psd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psd *ProofStatDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, psd.sqlExec, psd.mutation, psd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psd *ProofStatDelete) ExecX(ctx context.Context) int {
+	n, err := psd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psd *ProofStatDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(proofstat.Table, sqlgraph.NewFieldSpec(proofstat.FieldID, field.TypeInt))
+	if ps := psd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	psd.mutation.done = true
+	return affected, err
+}
+
+// ProofStatDeleteOne is the builder for deleting a single ProofStat entity.
+type ProofStatDeleteOne struct {
+	psd *ProofStatDelete
+}
+
+// Where appends a list predicates to the ProofStatDelete builder.
+func (psdo *ProofStatDeleteOne) Where(ps ...predicate.ProofStat) *ProofStatDeleteOne {
+	psdo.psd.mutation.Where(ps...)
+	return psdo
+}
+
+// Exec executes the deletion query.
+func (psdo *ProofStatDeleteOne) Exec(ctx context.Context) error {
+	n, err := psdo.psd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{proofstat.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psdo *ProofStatDeleteOne) ExecX(ctx context.Context) {
+	if err := psdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
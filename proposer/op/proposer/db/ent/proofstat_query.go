@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// ProofStatQuery is the builder for querying ProofStat entities.
+type ProofStatQuery struct {
+	config
+	ctx        *QueryContext
+	order      []proofstat.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ProofStat
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProofStatQuery builder.
+func (psq *ProofStatQuery) Where(ps ...predicate.ProofStat) *ProofStatQuery {
+	psq.predicates = append(psq.predicates, ps...)
+	return psq
+}
+
+// Limit the number of records to be returned by this query.
+func (psq *ProofStatQuery) Limit(limit int) *ProofStatQuery {
+	psq.ctx.Limit = &limit
+	return psq
+}
+
+// Offset to start from.
+func (psq *ProofStatQuery) Offset(offset int) *ProofStatQuery {
+	psq.ctx.Offset = &offset
+	return psq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (psq *ProofStatQuery) Unique(unique bool) *ProofStatQuery {
+	psq.ctx.Unique = &unique
+	return psq
+}
+
+// Order specifies how the records should be ordered.
+func (psq *ProofStatQuery) Order(o ...proofstat.OrderOption) *ProofStatQuery {
+	psq.order = append(psq.order, o...)
+	return psq
+}
+
+// First returns the first ProofStat entity from the query.
+// Returns a *NotFoundError when no ProofStat was found.
+func (psq *ProofStatQuery) First(ctx context.Context) (*ProofStat, error) {
+	nodes, err := psq.Limit(1).All(setContextOp(ctx, psq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{proofstat.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (psq *ProofStatQuery) FirstX(ctx context.Context) *ProofStat {
+	node, err := psq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProofStat ID from the query.
+// Returns a *NotFoundError when no ProofStat ID was found.
+func (psq *ProofStatQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = psq.Limit(1).IDs(setContextOp(ctx, psq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{proofstat.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (psq *ProofStatQuery) FirstIDX(ctx context.Context) int {
+	id, err := psq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProofStat entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProofStat entity is found.
+// Returns a *NotFoundError when no ProofStat entities are found.
+func (psq *ProofStatQuery) Only(ctx context.Context) (*ProofStat, error) {
+	nodes, err := psq.Limit(2).All(setContextOp(ctx, psq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{proofstat.Label}
+	default:
+		return nil, &NotSingularError{proofstat.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (psq *ProofStatQuery) OnlyX(ctx context.Context) *ProofStat {
+	node, err := psq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProofStat ID in the query.
+// Returns a *NotSingularError when more than one ProofStat ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (psq *ProofStatQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = psq.Limit(2).IDs(setContextOp(ctx, psq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{proofstat.Label}
+	default:
+		err = &NotSingularError{proofstat.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (psq *ProofStatQuery) OnlyIDX(ctx context.Context) int {
+	id, err := psq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProofStats.
+func (psq *ProofStatQuery) All(ctx context.Context) ([]*ProofStat, error) {
+	ctx = setContextOp(ctx, psq.ctx, "All")
+	if err := psq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProofStat, *ProofStatQuery]()
+	return withInterceptors[[]*ProofStat](ctx, psq, qr, psq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (psq *ProofStatQuery) AllX(ctx context.Context) []*ProofStat {
+	nodes, err := psq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProofStat IDs.
+func (psq *ProofStatQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if psq.ctx.Unique == nil && psq.path != nil {
+		psq.Unique(true)
+	}
+	ctx = setContextOp(ctx, psq.ctx, "IDs")
+	if err = psq.Select(proofstat.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (psq *ProofStatQuery) IDsX(ctx context.Context) []int {
+	ids, err := psq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (psq *ProofStatQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, psq.ctx, "Count")
+	if err := psq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, psq, querierCount[*ProofStatQuery](), psq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (psq *ProofStatQuery) CountX(ctx context.Context) int {
+	count, err := psq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (psq *ProofStatQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, psq.ctx, "Exist")
+	switch _, err := psq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (psq *ProofStatQuery) ExistX(ctx context.Context) bool {
+	exist, err := psq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProofStatQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (psq *ProofStatQuery) Clone() *ProofStatQuery {
+	if psq == nil {
+		return nil
+	}
+	return &ProofStatQuery{
+		config:     psq.config,
+		ctx:        psq.ctx.Clone(),
+		order:      append([]proofstat.OrderOption{}, psq.order...),
+		inters:     append([]Interceptor{}, psq.inters...),
+		predicates: append([]predicate.ProofStat{}, psq.predicates...),
+		// clone intermediate query.
+		sql:  psq.sql.Clone(),
+		path: psq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Period proofstat.Period `json:"period,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProofStat.Query().
+//		GroupBy(proofstat.FieldPeriod).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (psq *ProofStatQuery) GroupBy(field string, fields ...string) *ProofStatGroupBy {
+	psq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProofStatGroupBy{build: psq}
+	grbuild.flds = &psq.ctx.Fields
+	grbuild.label = proofstat.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Period proofstat.Period `json:"period,omitempty"`
+//	}
+//
+//	client.ProofStat.Query().
+//		Select(proofstat.FieldPeriod).
+//		Scan(ctx, &v)
+func (psq *ProofStatQuery) Select(fields ...string) *ProofStatSelect {
+	psq.ctx.Fields = append(psq.ctx.Fields, fields...)
+	sbuild := &ProofStatSelect{ProofStatQuery: psq}
+	sbuild.label = proofstat.Label
+	sbuild.flds, sbuild.scan = &psq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProofStatSelect configured with the given aggregations.
+func (psq *ProofStatQuery) Aggregate(fns ...AggregateFunc) *ProofStatSelect {
+	return psq.Select().Aggregate(fns...)
+}
+
+func (psq *ProofStatQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range psq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, psq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range psq.ctx.Fields {
+		if !proofstat.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if psq.path != nil {
+		prev, err := psq.path(ctx)
+		if err != nil {
+			return err
+		}
+		psq.sql = prev
+	}
+	return nil
+}
+
+func (psq *ProofStatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProofStat, error) {
+	var (
+		nodes = []*ProofStat{}
+		_spec = psq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProofStat).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProofStat{config: psq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, psq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (psq *ProofStatQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := psq.querySpec()
+	_spec.Node.Columns = psq.ctx.Fields
+	if len(psq.ctx.Fields) > 0 {
+		_spec.Unique = psq.ctx.Unique != nil && *psq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, psq.driver, _spec)
+}
+
+func (psq *ProofStatQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(proofstat.Table, proofstat.Columns, sqlgraph.NewFieldSpec(proofstat.FieldID, field.TypeInt))
+	_spec.From = psq.sql
+	if unique := psq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if psq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := psq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proofstat.FieldID)
+		for i := range fields {
+			if fields[i] != proofstat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := psq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := psq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (psq *ProofStatQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(psq.driver.Dialect())
+	t1 := builder.Table(proofstat.Table)
+	columns := psq.ctx.Fields
+	if len(columns) == 0 {
+		columns = proofstat.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if psq.sql != nil {
+		selector = psq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if psq.ctx.Unique != nil && *psq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range psq.predicates {
+		p(selector)
+	}
+	for _, p := range psq.order {
+		p(selector)
+	}
+	if offset := psq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := psq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProofStatGroupBy is the group-by builder for ProofStat entities.
+type ProofStatGroupBy struct {
+	selector
+	build *ProofStatQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psgb *ProofStatGroupBy) Aggregate(fns ...AggregateFunc) *ProofStatGroupBy {
+	psgb.fns = append(psgb.fns, fns...)
+	return psgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (psgb *ProofStatGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, psgb.build.ctx, "GroupBy")
+	if err := psgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProofStatQuery, *ProofStatGroupBy](ctx, psgb.build, psgb, psgb.build.inters, v)
+}
+
+func (psgb *ProofStatGroupBy) sqlScan(ctx context.Context, root *ProofStatQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(psgb.fns))
+	for _, fn := range psgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*psgb.flds)+len(psgb.fns))
+		for _, f := range *psgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*psgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProofStatSelect is the builder for selecting fields of ProofStat entities.
+type ProofStatSelect struct {
+	*ProofStatQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pss *ProofStatSelect) Aggregate(fns ...AggregateFunc) *ProofStatSelect {
+	pss.fns = append(pss.fns, fns...)
+	return pss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pss *ProofStatSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pss.ctx, "Select")
+	if err := pss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProofStatQuery, *ProofStatSelect](ctx, pss.ProofStatQuery, pss, pss.inters, v)
+}
+
+func (pss *ProofStatSelect) sqlScan(ctx context.Context, root *ProofStatQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pss.fns))
+	for _, fn := range pss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
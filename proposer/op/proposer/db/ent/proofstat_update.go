@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/predicate"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// ProofStatUpdate is the builder for updating ProofStat entities.
+type ProofStatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProofStatMutation
+}
+
+// Where appends a list predicates to the ProofStatUpdate builder.
+func (psu *ProofStatUpdate) Where(ps ...predicate.ProofStat) *ProofStatUpdate {
+	psu.mutation.Where(ps...)
+	return psu
+}
+
+// SetPeriod sets the "period" field.
+func (psu *ProofStatUpdate) SetPeriod(pr proofstat.Period) *ProofStatUpdate {
+	psu.mutation.SetPeriod(pr)
+	return psu
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillablePeriod(pr *proofstat.Period) *ProofStatUpdate {
+	if pr != nil {
+		psu.SetPeriod(*pr)
+	}
+	return psu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (psu *ProofStatUpdate) SetPeriodStart(u uint64) *ProofStatUpdate {
+	psu.mutation.ResetPeriodStart()
+	psu.mutation.SetPeriodStart(u)
+	return psu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillablePeriodStart(u *uint64) *ProofStatUpdate {
+	if u != nil {
+		psu.SetPeriodStart(*u)
+	}
+	return psu
+}
+
+// AddPeriodStart adds u to the "period_start" field.
+func (psu *ProofStatUpdate) AddPeriodStart(u int64) *ProofStatUpdate {
+	psu.mutation.AddPeriodStart(u)
+	return psu
+}
+
+// SetRequested sets the "requested" field.
+func (psu *ProofStatUpdate) SetRequested(i int) *ProofStatUpdate {
+	psu.mutation.ResetRequested()
+	psu.mutation.SetRequested(i)
+	return psu
+}
+
+// SetNillableRequested sets the "requested" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillableRequested(i *int) *ProofStatUpdate {
+	if i != nil {
+		psu.SetRequested(*i)
+	}
+	return psu
+}
+
+// AddRequested adds i to the "requested" field.
+func (psu *ProofStatUpdate) AddRequested(i int) *ProofStatUpdate {
+	psu.mutation.AddRequested(i)
+	return psu
+}
+
+// SetFulfilled sets the "fulfilled" field.
+func (psu *ProofStatUpdate) SetFulfilled(i int) *ProofStatUpdate {
+	psu.mutation.ResetFulfilled()
+	psu.mutation.SetFulfilled(i)
+	return psu
+}
+
+// SetNillableFulfilled sets the "fulfilled" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillableFulfilled(i *int) *ProofStatUpdate {
+	if i != nil {
+		psu.SetFulfilled(*i)
+	}
+	return psu
+}
+
+// AddFulfilled adds i to the "fulfilled" field.
+func (psu *ProofStatUpdate) AddFulfilled(i int) *ProofStatUpdate {
+	psu.mutation.AddFulfilled(i)
+	return psu
+}
+
+// SetFailed sets the "failed" field.
+func (psu *ProofStatUpdate) SetFailed(i int) *ProofStatUpdate {
+	psu.mutation.ResetFailed()
+	psu.mutation.SetFailed(i)
+	return psu
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillableFailed(i *int) *ProofStatUpdate {
+	if i != nil {
+		psu.SetFailed(*i)
+	}
+	return psu
+}
+
+// AddFailed adds i to the "failed" field.
+func (psu *ProofStatUpdate) AddFailed(i int) *ProofStatUpdate {
+	psu.mutation.AddFailed(i)
+	return psu
+}
+
+// SetBlocksProven sets the "blocks_proven" field.
+func (psu *ProofStatUpdate) SetBlocksProven(u uint64) *ProofStatUpdate {
+	psu.mutation.ResetBlocksProven()
+	psu.mutation.SetBlocksProven(u)
+	return psu
+}
+
+// SetNillableBlocksProven sets the "blocks_proven" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillableBlocksProven(u *uint64) *ProofStatUpdate {
+	if u != nil {
+		psu.SetBlocksProven(*u)
+	}
+	return psu
+}
+
+// AddBlocksProven adds u to the "blocks_proven" field.
+func (psu *ProofStatUpdate) AddBlocksProven(u int64) *ProofStatUpdate {
+	psu.mutation.AddBlocksProven(u)
+	return psu
+}
+
+// SetAverageLatency sets the "average_latency" field.
+func (psu *ProofStatUpdate) SetAverageLatency(f float64) *ProofStatUpdate {
+	psu.mutation.ResetAverageLatency()
+	psu.mutation.SetAverageLatency(f)
+	return psu
+}
+
+// SetNillableAverageLatency sets the "average_latency" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillableAverageLatency(f *float64) *ProofStatUpdate {
+	if f != nil {
+		psu.SetAverageLatency(*f)
+	}
+	return psu
+}
+
+// AddAverageLatency adds f to the "average_latency" field.
+func (psu *ProofStatUpdate) AddAverageLatency(f float64) *ProofStatUpdate {
+	psu.mutation.AddAverageLatency(f)
+	return psu
+}
+
+// SetSpend sets the "spend" field.
+func (psu *ProofStatUpdate) SetSpend(u uint64) *ProofStatUpdate {
+	psu.mutation.ResetSpend()
+	psu.mutation.SetSpend(u)
+	return psu
+}
+
+// SetNillableSpend sets the "spend" field if the given value is not nil.
+func (psu *ProofStatUpdate) SetNillableSpend(u *uint64) *ProofStatUpdate {
+	if u != nil {
+		psu.SetSpend(*u)
+	}
+	return psu
+}
+
+// AddSpend adds u to the "spend" field.
+func (psu *ProofStatUpdate) AddSpend(u int64) *ProofStatUpdate {
+	psu.mutation.AddSpend(u)
+	return psu
+}
+
+// Mutation returns the ProofStatMutation object of the builder.
+func (psu *ProofStatUpdate) Mutation() *ProofStatMutation {
+	return psu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psu *ProofStatUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, psu.sqlSave, psu.mutation, psu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psu *ProofStatUpdate) SaveX(ctx context.Context) int {
+	affected, err := psu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psu *ProofStatUpdate) Exec(ctx context.Context) error {
+	_, err := psu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psu *ProofStatUpdate) ExecX(ctx context.Context) {
+	if err := psu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psu *ProofStatUpdate) check() error {
+	if v, ok := psu.mutation.Period(); ok {
+		if err := proofstat.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "ProofStat.period": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (psu *ProofStatUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := psu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proofstat.Table, proofstat.Columns, sqlgraph.NewFieldSpec(proofstat.FieldID, field.TypeInt))
+	if ps := psu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psu.mutation.Period(); ok {
+		_spec.SetField(proofstat.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := psu.mutation.PeriodStart(); ok {
+		_spec.SetField(proofstat.FieldPeriodStart, field.TypeUint64, value)
+	}
+	if value, ok := psu.mutation.AddedPeriodStart(); ok {
+		_spec.AddField(proofstat.FieldPeriodStart, field.TypeUint64, value)
+	}
+	if value, ok := psu.mutation.Requested(); ok {
+		_spec.SetField(proofstat.FieldRequested, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedRequested(); ok {
+		_spec.AddField(proofstat.FieldRequested, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.Fulfilled(); ok {
+		_spec.SetField(proofstat.FieldFulfilled, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedFulfilled(); ok {
+		_spec.AddField(proofstat.FieldFulfilled, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.Failed(); ok {
+		_spec.SetField(proofstat.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedFailed(); ok {
+		_spec.AddField(proofstat.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.BlocksProven(); ok {
+		_spec.SetField(proofstat.FieldBlocksProven, field.TypeUint64, value)
+	}
+	if value, ok := psu.mutation.AddedBlocksProven(); ok {
+		_spec.AddField(proofstat.FieldBlocksProven, field.TypeUint64, value)
+	}
+	if value, ok := psu.mutation.AverageLatency(); ok {
+		_spec.SetField(proofstat.FieldAverageLatency, field.TypeFloat64, value)
+	}
+	if value, ok := psu.mutation.AddedAverageLatency(); ok {
+		_spec.AddField(proofstat.FieldAverageLatency, field.TypeFloat64, value)
+	}
+	if value, ok := psu.mutation.Spend(); ok {
+		_spec.SetField(proofstat.FieldSpend, field.TypeUint64, value)
+	}
+	if value, ok := psu.mutation.AddedSpend(); ok {
+		_spec.AddField(proofstat.FieldSpend, field.TypeUint64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proofstat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	psu.mutation.done = true
+	return n, nil
+}
+
+// ProofStatUpdateOne is the builder for updating a single ProofStat entity.
+type ProofStatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProofStatMutation
+}
+
+// SetPeriod sets the "period" field.
+func (psuo *ProofStatUpdateOne) SetPeriod(pr proofstat.Period) *ProofStatUpdateOne {
+	psuo.mutation.SetPeriod(pr)
+	return psuo
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillablePeriod(pr *proofstat.Period) *ProofStatUpdateOne {
+	if pr != nil {
+		psuo.SetPeriod(*pr)
+	}
+	return psuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (psuo *ProofStatUpdateOne) SetPeriodStart(u uint64) *ProofStatUpdateOne {
+	psuo.mutation.ResetPeriodStart()
+	psuo.mutation.SetPeriodStart(u)
+	return psuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillablePeriodStart(u *uint64) *ProofStatUpdateOne {
+	if u != nil {
+		psuo.SetPeriodStart(*u)
+	}
+	return psuo
+}
+
+// AddPeriodStart adds u to the "period_start" field.
+func (psuo *ProofStatUpdateOne) AddPeriodStart(u int64) *ProofStatUpdateOne {
+	psuo.mutation.AddPeriodStart(u)
+	return psuo
+}
+
+// SetRequested sets the "requested" field.
+func (psuo *ProofStatUpdateOne) SetRequested(i int) *ProofStatUpdateOne {
+	psuo.mutation.ResetRequested()
+	psuo.mutation.SetRequested(i)
+	return psuo
+}
+
+// SetNillableRequested sets the "requested" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillableRequested(i *int) *ProofStatUpdateOne {
+	if i != nil {
+		psuo.SetRequested(*i)
+	}
+	return psuo
+}
+
+// AddRequested adds i to the "requested" field.
+func (psuo *ProofStatUpdateOne) AddRequested(i int) *ProofStatUpdateOne {
+	psuo.mutation.AddRequested(i)
+	return psuo
+}
+
+// SetFulfilled sets the "fulfilled" field.
+func (psuo *ProofStatUpdateOne) SetFulfilled(i int) *ProofStatUpdateOne {
+	psuo.mutation.ResetFulfilled()
+	psuo.mutation.SetFulfilled(i)
+	return psuo
+}
+
+// SetNillableFulfilled sets the "fulfilled" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillableFulfilled(i *int) *ProofStatUpdateOne {
+	if i != nil {
+		psuo.SetFulfilled(*i)
+	}
+	return psuo
+}
+
+// AddFulfilled adds i to the "fulfilled" field.
+func (psuo *ProofStatUpdateOne) AddFulfilled(i int) *ProofStatUpdateOne {
+	psuo.mutation.AddFulfilled(i)
+	return psuo
+}
+
+// SetFailed sets the "failed" field.
+func (psuo *ProofStatUpdateOne) SetFailed(i int) *ProofStatUpdateOne {
+	psuo.mutation.ResetFailed()
+	psuo.mutation.SetFailed(i)
+	return psuo
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillableFailed(i *int) *ProofStatUpdateOne {
+	if i != nil {
+		psuo.SetFailed(*i)
+	}
+	return psuo
+}
+
+// AddFailed adds i to the "failed" field.
+func (psuo *ProofStatUpdateOne) AddFailed(i int) *ProofStatUpdateOne {
+	psuo.mutation.AddFailed(i)
+	return psuo
+}
+
+// SetBlocksProven sets the "blocks_proven" field.
+func (psuo *ProofStatUpdateOne) SetBlocksProven(u uint64) *ProofStatUpdateOne {
+	psuo.mutation.ResetBlocksProven()
+	psuo.mutation.SetBlocksProven(u)
+	return psuo
+}
+
+// SetNillableBlocksProven sets the "blocks_proven" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillableBlocksProven(u *uint64) *ProofStatUpdateOne {
+	if u != nil {
+		psuo.SetBlocksProven(*u)
+	}
+	return psuo
+}
+
+// AddBlocksProven adds u to the "blocks_proven" field.
+func (psuo *ProofStatUpdateOne) AddBlocksProven(u int64) *ProofStatUpdateOne {
+	psuo.mutation.AddBlocksProven(u)
+	return psuo
+}
+
+// SetAverageLatency sets the "average_latency" field.
+func (psuo *ProofStatUpdateOne) SetAverageLatency(f float64) *ProofStatUpdateOne {
+	psuo.mutation.ResetAverageLatency()
+	psuo.mutation.SetAverageLatency(f)
+	return psuo
+}
+
+// SetNillableAverageLatency sets the "average_latency" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillableAverageLatency(f *float64) *ProofStatUpdateOne {
+	if f != nil {
+		psuo.SetAverageLatency(*f)
+	}
+	return psuo
+}
+
+// AddAverageLatency adds f to the "average_latency" field.
+func (psuo *ProofStatUpdateOne) AddAverageLatency(f float64) *ProofStatUpdateOne {
+	psuo.mutation.AddAverageLatency(f)
+	return psuo
+}
+
+// SetSpend sets the "spend" field.
+func (psuo *ProofStatUpdateOne) SetSpend(u uint64) *ProofStatUpdateOne {
+	psuo.mutation.ResetSpend()
+	psuo.mutation.SetSpend(u)
+	return psuo
+}
+
+// SetNillableSpend sets the "spend" field if the given value is not nil.
+func (psuo *ProofStatUpdateOne) SetNillableSpend(u *uint64) *ProofStatUpdateOne {
+	if u != nil {
+		psuo.SetSpend(*u)
+	}
+	return psuo
+}
+
+// AddSpend adds u to the "spend" field.
+func (psuo *ProofStatUpdateOne) AddSpend(u int64) *ProofStatUpdateOne {
+	psuo.mutation.AddSpend(u)
+	return psuo
+}
+
+// Mutation returns the ProofStatMutation object of the builder.
+func (psuo *ProofStatUpdateOne) Mutation() *ProofStatMutation {
+	return psuo.mutation
+}
+
+// Where appends a list predicates to the ProofStatUpdate builder.
+func (psuo *ProofStatUpdateOne) Where(ps ...predicate.ProofStat) *ProofStatUpdateOne {
+	psuo.mutation.Where(ps...)
+	return psuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psuo *ProofStatUpdateOne) Select(field string, fields ...string) *ProofStatUpdateOne {
+	psuo.fields = append([]string{field}, fields...)
+	return psuo
+}
+
+// Save executes the query and returns the updated ProofStat entity.
+func (psuo *ProofStatUpdateOne) Save(ctx context.Context) (*ProofStat, error) {
+	return withHooks(ctx, psuo.sqlSave, psuo.mutation, psuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psuo *ProofStatUpdateOne) SaveX(ctx context.Context) *ProofStat {
+	node, err := psuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psuo *ProofStatUpdateOne) Exec(ctx context.Context) error {
+	_, err := psuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psuo *ProofStatUpdateOne) ExecX(ctx context.Context) {
+	if err := psuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psuo *ProofStatUpdateOne) check() error {
+	if v, ok := psuo.mutation.Period(); ok {
+		if err := proofstat.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "ProofStat.period": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (psuo *ProofStatUpdateOne) sqlSave(ctx context.Context) (_node *ProofStat, err error) {
+	if err := psuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proofstat.Table, proofstat.Columns, sqlgraph.NewFieldSpec(proofstat.FieldID, field.TypeInt))
+	id, ok := psuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProofStat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proofstat.FieldID)
+		for _, f := range fields {
+			if !proofstat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != proofstat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psuo.mutation.Period(); ok {
+		_spec.SetField(proofstat.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := psuo.mutation.PeriodStart(); ok {
+		_spec.SetField(proofstat.FieldPeriodStart, field.TypeUint64, value)
+	}
+	if value, ok := psuo.mutation.AddedPeriodStart(); ok {
+		_spec.AddField(proofstat.FieldPeriodStart, field.TypeUint64, value)
+	}
+	if value, ok := psuo.mutation.Requested(); ok {
+		_spec.SetField(proofstat.FieldRequested, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedRequested(); ok {
+		_spec.AddField(proofstat.FieldRequested, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.Fulfilled(); ok {
+		_spec.SetField(proofstat.FieldFulfilled, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedFulfilled(); ok {
+		_spec.AddField(proofstat.FieldFulfilled, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.Failed(); ok {
+		_spec.SetField(proofstat.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedFailed(); ok {
+		_spec.AddField(proofstat.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.BlocksProven(); ok {
+		_spec.SetField(proofstat.FieldBlocksProven, field.TypeUint64, value)
+	}
+	if value, ok := psuo.mutation.AddedBlocksProven(); ok {
+		_spec.AddField(proofstat.FieldBlocksProven, field.TypeUint64, value)
+	}
+	if value, ok := psuo.mutation.AverageLatency(); ok {
+		_spec.SetField(proofstat.FieldAverageLatency, field.TypeFloat64, value)
+	}
+	if value, ok := psuo.mutation.AddedAverageLatency(); ok {
+		_spec.AddField(proofstat.FieldAverageLatency, field.TypeFloat64, value)
+	}
+	if value, ok := psuo.mutation.Spend(); ok {
+		_spec.SetField(proofstat.FieldSpend, field.TypeUint64, value)
+	}
+	if value, ok := psuo.mutation.AddedSpend(); ok {
+		_spec.AddField(proofstat.FieldSpend, field.TypeUint64, value)
+	}
+	_node = &ProofStat{config: psuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proofstat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	psuo.mutation.done = true
+	return _node, nil
+}
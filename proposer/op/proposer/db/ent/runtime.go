@@ -2,8 +2,23 @@
 
 package ent
 
+import (
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/schema"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	proofrequestFields := schema.ProofRequest{}.Fields()
+	_ = proofrequestFields
+	// proofrequestDescBackfill is the schema descriptor for backfill field.
+	proofrequestDescBackfill := proofrequestFields[16].Descriptor()
+	// proofrequest.DefaultBackfill holds the default value on creation for the backfill field.
+	proofrequest.DefaultBackfill = proofrequestDescBackfill.Default.(bool)
+	// proofrequestDescFinal is the schema descriptor for final field.
+	proofrequestDescFinal := proofrequestFields[29].Descriptor()
+	// proofrequest.DefaultFinal holds the default value on creation for the final field.
+	proofrequest.DefaultFinal = proofrequestDescFinal.Default.(bool)
 }
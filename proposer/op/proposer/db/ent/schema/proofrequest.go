@@ -2,7 +2,9 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // ProofRequest holds the schema definition for the ProofRequest entity.
@@ -24,5 +26,37 @@ func (ProofRequest) Fields() []ent.Field {
 		field.Uint64("l1_block_number").Optional(),
 		field.String("l1_block_hash").Optional(),
 		field.Bytes("proof").Optional(),
+		field.Uint64("estimated_cycles").Optional(),
+		field.Uint64("estimated_fee").Optional(),
+		field.String("proof_system").Optional(),
+		field.String("vkey_hash").Optional(),
+		field.String("proof_format").Optional(),
+		field.Bool("backfill").Default(false),
+		field.String("failure_reason").Optional(),
+		field.Uint64("fulfilled_cycles").Optional(),
+		field.Uint64("fulfilled_fee").Optional(),
+		field.String("prover").Optional(),
+		field.Uint64("fulfilled_time").Optional(),
+		field.String("program_version").Optional(),
+		field.Bytes("public_values").Optional(),
+		field.String("error_code").Optional(),
+		field.Enum("failure_stage").Values("REQUEST", "PROVE", "VERIFY").Optional(),
+		field.Int("parent_id").Optional(),
+		field.Strings("labels").Optional(),
+		field.Enum("challenge_status").Values("CHALLENGED", "CHALLENGER_WINS", "DEFENDER_WINS").Optional(),
+		// Whether a failed request is resolved without being retried, e.g. because other requests cover its range.
+		field.Bool("final").Default(false),
+	}
+}
+
+// Indexes of the ProofRequest.
+func (ProofRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		// At most one request per range can be active, so that concurrent driver ticks or crash recovery can't queue
+		// the same range twice. Failed and complete requests for a range are kept for the history. Backfills that
+		// prove a range again (e.g. after a vkey upgrade) are queued separately from the driver's requests.
+		index.Fields("type", "start_block", "end_block", "backfill").
+			Unique().
+			Annotations(entsql.IndexWhere("status IN ('UNREQ', 'WITNESSGEN', 'PROVING')")),
 	}
 }
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProofStat holds the schema definition for the ProofStat entity, the proving statistics of an hour or a day rolled
+// up from the proof requests.
+type ProofStat struct {
+	ent.Schema
+}
+
+// Fields of the ProofStat.
+func (ProofStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("period").Values("HOUR", "DAY"),
+		field.Uint64("period_start"),
+		field.Int("requested"),
+		field.Int("fulfilled"),
+		field.Int("failed"),
+		field.Uint64("blocks_proven"),
+		field.Float("average_latency"),
+		field.Uint64("spend"),
+	}
+}
+
+// Indexes of the ProofStat.
+func (ProofStat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period", "period_start").Unique(),
+	}
+}
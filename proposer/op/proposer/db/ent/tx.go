@@ -14,6 +14,8 @@ type Tx struct {
 	config
 	// ProofRequest is the client for interacting with the ProofRequest builders.
 	ProofRequest *ProofRequestClient
+	// ProofStat is the client for interacting with the ProofStat builders.
+	ProofStat *ProofStatClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +148,7 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ProofRequest = NewProofRequestClient(tx.config)
+	tx.ProofStat = NewProofStatClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
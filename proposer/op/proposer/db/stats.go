@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// StatsPeriods are the lengths of the periods that the proving statistics are rolled up into.
+var StatsPeriods = map[proofstat.Period]time.Duration{
+	proofstat.PeriodHOUR: time.Hour,
+	proofstat.PeriodDAY:  24 * time.Hour,
+}
+
+// RollUpStats rolls up the proving statistics of the periods of the given length that ended before now, and that
+// weren't rolled up yet, into the stats table. At most maxPeriods periods are rolled up per call, oldest first, so
+// that the first roll-up of a large DB is spread over several calls. Returns the number of periods rolled up.
+//
+// Each period counts the proofs requested, and the proofs fulfilled and failed within it. The blocks proven, the
+// average latency from request to fulfillment and the spend are those of the fulfilled proofs.
+func (db *ProofDB) RollUpStats(period proofstat.Period, now time.Time, maxPeriods int) (int, error) {
+	ctx := context.Background()
+	length := uint64(StatsPeriods[period].Seconds())
+
+	var start uint64
+	latest, err := db.readClient.ProofStat.Query().
+		Where(proofstat.PeriodEQ(period)).
+		Order(ent.Desc(proofstat.FieldPeriodStart)).
+		First(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		// Start at the period of the oldest proof request.
+		oldest, err := db.readClient.ProofRequest.Query().
+			Order(ent.Asc(proofrequest.FieldRequestAddedTime)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to query oldest proof request: %w", err)
+		}
+		start = oldest.RequestAddedTime - oldest.RequestAddedTime%length
+	case err != nil:
+		return 0, fmt.Errorf("failed to query latest %s stats: %w", period, err)
+	default:
+		start = latest.PeriodStart + length
+	}
+
+	rolledUp := 0
+	for ; rolledUp < maxPeriods && start+length <= uint64(now.Unix()); rolledUp++ {
+		if err := db.rollUpPeriod(ctx, period, start, start+length); err != nil {
+			return rolledUp, err
+		}
+		start += length
+	}
+	return rolledUp, nil
+}
+
+// rollUpPeriod rolls up the proving statistics of the period [start, end) into the stats table.
+func (db *ProofDB) rollUpPeriod(ctx context.Context, period proofstat.Period, start, end uint64) error {
+	requested, err := db.readClient.ProofRequest.Query().
+		Where(proofrequest.ProofRequestTimeGTE(start), proofrequest.ProofRequestTimeLT(end)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count requested proofs: %w", err)
+	}
+	failed, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusFAILED),
+			proofrequest.LastUpdatedTimeGTE(start),
+			proofrequest.LastUpdatedTimeLT(end),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count failed proofs: %w", err)
+	}
+	fulfilled, err := db.readClient.ProofRequest.Query().
+		Where(
+			proofrequest.StatusEQ(proofrequest.StatusCOMPLETE),
+			proofrequest.LastUpdatedTimeGTE(start),
+			proofrequest.LastUpdatedTimeLT(end),
+		).
+		Select(
+			proofrequest.FieldType,
+			proofrequest.FieldStartBlock,
+			proofrequest.FieldEndBlock,
+			proofrequest.FieldProofRequestTime,
+			proofrequest.FieldLastUpdatedTime,
+			proofrequest.FieldFulfilledFee,
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query fulfilled proofs: %w", err)
+	}
+
+	var blocksProven, spend, totalLatency uint64
+	var timed int
+	for _, p := range fulfilled {
+		// Agg proofs prove the same blocks as their span proofs again.
+		if p.Type == proofrequest.TypeSPAN {
+			blocksProven += p.EndBlock - p.StartBlock
+		}
+		spend += p.FulfilledFee
+		if p.ProofRequestTime != 0 {
+			totalLatency += p.LastUpdatedTime - min(p.LastUpdatedTime, p.ProofRequestTime)
+			timed++
+		}
+	}
+	var averageLatency float64
+	if timed > 0 {
+		averageLatency = float64(totalLatency) / float64(timed)
+	}
+
+	err = db.writeClient.ProofStat.Create().
+		SetPeriod(period).
+		SetPeriodStart(start).
+		SetRequested(requested).
+		SetFulfilled(len(fulfilled)).
+		SetFailed(failed).
+		SetBlocksProven(blocksProven).
+		SetAverageLatency(averageLatency).
+		SetSpend(spend).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save %s stats at %d: %w", period, start, err)
+	}
+	return nil
+}
+
+// GetStats returns the rolled up proving statistics of the periods of the given length that start in the time range
+// [since, until), oldest first.
+func (db *ProofDB) GetStats(period proofstat.Period, since, until uint64) ([]*ent.ProofStat, error) {
+	stats, err := db.readClient.ProofStat.Query().
+		Where(
+			proofstat.PeriodEQ(period),
+			proofstat.PeriodStartGTE(since),
+			proofstat.PeriodStartLT(until),
+		).
+		Order(ent.Asc(proofstat.FieldPeriodStart)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s stats: %w", period, err)
+	}
+	return stats, nil
+}
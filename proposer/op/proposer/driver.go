@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"math/big"
 	_ "net/http/pprof"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -16,18 +18,22 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru/v2"
 
 	// Original Optimism Bindings
 	opbindings "github.com/ethereum-optimism/optimism/op-proposer/bindings"
 	// OP Succinct Contract Bindings
 	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
 
-	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
 )
 
 var (
@@ -35,6 +41,18 @@ var (
 	ErrProposerNotRunning    = errors.New("proposer is not running")
 )
 
+// Policies for selecting the L1 head that is checkpointed for agg proofs.
+const (
+	// Checkpoint the block before the current L1 head.
+	L1HeadPolicyLatest = "latest"
+	// Checkpoint the block AggProofL1HeadOffset blocks behind the current L1 head.
+	L1HeadPolicyConfirmations = "confirmations"
+	// Checkpoint the block AggProofL1HeadOffset blocks behind the L1 safe head.
+	L1HeadPolicySafe = "safe"
+	// Checkpoint the block AggProofL1HeadOffset blocks behind the L1 finalized head.
+	L1HeadPolicyFinalized = "finalized"
+)
+
 type L1Client interface {
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	// CodeAt returns the code of the given account. This is needed to differentiate
@@ -54,6 +72,11 @@ type L2OOContract interface {
 	NextOutputIndex(*bind.CallOpts) (*big.Int, error)
 	StartingTimestamp(*bind.CallOpts) (*big.Int, error)
 	L2BLOCKTIME(*bind.CallOpts) (*big.Int, error)
+	AggregationVkey(*bind.CallOpts) ([32]byte, error)
+	RangeVkeyCommitment(*bind.CallOpts) ([32]byte, error)
+	RollupConfigHash(*bind.CallOpts) ([32]byte, error)
+	GetL2Output(*bind.CallOpts, *big.Int) (opsuccinctbindings.TypesOutputProposal, error)
+	GetL2OutputIndexAfter(*bind.CallOpts, *big.Int) (*big.Int, error)
 }
 
 type RollupClient interface {
@@ -68,6 +91,9 @@ type DriverSetup struct {
 	Txmgr    txmgr.TxManager
 	L1Client *ethclient.Client
 
+	// L2Client is optional, and is used to estimate the proving cost of L2 blocks.
+	L2Client *ethclient.Client
+
 	// RollupProvider's RollupClient() is used to retrieve output roots from
 	RollupProvider dial.RollupProvider
 }
@@ -85,13 +111,79 @@ type L2OutputSubmitter struct {
 	mutex   sync.Mutex
 	running bool
 
+	// Set once the OP Succinct server has rejected a batch span proof request, so that span proofs are requested
+	// individually from then on.
+	batchSpanRequestsUnsupported atomic.Bool
+	// Set once the OP Succinct server has rejected a span proof request with a witness, so that witnesses are
+	// generated by the server from then on.
+	witnessUploadUnsupported atomic.Bool
+	// The time until which no new proofs are requested, in Unix nanoseconds, because the OP Succinct server asked the
+	// proposer to back off.
+	requestBackoffUntil atomic.Int64
+
 	l2ooContract L2OOContract
 	l2ooABI      *abi.ABI
 
+	// Set in shadow mode, in which outputs are compared against the incumbent proposer instead of being submitted.
+	shadow    *shadowL2OO
+	incumbent IncumbentOracle
+
 	dgfContract *opbindings.L2OutputOracleCaller
 	dgfABI      *abi.ABI
+	// Watches the dispute games created by the proposer for challenges. Only set in fault-proof mode.
+	challenges *challengeMonitor
 
 	db db.ProofDB
+
+	backends *proverBackends
+
+	// The L2 safe and finalized heads, polled from the rollup node in the background.
+	heads *headTracker
+	// The hardfork schedule of the L2 chain, loaded from the rollup node on first use.
+	forks atomic.Pointer[forkSchedule]
+	// Tracks the proving throughput for the output SLA.
+	sla slaMonitor
+	// The estimated proving cost of recently seen L2 blocks, so that sizing spans by cost doesn't fetch the blocks of
+	// the trailing partial span again on every tick. Created on first use.
+	blockCycles     *lru.Cache[uint64, uint64]
+	blockCyclesOnce sync.Once
+
+	// The dependency set of an interop chain, nil otherwise.
+	interop *dependencySet
+
+	// Publishes lifecycle events if an event bus is configured, nil otherwise.
+	events *eventPublisher
+
+	// Elects the active replica if the proposer runs with standby replicas, nil otherwise.
+	leadership Leadership
+	// Whether this replica was the active one on the previous check, once checked. Only used by the driver loop.
+	leaderKnown bool
+	wasLeader   bool
+
+	// The last time stale proof requests were cleaned up.
+	lastGC time.Time
+	// Whether new span proofs wait for the span proof backlog to drain. Only used by the driver loop.
+	spanBackpressure bool
+	// Adapts the limit of concurrent proof requests to the observed proof turnaround.
+	concurrency concurrencyController
+
+	// The windows in which agg proofs are submitted. Empty if they're submitted any time.
+	submissionWindows []*cronSchedule
+
+	// Config updates to apply between iterations of the driver loop.
+	reloadCh chan func(cfg *ProposerConfig)
+	// The config with the updates applied so far, if any. It's replaced rather than modified, as the background
+	// workers read it concurrently with the driver loop.
+	reloadedCfg atomic.Pointer[ProposerConfig]
+
+	// The proof requests running in the background, which are drained on shutdown.
+	inFlight sync.WaitGroup
+	// Set once the proposer is stopping, so that no new proofs are requested.
+	draining atomic.Bool
+	// The context of requests to the OP Succinct server. Unlike ctx, it isn't cancelled when the proposer starts
+	// stopping, so that in-flight requests can finish.
+	serverCtx    context.Context
+	serverCancel context.CancelFunc
 }
 
 // NewL2OutputSubmitter creates a new L2 Output Submitter
@@ -137,22 +229,102 @@ func newL2OOSubmitter(ctx context.Context, cancel context.CancelFunc, setup Driv
 		return nil, err
 	}
 
-	db, err := db.InitDB(setup.Cfg.DbPath, setup.Cfg.UseCachedDb)
+	leadership, err := newLeadership(ctx, setup.Cfg, setup.Log)
 	if err != nil {
 		cancel()
 		return nil, err
 	}
 
-	return &L2OutputSubmitter{
-		DriverSetup: setup,
-		done:        make(chan struct{}),
-		ctx:         ctx,
-		cancel:      cancel,
+	db, err := initProofDB(setup.Cfg, setup.Log)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	var interop *dependencySet
+	if setup.Cfg.DependencySetFile != "" {
+		depSet, err := LoadDependencySet(setup.Cfg.DependencySetFile)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		if interop, err = newDependencySet(ctx, depSet, setup.Log); err != nil {
+			cancel()
+			return nil, err
+		}
+		log.Info("Proving with interop dependency set", "chains", len(depSet.Chains))
+	}
+
+	var events *eventPublisher
+	if setup.Cfg.EventBus != "" {
+		publisher, err := utils.NewEventPublisher(setup.Cfg.EventBus, setup.Cfg.EventTopic)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		events = newEventPublisher(publisher, setup.Cfg.L2ChainID, setup.Log, setup.Metr)
+		log.Info("Publishing lifecycle events", "topic", setup.Cfg.EventTopic)
+	}
+
+	serverCtx, serverCancel := context.WithCancel(context.Background())
+	l := &L2OutputSubmitter{
+		DriverSetup:  setup,
+		done:         make(chan struct{}),
+		reloadCh:     make(chan func(cfg *ProposerConfig), 1),
+		ctx:          ctx,
+		cancel:       cancel,
+		serverCtx:    serverCtx,
+		serverCancel: serverCancel,
 
 		l2ooContract: l2ooContract,
 		l2ooABI:      parsed,
 		db:           *db,
-	}, nil
+		backends:     newProverBackends(setup.Cfg),
+		heads:        newHeadTracker(setup.RollupProvider, setup.Log),
+		leadership:   leadership,
+		interop:      interop,
+		events:       events,
+	}
+
+	if l.submissionWindows, err = parseSubmissionWindows(setup.Cfg.SubmissionSchedule); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if setup.Cfg.ShadowL2OOAddr != nil {
+		l.shadow, l.incumbent, err = newShadowL2OO(l2ooContract, *setup.Cfg.ShadowL2OOAddr, setup.L1Client)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		l.l2ooContract = l.shadow
+		log.Info("Running in shadow mode, comparing outputs against the incumbent proposer", "address", setup.Cfg.ShadowL2OOAddr)
+	}
+	return l, nil
+}
+
+// initProofDB opens the proof DB with the configured options.
+func initProofDB(cfg ProposerConfig, log log.Logger) (*db.ProofDB, error) {
+	var encryptionKey []byte
+	if cfg.ProofEncryptionKeyFile != "" {
+		var err error
+		if encryptionKey, err = db.LoadEncryptionKey(cfg.ProofEncryptionKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	proofDB, err := db.InitDBWithOptions(cfg.DbPath, cfg.UseCachedDb, db.Options{
+		JournalMode:   cfg.DbJournalMode,
+		BusyTimeout:   cfg.DbBusyTimeout,
+		MaxReadConns:  cfg.DbMaxReadConns,
+		EncryptionKey: encryptionKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n := proofDB.DedupedRequests(); n > 0 {
+		log.Warn("failed duplicate active proof requests in the proof DB", "count", n)
+	}
+	return proofDB, nil
 }
 
 // Create a new submitter for the DisputeGameFactory. Note: This is unused in OP-Succinct.
@@ -178,14 +350,49 @@ func newDGFSubmitter(ctx context.Context, cancel context.CancelFunc, setup Drive
 		return nil, err
 	}
 
+	leadership, err := newLeadership(ctx, setup.Cfg, setup.Log)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The proof DB is only used to record challenges against the games of the proposer on the agg proofs.
+	db, err := initProofDB(setup.Cfg, setup.Log)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	challenges, err := newChallengeMonitor(*setup.Cfg.DisputeGameFactoryAddr, setup.L1Client, setup.Txmgr.From(), setup.Cfg.DisputeGameType, db, setup.Log, setup.Metr)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	submissionWindows, err := parseSubmissionWindows(setup.Cfg.SubmissionSchedule)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	serverCtx, serverCancel := context.WithCancel(context.Background())
 	return &L2OutputSubmitter{
-		DriverSetup: setup,
-		done:        make(chan struct{}),
-		ctx:         ctx,
-		cancel:      cancel,
+		DriverSetup:  setup,
+		done:         make(chan struct{}),
+		reloadCh:     make(chan func(cfg *ProposerConfig), 1),
+		ctx:          ctx,
+		cancel:       cancel,
+		serverCtx:    serverCtx,
+		serverCancel: serverCancel,
 
 		dgfContract: dgfCaller,
 		dgfABI:      parsed,
+		db:          *db,
+		backends:    newProverBackends(setup.Cfg),
+		heads:       newHeadTracker(setup.RollupProvider, setup.Log),
+		leadership:  leadership,
+		challenges:  challenges,
+
+		submissionWindows: submissionWindows,
 	}, nil
 }
 
@@ -198,8 +405,36 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	if l.running {
 		return errors.New("proposer is already running")
 	}
+
+	ctx, cancel := context.WithTimeout(l.ctx, l.config().NetworkTimeout)
+	defer cancel()
+	if err := l.negotiateServerVersion(ctx); err != nil {
+		return err
+	}
 	l.running = true
 
+	if l.config().HeadPollInterval > 0 {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.heads.run(l.ctx, l.config().HeadPollInterval)
+		}()
+	}
+	if l.challenges != nil {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			l.challenges.run(l.ctx, l.config().PollInterval)
+		}()
+	}
+	if lease, ok := l.leadership.(*leaseLeadership); ok {
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+			lease.run(l.ctx, l.Log)
+		}()
+	}
+
 	l.wg.Add(1)
 	go l.loop()
 
@@ -207,6 +442,42 @@ func (l *L2OutputSubmitter) StartL2OutputSubmitting() error {
 	return nil
 }
 
+// VersionInfo returns the version of the proposer, and of the programs that the L2OO contract and the active OP
+// Succinct server verify and prove.
+func (l *L2OutputSubmitter) VersionInfo(ctx context.Context, version string) (VersionInfo, error) {
+	var contract VersionContract
+	if l.l2ooContract != nil {
+		contract = l.l2ooContract
+	}
+	return NewVersionInfo(ctx, version, contract, l.backends.active().url)
+}
+
+// ReloadConfig applies a config update between iterations of the driver loop, so that it doesn't change the config
+// in the middle of an iteration. Blocks until the loop picks up the update or the driver stops.
+func (l *L2OutputSubmitter) ReloadConfig(update func(cfg *ProposerConfig)) {
+	select {
+	case l.reloadCh <- update:
+	case <-l.done:
+	}
+}
+
+// config returns the current config: Cfg, with the updates applied by ReloadConfig so far. It's safe to call from any
+// goroutine, and the returned config must not be modified.
+func (l *L2OutputSubmitter) config() *ProposerConfig {
+	if cfg := l.reloadedCfg.Load(); cfg != nil {
+		return cfg
+	}
+	return &l.Cfg
+}
+
+// applyConfigUpdate applies a config update to a copy of the current config, and makes the copy current.
+func (l *L2OutputSubmitter) applyConfigUpdate(update func(cfg *ProposerConfig)) *ProposerConfig {
+	cfg := *l.config()
+	update(&cfg)
+	l.reloadedCfg.Store(&cfg)
+	return &cfg
+}
+
 func (l *L2OutputSubmitter) StopL2OutputSubmittingIfRunning() error {
 	err := l.StopL2OutputSubmitting()
 	if errors.Is(err, ErrProposerNotRunning) {
@@ -226,9 +497,13 @@ func (l *L2OutputSubmitter) StopL2OutputSubmitting() error {
 	}
 	l.running = false
 
+	// Stop requesting new proofs, and wait for the loop to finish its current iteration and the in-flight proof
+	// requests to record their outcome, so that the DB isn't closed in the middle of a write.
+	l.draining.Store(true)
 	l.cancel()
 	close(l.done)
 	l.wg.Wait()
+	l.drainRequests()
 
 	if l.db != (db.ProofDB{}) {
 		if err := l.db.CloseDB(); err != nil {
@@ -240,9 +515,48 @@ func (l *L2OutputSubmitter) StopL2OutputSubmitting() error {
 	return nil
 }
 
+// drainRequests waits for the in-flight proof requests to finish. If they don't finish within the shutdown timeout,
+// their requests to the OP Succinct server are cancelled, and they're waited for to record the failure.
+func (l *L2OutputSubmitter) drainRequests() {
+	drained := make(chan struct{})
+	go func() {
+		l.inFlight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(l.config().ShutdownTimeout):
+		l.Log.Warn("Timed out waiting for in-flight proof requests, cancelling them", "timeout", l.config().ShutdownTimeout)
+		l.serverCancel()
+		<-drained
+	}
+	l.serverCancel()
+}
+
+// goRequest runs a request for the given proofs in the background, and tracks it so that it's drained on shutdown. If
+// the request panics, the proofs are marked as FAILED.
+func (l *L2OutputSubmitter) goRequest(reqs []ent.ProofRequest, request func()) {
+	l.inFlight.Add(1)
+	go func() {
+		defer l.inFlight.Done()
+		defer l.recoverWorker("proof request", reqs...)
+		request()
+	}()
+}
+
+// serverContext returns the parent context of requests to the OP Succinct server.
+func (l *L2OutputSubmitter) serverContext() context.Context {
+	if l.serverCtx == nil {
+		return context.Background()
+	}
+	return l.serverCtx
+}
+
 // ProposerMetrics contains relevant statistics for the proposer.
 type ProposerMetrics struct {
 	L2UnsafeHeadBlock              uint64
+	L2SafeHeadBlock                uint64
 	L2FinalizedBlock               uint64
 	LatestContractL2Block          uint64
 	HighestProvenContiguousL2Block uint64
@@ -254,14 +568,9 @@ type ProposerMetrics struct {
 // GetProposerMetrics gets the performance metrics for the proposer.
 // TODO: Add a metric for the latest proven transaction.
 func (l *L2OutputSubmitter) GetProposerMetrics(ctx context.Context) (ProposerMetrics, error) {
-	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	status, err := l.heads.syncStatus(ctx)
 	if err != nil {
-		return ProposerMetrics{}, fmt.Errorf("getting rollup client: %w", err)
-	}
-
-	status, err := rollupClient.SyncStatus(ctx)
-	if err != nil {
-		return ProposerMetrics{}, fmt.Errorf("getting sync status: %w", err)
+		return ProposerMetrics{}, err
 	}
 
 	// The unsafe head block on L2.
@@ -297,6 +606,7 @@ func (l *L2OutputSubmitter) GetProposerMetrics(ctx context.Context) (ProposerMet
 
 	return ProposerMetrics{
 		L2UnsafeHeadBlock:              l2UnsafeHeadBlock,
+		L2SafeHeadBlock:                status.SafeL2.Number,
 		L2FinalizedBlock:               l2FinalizedBlock,
 		LatestContractL2Block:          latestContractL2Block.Uint64(),
 		HighestProvenContiguousL2Block: highestProvenContiguousL2Block,
@@ -323,12 +633,30 @@ func (l *L2OutputSubmitter) SubmitAggProofs(ctx context.Context) error {
 		return nil
 	}
 
+	// Routine submissions wait for a submission window.
+	if ok, err := l.canSubmit(ctx, time.Now()); err != nil || !ok {
+		return err
+	}
+
 	for _, aggProof := range completedAggProofs {
 		output, err := l.FetchOutput(ctx, aggProof.EndBlock)
 		if err != nil {
 			return fmt.Errorf("failed to fetch output at block %d: %w", aggProof.EndBlock, err)
 		}
 
+		if l.config().CrossCheckOutputRoots {
+			if err := l.crossCheckAggProof(aggProof, output); err != nil {
+				l.rejectAggProof(aggProof, err)
+				return err
+			}
+		}
+
+		// Leadership can move to a standby replica while the proof is fetched and checked, so check it again right
+		// before submitting. The L2OO contract rejects an output that another replica already submitted.
+		if !l.isActive(ctx) {
+			l.Log.Warn("no longer the active proposer, not submitting agg proof", "start", aggProof.StartBlock, "end", aggProof.EndBlock)
+			return nil
+		}
 		l.proposeOutput(ctx, output, aggProof.Proof, aggProof.L1BlockNumber, common.HexToHash(aggProof.L1BlockHash))
 		l.Log.Info("AGG proof submitted on-chain", "start", aggProof.StartBlock, "end", aggProof.EndBlock)
 	}
@@ -346,7 +674,7 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 		return nil, false, fmt.Errorf("L2OutputOracle contract not set, cannot fetch next output info")
 	}
 
-	cCtx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	cCtx, cancel := context.WithTimeout(ctx, l.config().NetworkTimeout)
 	defer cancel()
 	callOpts := &bind.CallOpts{
 		From:    l.Txmgr.From(),
@@ -375,12 +703,12 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 	}
 
 	// Always propose if it's part of the Finalized L2 chain. Or if allowed, if it's part of the safe L2 chain.
-	if output.BlockRef.Number > output.Status.FinalizedL2.Number && (!l.Cfg.AllowNonFinalized || output.BlockRef.Number > output.Status.SafeL2.Number) {
+	if output.BlockRef.Number > output.Status.FinalizedL2.Number && (!l.config().AllowNonFinalized || output.BlockRef.Number > output.Status.SafeL2.Number) {
 		l.Log.Debug("Not proposing yet, L2 block is not ready for proposal",
 			"l2_proposal", output.BlockRef,
 			"l2_safe", output.Status.SafeL2,
 			"l2_finalized", output.Status.FinalizedL2,
-			"allow_non_finalized", l.Cfg.AllowNonFinalized)
+			"allow_non_finalized", l.config().AllowNonFinalized)
 		return output, false, nil
 	}
 	return output, true, nil
@@ -390,7 +718,7 @@ func (l *L2OutputSubmitter) FetchL2OOOutput(ctx context.Context) (*eth.OutputRes
 // The passed context is expected to be a lifecycle context. A network timeout
 // context will be derived from it.
 func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	ctx, cancel := context.WithTimeout(ctx, l.config().NetworkTimeout)
 	defer cancel()
 
 	blockNum, err := l.FetchCurrentBlockNumber(ctx)
@@ -403,18 +731,13 @@ func (l *L2OutputSubmitter) FetchDGFOutput(ctx context.Context) (*eth.OutputResp
 // FetchCurrentBlockNumber gets the current block number from the [L2OutputSubmitter]'s [RollupClient]. If the `AllowNonFinalized` configuration
 // option is set, it will return the safe head block number, and if not, it will return the finalized head block number.
 func (l *L2OutputSubmitter) FetchCurrentBlockNumber(ctx context.Context) (uint64, error) {
-	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	status, err := l.heads.syncStatus(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("getting rollup client: %w", err)
-	}
-
-	status, err := rollupClient.SyncStatus(ctx)
-	if err != nil {
-		return 0, fmt.Errorf("getting sync status: %w", err)
+		return 0, err
 	}
 
 	// Use either the finalized or safe head depending on the config. Finalized head is default & safer.
-	if l.Cfg.AllowNonFinalized {
+	if l.config().AllowNonFinalized && !l.config().FinalizedOnly {
 		return status.SafeL2.Number, nil
 	}
 	return status.FinalizedL2.Number, nil
@@ -466,7 +789,7 @@ func (l *L2OutputSubmitter) CheckpointBlockHashTxData(blockNumber *big.Int, bloc
 // will produce a value of 0 within EstimateGas, and the call will fail when the contract checks
 // that l1blockhash matches blockhash(l1blocknum).
 func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64) error {
-	ticker := time.NewTicker(l.Cfg.PollInterval)
+	ticker := time.NewTicker(l.config().PollInterval)
 	defer ticker.Stop()
 	l1head, err := l.Txmgr.BlockNumber(ctx)
 	if err != nil {
@@ -496,7 +819,7 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 
 	l.Log.Info("Proposing output root", "output", output.OutputRoot, "block", output.BlockRef)
 	var receipt *types.Receipt
-	if l.Cfg.DisputeGameFactoryAddr != nil {
+	if l.config().DisputeGameFactoryAddr != nil {
 		return errors.New("not implemented")
 	} else {
 		data, err := l.ProposeL2OutputTxData(output, proof, l1BlockNum, l1BlockHash)
@@ -506,7 +829,7 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 		// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
 		receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
 			TxData:   data,
-			To:       l.Cfg.L2OutputOracleAddr,
+			To:       l.config().L2OutputOracleAddr,
 			GasLimit: 0,
 		})
 		if err != nil {
@@ -516,12 +839,13 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 
 	if receipt.Status == types.ReceiptStatusFailed {
 		l.Log.Error("Proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
-	} else {
-		l.Log.Info("Proposer tx successfully published",
-			"tx_hash", receipt.TxHash,
-			"l1blocknum", l1BlockNum,
-			"l1blockhash", l1BlockHash)
+		return &RevertError{Method: "proposeL2Output", TxHash: receipt.TxHash}
 	}
+	l.Log.Info("Proposer tx successfully published",
+		"tx_hash", receipt.TxHash,
+		"l1blocknum", l1BlockNum,
+		"l1blockhash", l1BlockHash)
+	l.publishOutputEvent(output, receipt.TxHash, receipt.BlockNumber.Uint64())
 	return nil
 }
 
@@ -536,7 +860,7 @@ func (l *L2OutputSubmitter) sendCheckpointTransaction(ctx context.Context, block
 	// TODO: This currently blocks the loop while it waits for the transaction to be confirmed. Up to 3 minutes.
 	receipt, err = l.Txmgr.Send(ctx, txmgr.TxCandidate{
 		TxData:   data,
-		To:       l.Cfg.L2OutputOracleAddr,
+		To:       l.config().L2OutputOracleAddr,
 		GasLimit: 0,
 	})
 	if err != nil {
@@ -545,10 +869,10 @@ func (l *L2OutputSubmitter) sendCheckpointTransaction(ctx context.Context, block
 
 	if receipt.Status == types.ReceiptStatusFailed {
 		l.Log.Error("checkpoint blockhash tx successfully published but reverted", "tx_hash", receipt.TxHash)
-	} else {
-		l.Log.Info("checkpoint blockhash tx successfully published",
-			"tx_hash", receipt.TxHash)
+		return 0, common.Hash{}, &RevertError{Method: "checkpointBlockHash", TxHash: receipt.TxHash}
 	}
+	l.Log.Info("checkpoint blockhash tx successfully published",
+		"tx_hash", receipt.TxHash)
 	return blockNumber.Uint64(), blockHash, nil
 }
 
@@ -558,7 +882,7 @@ func (l *L2OutputSubmitter) loop() {
 	defer l.wg.Done()
 	ctx := l.ctx
 
-	if l.Cfg.WaitNodeSync {
+	if l.config().WaitNodeSync {
 		err := l.waitNodeSync()
 		if err != nil {
 			l.Log.Error("Error waiting for node sync", "err", err)
@@ -574,7 +898,7 @@ func (l *L2OutputSubmitter) loop() {
 }
 
 func (l *L2OutputSubmitter) waitNodeSync() error {
-	cCtx, cancel := context.WithTimeout(l.ctx, l.Cfg.NetworkTimeout)
+	cCtx, cancel := context.WithTimeout(l.ctx, l.config().NetworkTimeout)
 	defer cancel()
 
 	l1head, err := l.Txmgr.BlockNumber(cCtx)
@@ -590,72 +914,212 @@ func (l *L2OutputSubmitter) waitNodeSync() error {
 	return dial.WaitRollupSync(l.ctx, l.Log, rollupClient, l1head, time.Second*12)
 }
 
+// loopStage tracks when a stage of the driver loop last ran, so that it runs on its own interval.
+type loopStage struct {
+	last time.Time
+}
+
+// due returns whether the stage should run on the tick at now. Ticks don't fire exactly on time, so the stage is due
+// once its interval elapses within half a tick.
+func (s *loopStage) due(now time.Time, interval, tick time.Duration) bool {
+	return s.last.IsZero() || now.Sub(s.last)+tick/2 >= interval
+}
+
+// loopStep is a step of an iteration of the driver loop. Steps with a stage run when the stage is due, and steps
+// without one run whenever another step is due.
+type loopStep struct {
+	stage    *loopStage
+	interval time.Duration
+	// run runs the step, and returns false if the remaining steps of the iteration should be skipped.
+	run func() bool
+}
+
+// runLoopSteps runs the steps of an iteration of the driver loop in order. A stage is recorded as run only once its
+// step ran, so that the stages skipped by a failing step run on the next tick instead of waiting out their interval.
+func runLoopSteps(now time.Time, tick time.Duration, steps []loopStep) {
+	if !slices.ContainsFunc(steps, func(step loopStep) bool {
+		return step.stage != nil && step.stage.due(now, step.interval, tick)
+	}) {
+		return
+	}
+	for _, step := range steps {
+		if step.stage != nil && !step.stage.due(now, step.interval, tick) {
+			continue
+		}
+		ok := step.run()
+		if step.stage != nil {
+			step.stage.last = now
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+// stageInterval returns the interval of a stage of the driver loop, which defaults to the poll interval.
+func (c *ProposerConfig) stageInterval(interval time.Duration) time.Duration {
+	if interval == 0 {
+		return c.PollInterval
+	}
+	return interval
+}
+
+// loopTick returns how often the driver loop ticks: the shortest interval of its stages.
+func (c *ProposerConfig) loopTick() time.Duration {
+	return min(c.PollInterval, c.stageInterval(c.PendingProofsInterval), c.stageInterval(c.AggProofsInterval),
+		c.stageInterval(c.RequestProofsInterval))
+}
+
 // The loopL2OO regularly polls the L2OO for the next block to propose,
 // and if the current finalized (or safe) block is past that next block, it
 // proposes it.
+//
+// Checking the status of requested proofs, deriving agg proofs and requesting queued proofs can each run on their
+// own interval. The other stages run every PollInterval.
 func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
-	ticker := time.NewTicker(l.Cfg.PollInterval)
+	tick := l.config().loopTick()
+	ticker := time.NewTicker(tick)
 	defer ticker.Stop()
+	var status, spanBatches, pendingProofs, aggProofs, requestProofs, submission loopStage
 	for {
 		select {
-		case <-ticker.C:
-			// Get the current metrics for the proposer.
-			metrics, err := l.GetProposerMetrics(ctx)
-			if err != nil {
-				l.Log.Error("failed to get metrics", "err", err)
-				continue
-			}
-			l.Log.Info("Proposer status", "metrics", metrics)
+		case now := <-ticker.C:
+			cfg := l.config()
+			// The work of the active replica is cancelled as soon as its term as the leader ends.
+			workCtx, cancelWork := ctx, context.CancelFunc(func() {})
+			runLoopSteps(now, tick, []loopStep{
+				{&status, cfg.PollInterval, func() bool {
+					if err := l.reportStatus(ctx); err != nil {
+						l.Log.Error("failed to get metrics", "err", err)
+						return false
+					}
+					return true
+				}},
+				// Standby replicas only report metrics. The active replica requests and polls proofs, and submits
+				// outputs.
+				{nil, 0, func() bool {
+					if !l.isActive(ctx) {
+						return false
+					}
+					workCtx, cancelWork = l.leaderContext(ctx)
+					return true
+				}},
+				{&spanBatches, cfg.PollInterval, func() bool { return l.deriveSpanBatches(workCtx) }},
+				{&pendingProofs, cfg.stageInterval(cfg.PendingProofsInterval), func() bool { return l.processPendingProofs(workCtx) }},
+				{&aggProofs, cfg.stageInterval(cfg.AggProofsInterval), func() bool { return l.deriveAggProofs(workCtx) }},
+				{&requestProofs, cfg.stageInterval(cfg.RequestProofsInterval), func() bool { return l.requestQueuedProofs(workCtx) }},
+				{&submission, cfg.PollInterval, func() bool {
+					l.submitOutputs(workCtx)
+					return true
+				}},
+			})
+			cancelWork()
+		case update := <-l.reloadCh:
+			cfg := l.applyConfigUpdate(update)
+			tick = cfg.loopTick()
+			ticker.Reset(tick)
+			l.Log.Info("Reloaded config", "pollInterval", cfg.PollInterval,
+				"pendingProofsInterval", cfg.stageInterval(cfg.PendingProofsInterval),
+				"aggProofsInterval", cfg.stageInterval(cfg.AggProofsInterval),
+				"requestProofsInterval", cfg.stageInterval(cfg.RequestProofsInterval),
+				"maxConcurrentProofRequests", cfg.MaxConcurrentProofRequests)
+		case <-l.done:
+			return
+		}
+	}
+}
 
-			// 1) Queue up the span proofs that are ready to prove. Determine these range proofs based on the latest L2 finalized block,
-			// and the current L2 unsafe head.
-			l.Log.Info("Stage 1: Deriving Span Batches...")
-			err = l.DeriveNewSpanBatches(ctx)
-			if err != nil {
-				l.Log.Error("failed to add next span batches to db", "err", err)
-				continue
-			}
+// reportStatus logs and records the current metrics of the proposer, and checks the output SLA.
+func (l *L2OutputSubmitter) reportStatus(ctx context.Context) error {
+	// Get the current metrics for the proposer.
+	metrics, err := l.GetProposerMetrics(ctx)
+	if err != nil {
+		return err
+	}
+	l.Log.Info("Proposer status", "metrics", metrics)
+	if err := l.checkOutputSLA(ctx, metrics); err != nil {
+		l.Log.Error("failed to check output SLA", "err", err)
+	}
+	if err := l.recordLabelMetrics(); err != nil {
+		l.Log.Error("failed to record label metrics", "err", err)
+	}
+	return nil
+}
 
-			// 2) Check the statuses of all requested proofs.
-			// If it's successfully returned, we validate that we have it on disk and set status = "COMPLETE".
-			// If it fails or times out, we set status = "FAILED" (and, if it's a span proof, split the request in half to try again).
-			l.Log.Info("Stage 2: Processing Pending Proofs...")
-			err = l.ProcessPendingProofs()
-			if err != nil {
-				l.Log.Error("failed to update requested proofs", "err", err)
-				continue
-			}
+// The stages of the driver loop. Each returns whether it succeeded, as a failed stage skips the stages after it until
+// the next tick.
 
-			// 3) Determine if there is a continguous chain of span proofs starting from the latest block on the L2OO contract.
-			// If there is, queue an aggregate proof for all of the span proofs.
-			l.Log.Info("Stage 3: Deriving Agg Proofs...")
-			err = l.DeriveAggProofs(ctx)
-			if err != nil {
-				l.Log.Error("failed to generate pending agg proofs", "err", err)
-				continue
-			}
+func (l *L2OutputSubmitter) deriveSpanBatches(ctx context.Context) bool {
+	// Clean up stale proof requests before processing the queue, as they can block the stages below.
+	if err := l.maybeCollectGarbage(ctx); err != nil {
+		l.Log.Error("failed to collect stale proof requests", "err", err)
+	}
 
-			// 4) Request all unrequested proofs from the prover network.
-			// Any DB entry with status = "UNREQ" means it's queued up and ready.
-			// We request all of these (both span and agg) from the prover network.
-			// For agg proofs, we also checkpoint the blockhash in advance.
-			l.Log.Info("Stage 4: Requesting Queued Proofs...")
-			err = l.RequestQueuedProofs(ctx)
-			if err != nil {
-				l.Log.Error("failed to request unrequested proofs", "err", err)
-				continue
-			}
+	// 1) Queue up the span proofs that are ready to prove. Determine these range proofs based on the latest L2 finalized block,
+	// and the current L2 unsafe head.
+	l.Log.Info("Stage 1: Deriving Span Batches...")
+	if err := l.DeriveNewSpanBatches(ctx); err != nil {
+		l.Log.Error("failed to add next span batches to db", "err", err)
+		return false
+	}
+	return true
+}
 
-			// 5) Submit agg proofs on chain.
-			// If we have a completed agg proof waiting in the DB, we submit them on chain.
-			l.Log.Info("Stage 5: Submitting Agg Proofs...")
-			err = l.SubmitAggProofs(ctx)
-			if err != nil {
-				l.Log.Error("failed to submit agg proofs", "err", err)
-			}
-		case <-l.done:
-			return
+func (l *L2OutputSubmitter) processPendingProofs(ctx context.Context) bool {
+	// 2) Check the statuses of all requested proofs.
+	// If it's successfully returned, we validate that we have it on disk and set status = "COMPLETE".
+	// If it fails or times out, we set status = "FAILED" (and, if it's a span proof, split the request in half to try again).
+	// Span proofs that are already covered by the latest output on the L2OO contract are cancelled.
+	l.Log.Info("Stage 2: Processing Pending Proofs...")
+	if err := l.ProcessPendingProofs(); err != nil {
+		l.Log.Error("failed to update requested proofs", "err", err)
+		return false
+	}
+	if err := l.CancelSupersededProofs(ctx); err != nil {
+		l.Log.Error("failed to cancel superseded proofs", "err", err)
+		return false
+	}
+	return true
+}
+
+func (l *L2OutputSubmitter) deriveAggProofs(ctx context.Context) bool {
+	// 3) Determine if there is a continguous chain of span proofs starting from the latest block on the L2OO contract.
+	// If there is, queue an aggregate proof for all of the span proofs.
+	l.Log.Info("Stage 3: Deriving Agg Proofs...")
+	if err := l.DeriveAggProofs(ctx); err != nil {
+		l.Log.Error("failed to generate pending agg proofs", "err", err)
+		return false
+	}
+	return true
+}
+
+func (l *L2OutputSubmitter) requestQueuedProofs(ctx context.Context) bool {
+	// 4) Request all unrequested proofs from the prover network.
+	// Any DB entry with status = "UNREQ" means it's queued up and ready.
+	// We request all of these (both span and agg) from the prover network.
+	// For agg proofs, we also checkpoint the blockhash in advance.
+	l.Log.Info("Stage 4: Requesting Queued Proofs...")
+	if err := l.RequestQueuedProofs(ctx); err != nil {
+		l.Log.Error("failed to request unrequested proofs", "err", err)
+		return false
+	}
+	return true
+}
+
+func (l *L2OutputSubmitter) submitOutputs(ctx context.Context) {
+	// 5) Submit agg proofs on chain.
+	// If we have a completed agg proof waiting in the DB, we submit them on chain. In shadow mode, its output
+	// is compared against the incumbent proposer's instead.
+	if l.shadow != nil {
+		l.Log.Info("Stage 5: Comparing Outputs Against Incumbent Proposer...")
+		if err := l.CompareShadowOutputs(ctx); err != nil {
+			l.Log.Error("failed to compare outputs against incumbent proposer", "err", err)
 		}
+		return
+	}
+	l.Log.Info("Stage 5: Submitting Agg Proofs...")
+	if err := l.SubmitAggProofs(ctx); err != nil {
+		l.Log.Error("failed to submit agg proofs", "err", err)
 	}
 }
 
@@ -665,7 +1129,7 @@ func (l *L2OutputSubmitter) loopL2OO(ctx context.Context) {
 // of the interval itself, for which it uses an internal ticker.
 func (l *L2OutputSubmitter) loopDGF(ctx context.Context) {
 	defer l.Log.Info("loopDGF returning")
-	ticker := time.NewTicker(l.Cfg.ProposalInterval)
+	ticker := time.NewTicker(l.config().ProposalInterval)
 	defer ticker.Stop()
 	for {
 		select {
@@ -687,11 +1151,26 @@ func (l *L2OutputSubmitter) loopDGF(ctx context.Context) {
 				output, err = l.FetchDGFOutput(ctx)
 				if err != nil {
 					l.Log.Warn("Error getting DGF output, retrying...", "err", err)
-					time.Sleep(l.Cfg.OutputRetryInterval)
+					time.Sleep(l.config().OutputRetryInterval)
 				}
 			}
 
-			l.proposeOutput(ctx, output, nil, 0, common.Hash{})
+			if !l.isActive(ctx) {
+				continue
+			}
+			// Like agg proofs, proposals wait for a submission window.
+			if ok, err := l.canSubmit(ctx, time.Now()); err != nil || !ok {
+				if err != nil {
+					l.Log.Error("failed to check the submission windows", "err", err)
+				}
+				continue
+			}
+			workCtx, cancelWork := l.leaderContext(ctx)
+			l.proposeOutput(workCtx, output, nil, 0, common.Hash{})
+			cancelWork()
+		case update := <-l.reloadCh:
+			l.applyConfigUpdate(update)
+			l.Log.Info("Reloaded config")
 		case <-l.done:
 			return
 		}
@@ -714,7 +1193,76 @@ func (l *L2OutputSubmitter) proposeOutput(ctx context.Context, output *eth.Outpu
 	l.Metr.RecordL2BlocksProposed(output.BlockRef)
 }
 
-func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, common.Hash, error) {
+// getReusableCheckpoint returns the L1 block hash checkpointed for a previous agg proof, if the block is within the
+// checkpoint reuse window, still part of the canonical L1 chain, and late enough to derive the agg proof's end block.
+func (l *L2OutputSubmitter) getReusableCheckpoint(ctx context.Context, endBlock uint64) (uint64, common.Hash, bool) {
+	if l.config().CheckpointReuseWindow == 0 {
+		return 0, common.Hash{}, false
+	}
+
+	blockNumber, blockHash, err := l.db.GetLatestCheckpointedL1Block()
+	if err != nil {
+		l.Log.Warn("failed to get latest checkpointed L1 block", "err", err)
+		return 0, common.Hash{}, false
+	}
+	if blockHash == "" {
+		return 0, common.Hash{}, false
+	}
+
+	cCtx, cancel := context.WithTimeout(ctx, l.config().NetworkTimeout)
+	defer cancel()
+	header, err := l.L1Client.HeaderByNumber(cCtx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		l.Log.Warn("failed to get header of checkpointed L1 block", "err", err, "block", blockNumber)
+		return 0, common.Hash{}, false
+	}
+	if header.Hash() != common.HexToHash(blockHash) {
+		l.Log.Info("checkpointed L1 block was reorged, checkpointing a new block hash", "block", blockNumber)
+		return 0, common.Hash{}, false
+	}
+	age := time.Since(time.Unix(int64(header.Time), 0))
+	if age > l.config().CheckpointReuseWindow {
+		return 0, common.Hash{}, false
+	}
+	// The agg proof derives the L2 chain up to its end block from L1 up to the checkpointed block, so the block must
+	// include the batches of the end block.
+	rollupClient, err := l.RollupProvider.RollupClient(cCtx)
+	if err != nil {
+		l.Log.Warn("failed to get rollup client", "err", err)
+		return 0, common.Hash{}, false
+	}
+	if ok, err := l1BlockDerivesL2Block(cCtx, rollupClient, blockNumber, endBlock); err != nil || !ok {
+		if err != nil {
+			l.Log.Warn("failed to check that checkpointed L1 block derives the agg proof's end block", "err", err, "block", blockNumber)
+		}
+		return 0, common.Hash{}, false
+	}
+
+	l.Log.Info("reusing checkpointed L1 block hash", "block", blockNumber, "hash", blockHash, "age", age)
+	return blockNumber, header.Hash(), true
+}
+
+// l1BlockDerivesL2Block returns whether the L2 safe head derived from L1 up to the given L1 block is at or past the
+// given L2 block, i.e. whether the batches of the L2 block are included by then.
+func l1BlockDerivesL2Block(ctx context.Context, rollupClient dial.RollupClientInterface, l1Block, l2Block uint64) (bool, error) {
+	provider, ok := rollupClient.(safeHeadProvider)
+	if !ok {
+		return false, errSafeHeadDBUnsupported
+	}
+	resp, err := provider.SafeHeadAtL1Block(ctx, l1Block)
+	if err != nil {
+		return false, fmt.Errorf("failed to get safe head at L1 block %d: %w", l1Block, err)
+	}
+	return resp.SafeHead.Number >= l2Block, nil
+}
+
+// checkpointBlockHash checkpoints an L1 block hash on the L2OO for an agg proof. Errors match ErrCheckpointFailed.
+func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (_ uint64, _ common.Hash, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%w: %w", ErrCheckpointFailed, err)
+		}
+	}()
 	cCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
@@ -722,12 +1270,53 @@ func (l *L2OutputSubmitter) checkpointBlockHash(ctx context.Context) (uint64, co
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-	header, err := l.L1Client.HeaderByNumber(cCtx, new(big.Int).SetUint64(currBlockNum-1))
+	checkpointBlockNum, err := l.selectL1HeadBlock(cCtx, currBlockNum)
+	if err != nil {
+		return 0, common.Hash{}, err
+	}
+	// The block hash is only available on-chain for the 256 most recent blocks.
+	if currBlockNum-checkpointBlockNum > 256 {
+		return 0, common.Hash{}, fmt.Errorf("selected L1 head %d is too far behind the current L1 head %d to checkpoint", checkpointBlockNum, currBlockNum)
+	}
+	header, err := l.L1Client.HeaderByNumber(cCtx, new(big.Int).SetUint64(checkpointBlockNum))
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
 	blockHash := header.Hash()
 	blockNumber := header.Number
 
+	// No transactions are sent in shadow mode. The agg proof commits to the L1 head without it being checkpointed.
+	if l.shadow != nil {
+		return blockNumber.Uint64(), blockHash, nil
+	}
 	return l.sendCheckpointTransaction(cCtx, blockNumber, blockHash)
 }
+
+// selectL1HeadBlock selects the L1 block to checkpoint for an agg proof, according to the configured L1 head policy.
+func (l *L2OutputSubmitter) selectL1HeadBlock(ctx context.Context, currBlockNum uint64) (uint64, error) {
+	var base uint64
+	switch l.config().AggProofL1HeadPolicy {
+	case L1HeadPolicyLatest, "":
+		// The hash of the current block isn't available on-chain yet, so the previous block is used.
+		return currBlockNum - 1, nil
+	case L1HeadPolicyConfirmations:
+		base = currBlockNum - 1
+	case L1HeadPolicySafe, L1HeadPolicyFinalized:
+		tag := rpc.SafeBlockNumber
+		if l.config().AggProofL1HeadPolicy == L1HeadPolicyFinalized {
+			tag = rpc.FinalizedBlockNumber
+		}
+		header, err := l.L1Client.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+		if err != nil {
+			return 0, fmt.Errorf("failed to get L1 %s head: %w", l.config().AggProofL1HeadPolicy, err)
+		}
+		base = header.Number.Uint64()
+	default:
+		return 0, fmt.Errorf("unknown L1 head policy: %s", l.config().AggProofL1HeadPolicy)
+	}
+
+	if base < l.config().AggProofL1HeadOffset {
+		return 0, fmt.Errorf("L1 head %d is lower than the L1 head offset %d", base, l.config().AggProofL1HeadOffset)
+	}
+	return base - l.config().AggProofL1HeadOffset, nil
+}
@@ -0,0 +1,125 @@
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoopStageIntervals tests that the stages of the driver loop run on their own intervals, within the ticks of the
+// shortest one.
+func TestLoopStageIntervals(t *testing.T) {
+	cfg := ProposerConfig{PollInterval: 12 * time.Second, PendingProofsInterval: 2 * time.Second, AggProofsInterval: 30 * time.Second}
+	tick := cfg.loopTick()
+	require.Equal(t, 2*time.Second, tick)
+	require.Equal(t, 12*time.Second, cfg.stageInterval(cfg.RequestProofsInterval))
+
+	var pending, agg, request loopStage
+	start := time.Now()
+	runs := map[string]int{}
+	step := func(stage *loopStage, name string, interval time.Duration) loopStep {
+		return loopStep{stage, interval, func() bool {
+			runs[name]++
+			return true
+		}}
+	}
+	for i := 0; i < 30; i++ {
+		// Ticks are delivered slightly early or late.
+		now := start.Add(time.Duration(i)*tick + time.Duration(i%3-1)*10*time.Millisecond)
+		runLoopSteps(now, tick, []loopStep{
+			step(&pending, "pending", cfg.stageInterval(cfg.PendingProofsInterval)),
+			step(&agg, "agg", cfg.stageInterval(cfg.AggProofsInterval)),
+			step(&request, "request", cfg.stageInterval(cfg.RequestProofsInterval)),
+		})
+	}
+	// Over 60 seconds of ticks, starting with a run of every stage.
+	require.Equal(t, 30, runs["pending"])
+	require.Equal(t, 2, runs["agg"])
+	require.Equal(t, 5, runs["request"])
+}
+
+// TestRunLoopStepsAfterFailure tests that the stages skipped by a failing step run on the next tick, and that steps
+// without a stage only run when a stage is due.
+func TestRunLoopStepsAfterFailure(t *testing.T) {
+	var first, second, third loopStage
+	var runs []string
+	fail := true
+	steps := func() []loopStep {
+		return []loopStep{
+			{&first, time.Minute, func() bool { runs = append(runs, "first"); return true }},
+			{nil, 0, func() bool { runs = append(runs, "gate"); return true }},
+			{&second, time.Minute, func() bool { runs = append(runs, "second"); return !fail }},
+			{&third, time.Minute, func() bool { runs = append(runs, "third"); return true }},
+		}
+	}
+
+	start := time.Now()
+	runLoopSteps(start, time.Second, steps())
+	require.Equal(t, []string{"first", "gate", "second"}, runs)
+
+	// The failed stage waits out its interval, but the stage it skipped runs on the next tick.
+	runs, fail = nil, false
+	runLoopSteps(start.Add(time.Second), time.Second, steps())
+	require.Equal(t, []string{"gate", "third"}, runs)
+
+	// Nothing is due.
+	runs = nil
+	runLoopSteps(start.Add(2*time.Second), time.Second, steps())
+	require.Empty(t, runs)
+
+	runLoopSteps(start.Add(time.Minute), time.Second, steps())
+	require.Equal(t, []string{"first", "gate", "second"}, runs)
+}
+
+// TestApplyConfigUpdate tests that reloading the config doesn't race with the background workers that read it.
+func TestApplyConfigUpdate(t *testing.T) {
+	l := &L2OutputSubmitter{}
+	l.Cfg = ProposerConfig{PollInterval: 12 * time.Second, MaxConcurrentProofRequests: 4}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			_ = l.config().MaxConcurrentProofRequests
+		}
+	}()
+	for i := uint64(0); i < 100; i++ {
+		l.applyConfigUpdate(func(cfg *ProposerConfig) { cfg.MaxConcurrentProofRequests = i })
+	}
+	<-done
+
+	require.Equal(t, uint64(99), l.config().MaxConcurrentProofRequests)
+	require.Equal(t, 12*time.Second, l.config().PollInterval)
+	// The initial config is left as is.
+	require.Equal(t, uint64(4), l.Cfg.MaxConcurrentProofRequests)
+}
+
+// safeHeadRollupClient returns the L2 safe head derived from each L1 block.
+type safeHeadRollupClient struct {
+	dial.RollupClientInterface
+	safeHeads map[uint64]uint64
+}
+
+func (c *safeHeadRollupClient) SafeHeadAtL1Block(_ context.Context, blockNum uint64) (*eth.SafeHeadResponse, error) {
+	return &eth.SafeHeadResponse{L1Block: eth.BlockID{Number: blockNum}, SafeHead: eth.BlockID{Number: c.safeHeads[blockNum]}}, nil
+}
+
+// TestL1BlockDerivesL2Block tests that a checkpointed L1 block is only reused for agg proofs whose end block is derived
+// from L1 up to it, even if it's recent enough to be reused.
+func TestL1BlockDerivesL2Block(t *testing.T) {
+	client := &safeHeadRollupClient{safeHeads: map[uint64]uint64{1000: 500, 1010: 600}}
+
+	ok, err := l1BlockDerivesL2Block(context.Background(), client, 1000, 550)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = l1BlockDerivesL2Block(context.Background(), client, 1010, 550)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = l1BlockDerivesL2Block(context.Background(), client.RollupClientInterface, 1010, 550)
+	require.ErrorIs(t, err, errSafeHeadDBUnsupported)
+}
@@ -0,0 +1,55 @@
+package e2e
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Devnet holds the endpoints and contracts of a local L1 and L2 devnet that the proposer runs against. The harness
+// doesn't start the devnet itself: it's started outside the test, e.g. by op-e2e or by a Kurtosis enclave, with the
+// L2OO contract deployed.
+type Devnet struct {
+	L1Rpc     string
+	BeaconRpc string
+	L2Rpc     string
+	RollupRpc string
+	// The OPSuccinctL2OutputOracle, deployed with the SP1 mock verifier.
+	L2OOAddress common.Address
+	// The hex-encoded private key of the proposer, which must be an approved proposer on the L2OO contract.
+	ProposerKey string
+}
+
+// The environment variables that DevnetFromEnv reads.
+const (
+	EnvL1Rpc       = "E2E_L1_RPC"
+	EnvBeaconRpc   = "E2E_BEACON_RPC"
+	EnvL2Rpc       = "E2E_L2_RPC"
+	EnvRollupRpc   = "E2E_ROLLUP_RPC"
+	EnvL2OOAddress = "E2E_L2OO_ADDRESS"
+	EnvProposerKey = "E2E_PROPOSER_KEY"
+)
+
+// DevnetFromEnv reads the devnet from the E2E_* environment variables, and skips the test if they aren't set, so that
+// e2e tests only run where a devnet is available.
+func DevnetFromEnv(t testing.TB) Devnet {
+	d := Devnet{
+		L1Rpc:       os.Getenv(EnvL1Rpc),
+		BeaconRpc:   os.Getenv(EnvBeaconRpc),
+		L2Rpc:       os.Getenv(EnvL2Rpc),
+		RollupRpc:   os.Getenv(EnvRollupRpc),
+		ProposerKey: os.Getenv(EnvProposerKey),
+	}
+	if d.L1Rpc == "" || d.RollupRpc == "" || d.ProposerKey == "" || os.Getenv(EnvL2OOAddress) == "" {
+		t.Skipf("devnet not configured, set %s, %s, %s and %s to run e2e tests", EnvL1Rpc, EnvRollupRpc, EnvL2OOAddress, EnvProposerKey)
+	}
+	if !common.IsHexAddress(os.Getenv(EnvL2OOAddress)) {
+		t.Fatalf("invalid %s: %s", EnvL2OOAddress, os.Getenv(EnvL2OOAddress))
+	}
+	d.L2OOAddress = common.HexToAddress(os.Getenv(EnvL2OOAddress))
+	if d.BeaconRpc == "" {
+		d.BeaconRpc = d.L1Rpc
+	}
+	return d
+}
@@ -0,0 +1,120 @@
+// Package e2e is a harness to run the proposer against a local L1 and L2 devnet with a mock prover, so that the full
+// pipeline, from span proof requests to output submissions, can be tested from Go. The devnet is started outside the
+// harness, and described to it by the E2E_* environment variables.
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer"
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// Harness runs a proposer against a devnet.
+type Harness struct {
+	Devnet  Devnet
+	Prover  *MockProver
+	Service *proposer.ProposerService
+
+	l2oo *bindings.OPSuccinctL2OutputOracleCaller
+}
+
+// NewHarness starts a proposer against the devnet and the mock prover, with a fresh DB. The args are passed to the
+// proposer as CLI flags after the harness's own, e.g. to set a smaller max-block-range-per-span-proof. The proposer is
+// stopped when the test finishes.
+func NewHarness(t testing.TB, devnet Devnet, prover *MockProver, args ...string) *Harness {
+	h := &Harness{Devnet: devnet, Prover: prover}
+
+	l1Client, err := ethclient.Dial(devnet.L1Rpc)
+	if err != nil {
+		t.Fatalf("failed to dial L1: %v", err)
+	}
+	t.Cleanup(l1Client.Close)
+	if h.l2oo, err = bindings.NewOPSuccinctL2OutputOracleCaller(devnet.L2OOAddress, l1Client); err != nil {
+		t.Fatalf("failed to bind L2OO: %v", err)
+	}
+
+	args = append([]string{
+		"op-proposer",
+		"--" + flags.L1EthRpcFlag.Name, devnet.L1Rpc,
+		"--" + flags.BeaconRpcFlag.Name, devnet.BeaconRpc,
+		"--" + flags.RollupRpcFlag.Name, devnet.RollupRpc,
+		"--" + flags.L2OOAddressFlag.Name, devnet.L2OOAddress.Hex(),
+		"--" + flags.OPSuccinctServerUrlFlag.Name, prover.URL,
+		"--" + flags.DbPathFlag.Name, t.TempDir(),
+		"--" + flags.PollIntervalFlag.Name, "1s",
+		"--" + txmgr.PrivateKeyFlagName, devnet.ProposerKey,
+		"--" + oprpc.PortFlagName, "0",
+	}, args...)
+	if devnet.L2Rpc != "" {
+		args = append(args, "--"+flags.L2EthRpcFlag.Name, devnet.L2Rpc)
+	}
+
+	logger := testlog.Logger(t, log.LevelInfo)
+	app := cli.NewApp()
+	app.Flags = cliapp.ProtectFlags(flags.Flags)
+	app.Action = func(cliCtx *cli.Context) error {
+		if err := flags.CheckRequired(cliCtx); err != nil {
+			return err
+		}
+		cfg := proposer.NewConfig(cliCtx)
+		if err := cfg.Check(); err != nil {
+			return fmt.Errorf("invalid CLI flags: %w", err)
+		}
+		h.Service, err = proposer.ProposerServiceFromCLIConfig(cliCtx.Context, "e2e", cfg, logger)
+		return err
+	}
+	if err := app.Run(args); err != nil {
+		t.Fatalf("failed to create proposer: %v", err)
+	}
+
+	if err := h.Service.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start proposer: %v", err)
+	}
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := h.Service.Stop(ctx); err != nil {
+			t.Errorf("failed to stop proposer: %v", err)
+		}
+	})
+	return h
+}
+
+// LatestOutputBlock returns the L2 block of the latest output on the L2OO contract.
+func (h *Harness) LatestOutputBlock(ctx context.Context) (uint64, error) {
+	latest, err := h.l2oo.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest output block: %w", err)
+	}
+	return latest.Uint64(), nil
+}
+
+// WaitForOutput waits until the proposer submitted an output at or after the given L2 block.
+func (h *Harness) WaitForOutput(ctx context.Context, block uint64) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		latest, err := h.LatestOutputBlock(ctx)
+		if err == nil && latest >= block {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("no output at L2 block %d, latest output is at %d: %w", block, latest, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
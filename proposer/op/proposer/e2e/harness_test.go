@@ -0,0 +1,67 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer"
+)
+
+// TestMockProverFulfillsProofs tests the mock prover with the proposer's HTTP client, with each of the request body
+// encodings.
+func TestMockProverFulfillsProofs(t *testing.T) {
+	for _, compression := range []string{proposer.ServerCompressionNone, proposer.ServerCompressionGzip, proposer.ServerCompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			prover := NewMockProver(t, 2)
+			prover.LeaveUnclaimed(1)
+			client := proposer.NewServerHTTPClient(proposer.ProposerConfig{ServerCompression: compression})
+
+			request := func(start, end uint64) string {
+				body, err := json.Marshal(proposer.SpanProofRequest{Start: start, End: end})
+				require.NoError(t, err)
+				resp, err := client.Post(prover.URL+"/request_span_proof", "application/json", bytes.NewReader(body))
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				require.Equal(t, http.StatusOK, resp.StatusCode)
+				var proof proposer.ProofResponse
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&proof))
+				return proof.ProofID
+			}
+			status := func(id string) string {
+				resp, err := client.Get(prover.URL + "/status/" + id)
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				var status proposer.ProofStatus
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+				return status.Status
+			}
+
+			unclaimed, fulfilled := request(100, 110), request(110, 120)
+			require.Equal(t, "PROOF_UNCLAIMED", status(unclaimed))
+			require.Equal(t, "PROOF_REQUESTED", status(fulfilled))
+			require.Equal(t, "PROOF_FULFILLED", status(fulfilled))
+			require.Equal(t, []MockProofRequest{
+				{ID: "span-0", Type: "span", Start: 100, End: 110, Status: "PROOF_UNCLAIMED", polls: 1},
+				{ID: "span-1", Type: "span", Start: 110, End: 120, Status: "PROOF_FULFILLED", polls: 2},
+			}, prover.Requests())
+		})
+	}
+}
+
+// TestProposerSubmitsOutputs runs the full pipeline against a devnet, and only runs if one is configured with the E2E_*
+// environment variables.
+func TestProposerSubmitsOutputs(t *testing.T) {
+	devnet := DevnetFromEnv(t)
+	h := NewHarness(t, devnet, NewMockProver(t, 1), "--max-block-range-per-span-proof", "10")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	latest, err := h.LatestOutputBlock(ctx)
+	require.NoError(t, err)
+	require.NoError(t, h.WaitForOutput(ctx, latest+1))
+}
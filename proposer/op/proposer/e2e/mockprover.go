@@ -0,0 +1,199 @@
+package e2e
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/succinctlabs/op-succinct-go/proposer"
+)
+
+// MockProofRequest is a proof request received by the mock prover.
+type MockProofRequest struct {
+	ID    string
+	Type  string
+	Start uint64
+	End   uint64
+	// The status that the mock prover reports for the proof.
+	Status string
+	polls  int
+}
+
+// MockProver is an OP Succinct server that fulfills proofs without proving them, so that the proposer pipeline can be
+// tested against a devnet whose L2OO contract uses the SP1 mock verifier. Its proofs are empty, which is what the mock
+// verifier accepts. Like the OP Succinct server, it accepts request bodies compressed with any of the proposer's
+// --server-compression encodings.
+type MockProver struct {
+	URL string
+
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	requests map[string]*MockProofRequest
+	order    []string
+	// The number of status polls after which a proof is fulfilled.
+	pollsToFulfill int
+	// The number of upcoming proof requests to leave unclaimed.
+	unclaimed int
+}
+
+// NewMockProver starts a mock prover that fulfills each proof on its pollsToFulfill-th status poll. It's closed when
+// the test finishes.
+func NewMockProver(t testing.TB, pollsToFulfill int) *MockProver {
+	m := &MockProver{requests: make(map[string]*MockProofRequest), pollsToFulfill: pollsToFulfill}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /version", m.handleVersion)
+	mux.HandleFunc("POST /request_span_proof", m.handleSpanProof)
+	mux.HandleFunc("POST /request_span_proofs", m.handleSpanProofs)
+	mux.HandleFunc("POST /request_agg_proof", m.handleAggProof)
+	mux.HandleFunc("GET /status/{id}", m.handleStatus)
+	mux.HandleFunc("POST /cancel/{id}", m.handleCancel)
+	m.srv = httptest.NewServer(decompressRequests(mux))
+	m.URL = m.srv.URL
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+// LeaveUnclaimed makes the mock prover report the next n proof requests as unclaimed, so that tests can exercise the
+// proposer's retries.
+func (m *MockProver) LeaveUnclaimed(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.unclaimed += n
+}
+
+// Requests returns the proof requests received so far, in the order they were received.
+func (m *MockProver) Requests() []MockProofRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reqs := make([]MockProofRequest, 0, len(m.order))
+	for _, id := range m.order {
+		reqs = append(reqs, *m.requests[id])
+	}
+	return reqs
+}
+
+func (m *MockProver) add(proofType string, start, end uint64) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	req := &MockProofRequest{ID: fmt.Sprintf("%s-%d", proofType, len(m.order)), Type: proofType, Start: start, End: end, Status: "PROOF_REQUESTED"}
+	if m.unclaimed > 0 {
+		m.unclaimed--
+		req.Status = "PROOF_UNCLAIMED"
+	}
+	m.requests[req.ID] = req
+	m.order = append(m.order, req.ID)
+	return req.ID
+}
+
+func (m *MockProver) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, proposer.ServerVersion{
+		APIVersion: proposer.ServerAPIVersion,
+		Features:   []string{proposer.ServerFeatureBatchSpanRequests},
+	})
+}
+
+func (m *MockProver) handleSpanProof(w http.ResponseWriter, r *http.Request) {
+	var req proposer.SpanProofRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, proposer.ProofResponse{ProofID: m.add("span", req.Start, req.End)})
+}
+
+func (m *MockProver) handleSpanProofs(w http.ResponseWriter, r *http.Request) {
+	var req proposer.SpanProofsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var resp proposer.ProofsResponse
+	for _, span := range req.Requests {
+		resp.ProofIDs = append(resp.ProofIDs, m.add("span", span.Start, span.End))
+	}
+	writeJSON(w, resp)
+}
+
+func (m *MockProver) handleAggProof(w http.ResponseWriter, r *http.Request) {
+	var req proposer.AggProofRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, proposer.ProofResponse{ProofID: m.add("agg", 0, 0)})
+}
+
+func (m *MockProver) handleStatus(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	req, ok := m.requests[r.PathValue("id")]
+	if !ok {
+		http.Error(w, "unknown proof", http.StatusNotFound)
+		return
+	}
+	req.polls++
+	if req.Status == "PROOF_REQUESTED" && req.polls >= m.pollsToFulfill {
+		req.Status = "PROOF_FULFILLED"
+	}
+	status := proposer.ProofStatus{Status: req.Status}
+	if req.Status == "PROOF_FULFILLED" {
+		status.Proof = []byte{}
+		status.Prover = "mock"
+	}
+	writeJSON(w, status)
+}
+
+func (m *MockProver) handleCancel(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if req, ok := m.requests[r.PathValue("id")]; ok && req.Status != "PROOF_FULFILLED" {
+		req.Status = "PROOF_CANCELLED"
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// decompressRequests decompresses the bodies of requests according to their Content-Encoding.
+func decompressRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body io.ReadCloser
+		switch encoding := r.Header.Get("Content-Encoding"); encoding {
+		case "", proposer.ServerCompressionNone:
+			next.ServeHTTP(w, r)
+			return
+		case proposer.ServerCompressionGzip:
+			gr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			body = gr
+		case proposer.ServerCompressionZstd:
+			zr, err := zstd.NewReader(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			body = zr.IOReadCloser()
+		default:
+			http.Error(w, "unsupported content encoding: "+encoding, http.StatusUnsupportedMediaType)
+			return
+		}
+		defer body.Close()
+		r.Body = body
+		r.Header.Del("Content-Encoding")
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,129 @@
+package proposer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// The errors that the proposer returns for its failure modes, so that callers can branch on them with errors.Is and
+// errors.As instead of matching error strings.
+var (
+	// ErrServerUnreachable is returned when a request to the OP Succinct server fails without a response.
+	ErrServerUnreachable = errors.New("OP Succinct server unreachable")
+	// ErrProofUnclaimed is the reason a proof is retried when no prover on the network claimed it.
+	ErrProofUnclaimed = errors.New("proof unclaimed by the prover network")
+	// ErrProofTimedOut is the reason a proof is retried when it wasn't fulfilled within the proof timeout.
+	ErrProofTimedOut = errors.New("proof timed out")
+	// ErrCoverageGap is matched by the db.CoverageError returned when span proofs don't cover an agg proof range.
+	ErrCoverageGap = db.ErrCoverageGap
+	// ErrCheckpointFailed is returned when the L1 block hash for an agg proof couldn't be checkpointed on the L2OO.
+	ErrCheckpointFailed = errors.New("failed to checkpoint L1 block hash")
+	// ErrVerifierReverted is matched by the RevertError returned when a transaction to the L2OO reverted, e.g.
+	// because the verifier rejected the proof.
+	ErrVerifierReverted = errors.New("L2OO transaction reverted")
+	// ErrServerBusy is matched by the ServerBusyError returned when the OP Succinct server asks the proposer to back
+	// off, with status 429 or 503.
+	ErrServerBusy = errors.New("OP Succinct server busy")
+	// ErrServerIncompatible is returned at startup when the OP Succinct server speaks a different API version, or
+	// proves different programs than the L2OO contract verifies.
+	ErrServerIncompatible = errors.New("OP Succinct server incompatible")
+	// ErrOutputRootMismatch is returned when the output root committed by an agg proof doesn't match the output root
+	// that the rollup node computes for its end block.
+	ErrOutputRootMismatch = errors.New("agg proof output root mismatch")
+	// ErrNoL2OutputOracle is returned by the APIs that read the latest output from the L2OO contract when the proposer
+	// proposes outputs to a dispute game factory instead.
+	ErrNoL2OutputOracle = errors.New("no L2OO contract configured")
+)
+
+// RevertError is returned when a transaction to the L2OO was included in a block but reverted.
+type RevertError struct {
+	// The name of the method that was called.
+	Method string
+	TxHash common.Hash
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("%s transaction %s reverted", e.Method, e.TxHash)
+}
+
+func (e *RevertError) Is(target error) bool {
+	return target == ErrVerifierReverted
+}
+
+// ServerError is the error envelope that the OP Succinct server returns with an unsuccessful response. Requests that
+// failed with a retryable error are queued again, the others are dropped.
+type ServerError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Retryable  bool
+}
+
+func (e *ServerError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("OP Succinct server returned status %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("OP Succinct server returned status %d (%s): %s", e.StatusCode, e.Code, e.Message)
+}
+
+// newServerError parses the error envelope from the body of an unsuccessful response. Servers that don't return an
+// envelope are assumed to have failed with a retryable error if the status is a server error.
+func newServerError(statusCode int, body []byte) *ServerError {
+	serverErr := &ServerError{StatusCode: statusCode, Message: string(body), Retryable: statusCode >= 500}
+	var envelope struct {
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		Retryable *bool  `json:"retryable"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && (envelope.Code != "" || envelope.Message != "") {
+		serverErr.Code = envelope.Code
+		serverErr.Message = envelope.Message
+		if envelope.Retryable != nil {
+			serverErr.Retryable = *envelope.Retryable
+		}
+	}
+	return serverErr
+}
+
+// ServerBusyError is returned when the OP Succinct server responded with status 429 or 503. RetryAfter is how long the
+// server asked to wait before the next request.
+type ServerBusyError struct {
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *ServerBusyError) Error() string {
+	return fmt.Sprintf("OP Succinct server busy with status %d, retry after %s", e.StatusCode, e.RetryAfter)
+}
+
+func (e *ServerBusyError) Is(target error) bool {
+	return target == ErrServerBusy
+}
+
+// newFailure describes a proof request that failed at the given stage with the given error. The error code is the one
+// reported by the OP Succinct server, or one derived from the proposer's own errors.
+func newFailure(stage proofrequest.FailureStage, reason error) db.Failure {
+	failure := db.Failure{Stage: stage, Reason: reason.Error()}
+	var serverErr *ServerError
+	switch {
+	case errors.As(reason, &serverErr):
+		failure.Code = serverErr.Code
+	case errors.Is(reason, ErrServerUnreachable):
+		failure.Code = "SERVER_UNREACHABLE"
+	case errors.Is(reason, ErrServerBusy):
+		failure.Code = "SERVER_BUSY"
+	case errors.Is(reason, ErrProofUnclaimed):
+		failure.Code = "PROOF_UNCLAIMED"
+	case errors.Is(reason, ErrProofTimedOut):
+		failure.Code = "PROOF_TIMED_OUT"
+	case errors.Is(reason, ErrOutputRootMismatch):
+		failure.Code = "OUTPUT_ROOT_MISMATCH"
+	}
+	return failure
+}
@@ -0,0 +1,185 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+	"github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+// EventSchemaVersion is the version of the payloads of the lifecycle events. It's bumped when fields are removed or
+// change meaning, so that consumers can tell the payloads apart. Adding fields doesn't bump it.
+const EventSchemaVersion = 1
+
+// EventType is the type of a lifecycle event.
+type EventType string
+
+const (
+	// A proof was accepted by the OP Succinct server.
+	EventProofRequested EventType = "ProofRequested"
+	// A proof was fulfilled by the prover.
+	EventProofFulfilled EventType = "ProofFulfilled"
+	// A proof request failed, and is retried.
+	EventProofFailed EventType = "ProofFailed"
+	// An output was submitted to the L2OO contract.
+	EventOutputSubmitted EventType = "OutputSubmitted"
+)
+
+// Event is a lifecycle event, as published to the event bus. Proof events have the proof request, and output events
+// the submitted output.
+type Event struct {
+	Version int       `json:"version"`
+	Type    EventType `json:"type"`
+	// The Unix time the event happened at, in milliseconds.
+	Time    int64             `json:"time"`
+	ChainID uint64            `json:"chain_id"`
+	Proof   *ProofRequestInfo `json:"proof,omitempty"`
+	Output  *OutputEvent      `json:"output,omitempty"`
+}
+
+// OutputEvent is an output submitted to the L2OO contract.
+type OutputEvent struct {
+	L2BlockNumber uint64      `json:"l2_block_number"`
+	OutputRoot    common.Hash `json:"output_root"`
+	TxHash        common.Hash `json:"tx_hash"`
+	L1BlockNumber uint64      `json:"l1_block_number"`
+}
+
+// The limits of the event publisher. Events are dropped if the queue is full, so that a slow or unavailable broker
+// doesn't stall the driver. The event bus clients reconnect and retry on their own, until the publish timeout.
+const (
+	eventQueueSize      = 1000
+	eventPublishTimeout = 10 * time.Second
+)
+
+// eventPublisher publishes lifecycle events to the event bus in the background.
+type eventPublisher struct {
+	publisher utils.EventPublisher
+	chainID   uint64
+	log       log.Logger
+	metr      metrics.Metricer
+
+	queue     chan Event
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newEventPublisher(publisher utils.EventPublisher, chainID uint64, log log.Logger, metr metrics.Metricer) *eventPublisher {
+	e := &eventPublisher{
+		publisher: publisher,
+		chainID:   chainID,
+		log:       log,
+		metr:      metr,
+		queue:     make(chan Event, eventQueueSize),
+		done:      make(chan struct{}),
+	}
+	go e.run()
+	return e
+}
+
+// publish queues an event. A nil publisher drops all events, so that the driver doesn't need to check whether an event
+// bus is configured.
+func (e *eventPublisher) publish(ev Event) {
+	if e == nil {
+		return
+	}
+	ev.Version = EventSchemaVersion
+	ev.Time = time.Now().UnixMilli()
+	ev.ChainID = e.chainID
+	select {
+	case e.queue <- ev:
+	default:
+		e.metr.RecordPublishedEvent(string(ev.Type), "dropped")
+		e.log.Warn("event queue is full, dropping event", "type", ev.Type)
+	}
+}
+
+func (e *eventPublisher) run() {
+	defer close(e.done)
+	for ev := range e.queue {
+		msg, err := json.Marshal(ev)
+		if err != nil {
+			e.log.Error("failed to encode event", "type", ev.Type, "err", err)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), eventPublishTimeout)
+		err = e.publisher.Publish(ctx, string(ev.Type), msg)
+		cancel()
+		result := "published"
+		if err != nil {
+			result = "failed"
+			e.log.Warn("failed to publish event", "type", ev.Type, "err", err)
+		}
+		e.metr.RecordPublishedEvent(string(ev.Type), result)
+	}
+}
+
+// Close publishes the queued events, and closes the connection to the broker.
+func (e *eventPublisher) Close() error {
+	if e == nil {
+		return nil
+	}
+	e.closeOnce.Do(func() { close(e.queue) })
+	<-e.done
+	return e.publisher.Close()
+}
+
+// publishProofEvent publishes a lifecycle event of the proof request with the given ID.
+func (l *L2OutputSubmitter) publishProofEvent(eventType EventType, id int) {
+	if l.events == nil {
+		return
+	}
+	p, err := l.db.GetProofRequestMetadata(id)
+	if err != nil {
+		l.Log.Warn("failed to load proof request for event", "type", eventType, "id", id, "err", err)
+		return
+	}
+	info := newProofRequestInfo(p)
+	l.events.publish(Event{Type: eventType, Proof: &info})
+}
+
+// publishProofUpdateEvents publishes the outcomes of the pending proofs that were applied to the DB. Updates that
+// were skipped, because they no longer applied, aren't published, and neither are the retries of requests that failed
+// before reaching the prover, which were published when they failed.
+func (l *L2OutputSubmitter) publishProofUpdateEvents(updates []db.ProofUpdate) {
+	if l.events == nil {
+		return
+	}
+	for _, u := range updates {
+		if u.Proof == nil && u.Failure == nil {
+			continue
+		}
+		eventType, status := EventProofFailed, proofrequest.StatusFAILED
+		if u.Proof != nil {
+			eventType, status = EventProofFulfilled, proofrequest.StatusCOMPLETE
+		}
+		p, err := l.db.GetProofRequestMetadata(u.ID)
+		if err != nil {
+			l.Log.Warn("failed to load proof request for event", "type", eventType, "id", u.ID, "err", err)
+			continue
+		}
+		if p.Status != status {
+			continue
+		}
+		info := newProofRequestInfo(p)
+		l.events.publish(Event{Type: eventType, Proof: &info})
+	}
+}
+
+// publishOutputEvent publishes the submission of an output.
+func (l *L2OutputSubmitter) publishOutputEvent(output *eth.OutputResponse, txHash common.Hash, l1BlockNumber uint64) {
+	l.events.publish(Event{Type: EventOutputSubmitted, Output: &OutputEvent{
+		L2BlockNumber: output.BlockRef.Number,
+		OutputRoot:    common.Hash(output.OutputRoot),
+		TxHash:        txHash,
+		L1BlockNumber: l1BlockNumber,
+	}})
+}
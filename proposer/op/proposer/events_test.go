@@ -0,0 +1,101 @@
+package proposer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
+)
+
+// fakeNATSServer accepts a single NATS client, and returns the subjects and payloads it publishes.
+func fakeNATSServer(t *testing.T) (string, <-chan [2]string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	msgs := make(chan [2]string, 10)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"max_payload\":1048576}\r\n")
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch {
+			case len(fields) == 3 && fields[0] == "PUB":
+				n, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, n+2)
+				if _, err := io.ReadFull(r, payload); err != nil {
+					return
+				}
+				msgs <- [2]string{fields[1], string(payload[:n])}
+			case len(fields) == 1 && fields[0] == "PING":
+				fmt.Fprint(conn, "PONG\r\n")
+			}
+		}
+	}()
+	return "nats://" + ln.Addr().String(), msgs
+}
+
+// TestEventPublisherNATS tests that events are published to NATS on a subject per event type.
+func TestEventPublisherNATS(t *testing.T) {
+	url, msgs := fakeNATSServer(t)
+	publisher, err := utils.NewEventPublisher(url, "proposer")
+	require.NoError(t, err)
+	events := newEventPublisher(publisher, 10, testlog.Logger(t, log.LevelInfo), metrics.NoopMetrics)
+
+	events.publish(Event{Type: EventProofRequested, Proof: &ProofRequestInfo{ID: 7, Type: "SPAN", StartBlock: 100, EndBlock: 200}})
+	events.publish(Event{Type: EventOutputSubmitted, Output: &OutputEvent{L2BlockNumber: 200}})
+	require.NoError(t, events.Close())
+
+	msg := <-msgs
+	require.Equal(t, "proposer.ProofRequested", msg[0])
+	var ev Event
+	require.NoError(t, json.Unmarshal([]byte(msg[1]), &ev))
+	require.Equal(t, EventSchemaVersion, ev.Version)
+	require.Equal(t, uint64(10), ev.ChainID)
+	require.Equal(t, 7, ev.Proof.ID)
+	require.Nil(t, ev.Output)
+
+	msg = <-msgs
+	require.Equal(t, "proposer.OutputSubmitted", msg[0])
+}
+
+// TestEventPublisherKafka tests that producing to an unavailable Kafka cluster fails once the publish times out,
+// instead of waiting for the cluster to come up.
+func TestEventPublisherKafka(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	publisher, err := utils.NewEventPublisher("kafka://"+addr, "proposer")
+	require.NoError(t, err)
+	defer publisher.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	require.Error(t, publisher.Publish(ctx, string(EventProofFulfilled), []byte(`{}`)))
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	_, err = utils.NewEventPublisher("kafka+http://"+addr, "proposer")
+	require.ErrorContains(t, err, "unsupported event bus scheme")
+}
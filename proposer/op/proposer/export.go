@@ -0,0 +1,86 @@
+package proposer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// ProofExportVersion is the version of the proof export format. It's bumped whenever a field changes meaning or is
+// removed, so that tools can reject exports they don't understand. Adding fields doesn't change the version.
+const ProofExportVersion = 1
+
+// ExportedProof is the canonical export format of a fulfilled proof. It carries everything needed to verify the proof
+// or relay it on-chain, so that other tools don't depend on the proposer's DB schema.
+type ExportedProof struct {
+	Version int    `json:"version"`
+	ChainID uint64 `json:"chain_id"`
+	// SPAN or AGG.
+	Type string `json:"type"`
+	// The L2 block range (StartBlock, EndBlock] that the proof covers.
+	StartBlock uint64 `json:"start_block"`
+	EndBlock   uint64 `json:"end_block"`
+
+	ProofSystem string `json:"proof_system,omitempty"`
+	// The hash of the verifying key of the program that produced the proof.
+	VkeyHash string `json:"vkey_hash,omitempty"`
+	// The encoding of the proof bytes, e.g. compressed or groth16.
+	ProofFormat    string        `json:"proof_format,omitempty"`
+	ProgramVersion string        `json:"program_version,omitempty"`
+	Proof          hexutil.Bytes `json:"proof"`
+	PublicValues   hexutil.Bytes `json:"public_values,omitempty"`
+
+	// The L1 block whose hash the agg proof commits to, and that's checkpointed on the L2OO contract before the proof
+	// is submitted. Only set for agg proofs.
+	L1BlockNumber uint64       `json:"l1_block_number,omitempty"`
+	L1BlockHash   *common.Hash `json:"l1_block_hash,omitempty"`
+
+	ProverRequestID string `json:"prover_request_id,omitempty"`
+	// The Unix time the proof was fulfilled at.
+	FulfilledTime uint64 `json:"fulfilled_time,omitempty"`
+}
+
+// ExportProof exports a fulfilled proof request of the L2 chain with the given chain ID, with its decoded proof bytes.
+func ExportProof(p *ent.ProofRequest, proof []byte, chainID uint64) (*ExportedProof, error) {
+	if p.Status != proofrequest.StatusCOMPLETE {
+		return nil, fmt.Errorf("proof request %d has status %s, only completed proofs can be exported", p.ID, p.Status)
+	}
+	export := &ExportedProof{
+		Version:         ProofExportVersion,
+		ChainID:         chainID,
+		Type:            p.Type.String(),
+		StartBlock:      p.StartBlock,
+		EndBlock:        p.EndBlock,
+		ProofSystem:     p.ProofSystem,
+		VkeyHash:        p.VkeyHash,
+		ProofFormat:     p.ProofFormat,
+		ProgramVersion:  p.ProgramVersion,
+		Proof:           proof,
+		PublicValues:    p.PublicValues,
+		ProverRequestID: p.ProverRequestID,
+		FulfilledTime:   p.FulfilledTime,
+	}
+	if p.Type == proofrequest.TypeAGG {
+		l1BlockHash := common.HexToHash(p.L1BlockHash)
+		export.L1BlockNumber = p.L1BlockNumber
+		export.L1BlockHash = &l1BlockHash
+	}
+	return export, nil
+}
+
+// ReadExportedProof decodes an exported proof, and rejects exports of a newer format version.
+func ReadExportedProof(r io.Reader) (*ExportedProof, error) {
+	var export ExportedProof
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to decode exported proof: %w", err)
+	}
+	if export.Version < 1 || export.Version > ProofExportVersion {
+		return nil, fmt.Errorf("unsupported proof export version %d, expected at most %d", export.Version, ProofExportVersion)
+	}
+	return &export, nil
+}
@@ -0,0 +1,38 @@
+package proposer
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestExportProofRoundTrip(t *testing.T) {
+	p := &ent.ProofRequest{
+		ID:            1,
+		Type:          proofrequest.TypeAGG,
+		Status:        proofrequest.StatusCOMPLETE,
+		StartBlock:    100,
+		EndBlock:      200,
+		VkeyHash:      "0xabcd",
+		PublicValues:  []byte{4, 5},
+		L1BlockNumber: 50,
+		L1BlockHash:   "0x01",
+	}
+	export, err := ExportProof(p, []byte{1, 2, 3}, 10)
+	require.NoError(t, err)
+	b, err := json.Marshal(export)
+	require.NoError(t, err)
+
+	read, err := ReadExportedProof(bytes.NewReader(b))
+	require.NoError(t, err)
+	require.Equal(t, export, read)
+	require.Equal(t, "AGG", read.Type)
+	require.Equal(t, []byte{1, 2, 3}, []byte(read.Proof))
+
+	_, err = ReadExportedProof(bytes.NewReader([]byte(`{"version":2}`)))
+	require.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package proposer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// ProofFixture is a completed agg proof exported as a Foundry test fixture, with everything needed to replay its
+// submission against the verifier and the L2OO contract: checkpoint the L1 head with CheckpointCalldata, then submit the
+// output with ProposeCalldata. Foundry's vm.parseJson decodes objects into structs by key in alphabetical order, so the
+// keys are sorted.
+type ProofFixture struct {
+	AggregationVkey    string        `json:"aggregationVkey,omitempty"`
+	CheckpointCalldata hexutil.Bytes `json:"checkpointCalldata"`
+	L1BlockNumber      uint64        `json:"l1BlockNumber"`
+	L1Head             common.Hash   `json:"l1Head"`
+	L2BlockNumber      uint64        `json:"l2BlockNumber"`
+	L2StartBlockNumber uint64        `json:"l2StartBlockNumber"`
+	OutputRoot         common.Hash   `json:"outputRoot"`
+	Proof              hexutil.Bytes `json:"proof"`
+	ProofSystem        string        `json:"proofSystem,omitempty"`
+	ProposeCalldata    hexutil.Bytes `json:"proposeCalldata"`
+	PublicValues       hexutil.Bytes `json:"publicValues"`
+}
+
+// NewProofFixture builds the fixture of a completed agg proof with the given decoded proof, and the output that the
+// rollup node computed for its end block.
+func NewProofFixture(aggProof *ent.ProofRequest, proof []byte, output *eth.OutputResponse) (*ProofFixture, error) {
+	if aggProof.Type != proofrequest.TypeAGG || aggProof.Status != proofrequest.StatusCOMPLETE {
+		return nil, fmt.Errorf("proof request %d is a %s proof with status %s, expected a completed agg proof", aggProof.ID, aggProof.Type, aggProof.Status)
+	}
+	if output.BlockRef.Number != aggProof.EndBlock {
+		return nil, fmt.Errorf("output is at L2 block %d, expected %d", output.BlockRef.Number, aggProof.EndBlock)
+	}
+
+	parsed, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	l1Head := common.HexToHash(aggProof.L1BlockHash)
+	checkpoint, err := parsed.Pack("checkpointBlockHash", new(big.Int).SetUint64(aggProof.L1BlockNumber), l1Head)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack checkpoint calldata: %w", err)
+	}
+	propose, err := proposeL2OutputTxData(parsed, output, proof, aggProof.L1BlockNumber, l1Head)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack proposal calldata: %w", err)
+	}
+
+	return &ProofFixture{
+		AggregationVkey:    aggProof.VkeyHash,
+		CheckpointCalldata: checkpoint,
+		L1BlockNumber:      aggProof.L1BlockNumber,
+		L1Head:             l1Head,
+		L2BlockNumber:      aggProof.EndBlock,
+		L2StartBlockNumber: aggProof.StartBlock,
+		OutputRoot:         common.Hash(output.OutputRoot),
+		Proof:              proof,
+		ProofSystem:        aggProof.ProofSystem,
+		ProposeCalldata:    propose,
+		PublicValues:       aggProof.PublicValues,
+	}, nil
+}
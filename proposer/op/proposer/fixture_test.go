@@ -0,0 +1,42 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	opsuccinctbindings "github.com/succinctlabs/op-succinct-go/bindings"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+func TestNewProofFixture(t *testing.T) {
+	aggProof := &ent.ProofRequest{
+		ID:            1,
+		Type:          proofrequest.TypeAGG,
+		Status:        proofrequest.StatusCOMPLETE,
+		StartBlock:    100,
+		EndBlock:      200,
+		L1BlockNumber: 50,
+		L1BlockHash:   common.HexToHash("0x01").Hex(),
+	}
+	output := &eth.OutputResponse{OutputRoot: eth.Bytes32{2}, BlockRef: eth.L2BlockRef{Number: 200}}
+
+	fixture, err := NewProofFixture(aggProof, []byte{3}, output)
+	require.NoError(t, err)
+
+	parsed, err := opsuccinctbindings.OPSuccinctL2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	args, err := parsed.Methods["proposeL2Output"].Inputs.Unpack(fixture.ProposeCalldata[4:])
+	require.NoError(t, err)
+	require.Equal(t, [32]byte(output.OutputRoot), args[0])
+	require.Equal(t, uint64(200), args[1].(*big.Int).Uint64())
+	require.Equal(t, [32]byte(common.HexToHash("0x01")), args[2])
+	require.Equal(t, []byte{3}, args[4])
+
+	aggProof.Status = proofrequest.StatusPROVING
+	_, err = NewProofFixture(aggProof, nil, output)
+	require.Error(t, err)
+}
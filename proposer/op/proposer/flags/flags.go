@@ -154,6 +154,365 @@ var (
 		Usage:   "Batch Sender Address",
 		EnvVars: prefixEnvVars("BATCHER_ADDRESS"),
 	}
+	SpanProofBatchSizeFlag = &cli.Uint64Flag{
+		Name:    "span-proof-batch-size",
+		Usage:   "Maximum number of span proofs to submit to the OP Succinct server in a single request",
+		Value:   1,
+		EnvVars: prefixEnvVars("SPAN_PROOF_BATCH_SIZE"),
+	}
+	MaxCyclesPerSpanProofFlag = &cli.Uint64Flag{
+		Name:    "max-cycles-per-span-proof",
+		Usage:   "Span proofs with a higher estimated cycle count are split before being requested. 0 means no limit",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_CYCLES_PER_SPAN_PROOF"),
+	}
+	MaxFeePerSpanProofFlag = &cli.Uint64Flag{
+		Name:    "max-fee-per-span-proof",
+		Usage:   "Span proofs with a higher estimated fee are split before being requested. 0 means no limit",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_FEE_PER_SPAN_PROOF"),
+	}
+	CheckpointReuseWindowFlag = &cli.DurationFlag{
+		Name:    "checkpoint-reuse-window",
+		Usage:   "Reuse an L1 block hash checkpointed for a previous agg proof if the block is at most this old. 0 checkpoints a new block hash for every agg proof",
+		Value:   0,
+		EnvVars: prefixEnvVars("CHECKPOINT_REUSE_WINDOW"),
+	}
+	AggProofL1HeadPolicyFlag = &cli.StringFlag{
+		Name:    "agg-proof-l1-head-policy",
+		Usage:   "How to select the L1 head checkpointed for agg proofs. One of: latest, confirmations, safe, finalized",
+		Value:   "latest",
+		EnvVars: prefixEnvVars("AGG_PROOF_L1_HEAD_POLICY"),
+	}
+	AggProofL1HeadOffsetFlag = &cli.Uint64Flag{
+		Name:    "agg-proof-l1-head-offset",
+		Usage:   "Number of blocks behind the block selected by the L1 head policy to checkpoint for agg proofs",
+		Value:   0,
+		EnvVars: prefixEnvVars("AGG_PROOF_L1_HEAD_OFFSET"),
+	}
+	MaxPipelinedAggProofsFlag = &cli.Uint64Flag{
+		Name:    "max-pipelined-agg-proofs",
+		Usage:   "Maximum number of agg proofs for consecutive output intervals to create ahead of the latest output on the L2OO contract",
+		Value:   1,
+		EnvVars: prefixEnvVars("MAX_PIPELINED_AGG_PROOFS"),
+	}
+	OPSuccinctSecondaryServerUrlFlag = &cli.StringFlag{
+		Name:    "op-succinct-secondary-server-url",
+		Usage:   "URL of a secondary OP Succinct server to fail over to when the fulfillment rate of the primary server degrades",
+		EnvVars: prefixEnvVars("OP_SUCCINCT_SECONDARY_SERVER_URL"),
+	}
+	FailoverMinFulfillmentRateFlag = &cli.Float64Flag{
+		Name:    "failover-min-fulfillment-rate",
+		Usage:   "Fail over to the secondary OP Succinct server when the share of recent proofs fulfilled by the primary drops below this rate",
+		Value:   0.5,
+		EnvVars: prefixEnvVars("FAILOVER_MIN_FULFILLMENT_RATE"),
+	}
+	FailoverCooldownFlag = &cli.DurationFlag{
+		Name:    "failover-cooldown",
+		Usage:   "How long to request proofs from the secondary OP Succinct server before switching back to the primary",
+		Value:   30 * time.Minute,
+		EnvVars: prefixEnvVars("FAILOVER_COOLDOWN"),
+	}
+	ProofSystemFlag = &cli.StringFlag{
+		Name:    "proof-system",
+		Usage:   "Proof system (zkVM) that the OP Succinct server generates proofs with",
+		Value:   "sp1",
+		EnvVars: prefixEnvVars("PROOF_SYSTEM"),
+	}
+	SpanProofTimeoutFlag = &cli.Uint64Flag{
+		Name:    "span-proof-timeout",
+		Usage:   "Maximum time in seconds to spend generating a span proof before giving up. If 0, proof-timeout is used",
+		Value:   0,
+		EnvVars: prefixEnvVars("SPAN_PROOF_TIMEOUT"),
+	}
+	AggProofTimeoutFlag = &cli.Uint64Flag{
+		Name:    "agg-proof-timeout",
+		Usage:   "Maximum time in seconds to spend generating an agg proof before giving up. If 0, proof-timeout is used",
+		Value:   0,
+		EnvVars: prefixEnvVars("AGG_PROOF_TIMEOUT"),
+	}
+	ProofTimeoutPerBlockFlag = &cli.Uint64Flag{
+		Name:    "proof-timeout-per-block",
+		Usage:   "Additional time in seconds allowed for a proof per L2 block in its range, so that larger proofs get proportionally longer timeouts",
+		Value:   0,
+		EnvVars: prefixEnvVars("PROOF_TIMEOUT_PER_BLOCK"),
+	}
+	GCIntervalFlag = &cli.DurationFlag{
+		Name:    "gc-interval",
+		Usage:   "Interval at which stale and orphaned proof requests are cleaned up. Set to 0 to disable",
+		Value:   10 * time.Minute,
+		EnvVars: prefixEnvVars("GC_INTERVAL"),
+	}
+	ConfigFileFlag = &cli.StringFlag{
+		Name:    "config",
+		Usage:   "Path to a TOML or YAML config file whose keys are flag names. Flags and environment variables take precedence over the file",
+		EnvVars: prefixEnvVars("CONFIG"),
+	}
+	NetworkFlag = &cli.StringFlag{
+		Name:    "network",
+		Usage:   "Superchain registry network (e.g. base-sepolia) to load the batch inbox, the batcher address and, when proposing to a DisputeGameFactory, its address from. The OP Succinct L2OO address must still be set",
+		EnvVars: prefixEnvVars("NETWORK"),
+	}
+	RPCCacheTTLFlag = &cli.DurationFlag{
+		Name:    "rpc-cache-ttl",
+		Usage:   "How long to cache outputs fetched from the rollup node. Set to 0 to disable the cache",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("RPC_CACHE_TTL"),
+	}
+	StatusPollConcurrencyFlag = &cli.Uint64Flag{
+		Name:    "status-poll-concurrency",
+		Usage:   "Maximum number of pending proofs whose status is polled from the OP Succinct server at the same time",
+		Value:   8,
+		EnvVars: prefixEnvVars("STATUS_POLL_CONCURRENCY"),
+	}
+	ServerMaxIdleConnsFlag = &cli.Uint64Flag{
+		Name:    "server-max-idle-conns",
+		Usage:   "Maximum number of idle connections kept open to each OP Succinct server",
+		Value:   16,
+		EnvVars: prefixEnvVars("SERVER_MAX_IDLE_CONNS"),
+	}
+	ServerHTTP2Flag = &cli.BoolFlag{
+		Name:    "server-http2",
+		Usage:   "Attempt to use HTTP/2 for requests to the OP Succinct server",
+		EnvVars: prefixEnvVars("SERVER_HTTP2"),
+	}
+	ShutdownTimeoutFlag = &cli.DurationFlag{
+		Name:    "shutdown-timeout",
+		Usage:   "How long to wait on shutdown for in-flight proof requests to the OP Succinct server before cancelling them",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("SHUTDOWN_TIMEOUT"),
+	}
+	WitnessGenCmdFlag = &cli.StringFlag{
+		Name:    "witnessgen-cmd",
+		Usage:   "Command that generates the witness of a span proof locally, to upload it with the span proof request. It is run with --start and --end, and must write the witness to stdout. If not set, the OP Succinct server generates the witness",
+		EnvVars: prefixEnvVars("WITNESSGEN_CMD"),
+	}
+	ServerCompressionFlag = &cli.StringFlag{
+		Name:    "server-compression",
+		Usage:   "Encoding used to compress request bodies sent to the OP Succinct server, which is also asked for compressed responses. One of: gzip, zstd, none. Only enable compression if the server accepts compressed request bodies",
+		Value:   "none",
+		EnvVars: prefixEnvVars("SERVER_COMPRESSION"),
+	}
+	BatchConfirmationsFlag = &cli.Uint64Flag{
+		Name:    "batch-confirmations",
+		Usage:   "If set, span proofs are queued up to the L2 safe head, for blocks whose batches are included in L1 blocks with at least this many confirmations, instead of only for finalized L2 blocks. Requires the rollup node's safe head DB",
+		EnvVars: prefixEnvVars("BATCH_CONFIRMATIONS"),
+	}
+	BatchFinalizedFlag = &cli.BoolFlag{
+		Name:    "batch-finalized",
+		Usage:   "If set, span proofs are queued up to the L2 safe head, for blocks whose batches are included in finalized L1 blocks. Requires the rollup node's safe head DB",
+		EnvVars: prefixEnvVars("BATCH_FINALIZED"),
+	}
+	HeadPollIntervalFlag = &cli.DurationFlag{
+		Name:    "head-poll-interval",
+		Usage:   "How frequently to poll the rollup node for the L2 safe and finalized heads in the background. Set to 0 to fetch them whenever they're used",
+		Value:   4 * time.Second,
+		EnvVars: prefixEnvVars("HEAD_POLL_INTERVAL"),
+	}
+	L2HeadMarginFlag = &cli.Uint64Flag{
+		Name:    "l2-head-margin",
+		Usage:   "Number of blocks behind the L2 head (finalized, or safe if batch gating is enabled) up to which span proofs are queued",
+		Value:   0,
+		EnvVars: prefixEnvVars("L2_HEAD_MARGIN"),
+	}
+	FinalizedOnlyFlag = &cli.BoolFlag{
+		Name:    "finalized-only",
+		Usage:   "Only prove and propose L2 blocks derived from finalized L1 data, so that no proven range can be reorged, at the cost of latency. Can't be combined with allow-non-finalized or batch gating",
+		EnvVars: prefixEnvVars("FINALIZED_ONLY"),
+	}
+	MaxPricePerCycleFlag = &cli.Uint64Flag{
+		Name:    "max-price-per-cycle",
+		Usage:   "Maximum price per cycle that the prover network may charge for a proof. Passed through with proof requests, and span proofs with a higher quote are handled by the price-ceiling-action. 0 means no limit",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_PRICE_PER_CYCLE"),
+	}
+	MaxTotalFeeFlag = &cli.Uint64Flag{
+		Name:    "max-total-fee",
+		Usage:   "Maximum total fee that the prover network may charge for a proof. Passed through with proof requests, and span proofs with a higher quote are handled by the price-ceiling-action. 0 means no limit",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_TOTAL_FEE"),
+	}
+	PriceCeilingActionFlag = &cli.StringFlag{
+		Name:    "price-ceiling-action",
+		Usage:   "What to do when the quote of a span proof exceeds max-price-per-cycle or max-total-fee. One of: wait, shrink, alert",
+		Value:   "wait",
+		EnvVars: prefixEnvVars("PRICE_CEILING_ACTION"),
+	}
+	DailyProvingBudgetFlag = &cli.Uint64Flag{
+		Name:    "daily-proving-budget",
+		Usage:   "Maximum sum of the fees of the proofs fulfilled in the last 24 hours. Once spent, only the proofs needed for the next output submission are requested. 0 means no budget",
+		Value:   0,
+		EnvVars: prefixEnvVars("DAILY_PROVING_BUDGET"),
+	}
+	WeeklyProvingBudgetFlag = &cli.Uint64Flag{
+		Name:    "weekly-proving-budget",
+		Usage:   "Maximum sum of the fees of the proofs fulfilled in the last 7 days. Once spent, only the proofs needed for the next output submission are requested. 0 means no budget",
+		Value:   0,
+		EnvVars: prefixEnvVars("WEEKLY_PROVING_BUDGET"),
+	}
+	OutputSLAFlag = &cli.DurationFlag{
+		Name:    "output-sla",
+		Usage:   "Maximum time that the latest output on the L2OO contract may trail the L2 safe head. Exposes the remaining slack as a metric, and alerts on breaches. 0 disables the SLA monitor",
+		Value:   0,
+		EnvVars: prefixEnvVars("OUTPUT_SLA"),
+	}
+	OutputSLAAlertWindowFlag = &cli.DurationFlag{
+		Name:    "output-sla-alert-window",
+		Usage:   "Alert if the output SLA is predicted to be breached within this window at the current proving throughput",
+		Value:   30 * time.Minute,
+		EnvVars: prefixEnvVars("OUTPUT_SLA_ALERT_WINDOW"),
+	}
+	ShadowL2OOAddressFlag = &cli.StringFlag{
+		Name:    "shadow-l2oo-address",
+		Usage:   "Address of the L2OutputOracle contract of an incumbent proposer on the same chain. If set, the proposer runs in shadow mode: it proves outputs but doesn't submit them, and compares them against the incumbent's outputs instead",
+		EnvVars: prefixEnvVars("SHADOW_L2OO_ADDRESS"),
+	}
+	CrossCheckOutputRootsFlag = &cli.BoolFlag{
+		Name:    "cross-check-output-roots",
+		Usage:   "Before submitting an agg proof, compare the output root it commits to against the output root computed by the rollup node, and refuse to submit it on mismatch",
+		EnvVars: prefixEnvVars("CROSS_CHECK_OUTPUT_ROOTS"),
+	}
+	QuorumRollupRpcsFlag = &cli.StringSliceFlag{
+		Name:    "quorum-rollup-rpcs",
+		Usage:   "HTTP provider URLs of additional rollup nodes that verify the outputs used for proofs and submissions. Outputs are only used if rollup-quorum of the nodes, including the rollup-rpc node, agree on the output root and block hash",
+		EnvVars: prefixEnvVars("QUORUM_ROLLUP_RPCS"),
+	}
+	RollupQuorumFlag = &cli.Uint64Flag{
+		Name:    "rollup-quorum",
+		Usage:   "Number of rollup nodes that must agree on an output if quorum-rollup-rpcs is set. 0 means a majority of the nodes",
+		Value:   0,
+		EnvVars: prefixEnvVars("ROLLUP_QUORUM"),
+	}
+	ArchiveAfterFlag = &cli.DurationFlag{
+		Name:    "archive-after",
+		Usage:   "Move COMPLETE and FAILED proof requests that were requested this long ago out of the DB, to an archive file next to it. Must be at least 24h with a daily proving budget, and 168h with a weekly one. Set to 0 to keep them in the DB",
+		Value:   0,
+		EnvVars: prefixEnvVars("ARCHIVE_AFTER"),
+	}
+	DbJournalModeFlag = &cli.StringFlag{
+		Name:    "db-journal-mode",
+		Usage:   "The SQLite journal mode of the proof DB: WAL, DELETE, TRUNCATE or PERSIST. In WAL mode, reads don't block writes, which avoids \"database is locked\" errors",
+		Value:   "WAL",
+		EnvVars: prefixEnvVars("DB_JOURNAL_MODE"),
+	}
+	DbBusyTimeoutFlag = &cli.DurationFlag{
+		Name:    "db-busy-timeout",
+		Usage:   "How long a proof DB connection waits for a lock held by another connection before failing",
+		Value:   5 * time.Second,
+		EnvVars: prefixEnvVars("DB_BUSY_TIMEOUT"),
+	}
+	DbMaxReadConnsFlag = &cli.IntFlag{
+		Name:    "db-max-read-conns",
+		Usage:   "The maximum number of open read connections to the proof DB. Writes are always serialized over a single connection",
+		Value:   4,
+		EnvVars: prefixEnvVars("DB_MAX_READ_CONNS"),
+	}
+	ProofEncryptionKeyFileFlag = &cli.StringFlag{
+		Name:    "proof-encryption-key-file",
+		Usage:   "Path to a file with a hex-encoded 32-byte key to encrypt proofs at rest in the proof DB with AES-256-GCM, e.g. provisioned by a KMS or secret manager. If not set, proofs are stored unencrypted",
+		EnvVars: prefixEnvVars("PROOF_ENCRYPTION_KEY_FILE"),
+	}
+	ConductorRpcFlag = &cli.StringFlag{
+		Name:    "conductor-rpc",
+		Usage:   "RPC of the op-conductor whose leadership this proposer replica follows. Only the leader's replica requests proofs and submits outputs, the others stand by",
+		EnvVars: prefixEnvVars("CONDUCTOR_RPC"),
+	}
+	LeaseFileFlag = &cli.StringFlag{
+		Name:    "lease-file",
+		Usage:   "Path to a lease file on storage shared by the proposer replicas, to elect the active replica without an op-conductor. The others stand by until the lease expires. The replicas share the proof DB, so the lease file must be in its directory, DbPathFlag/{chain_id}, and the DB must be cached and not in WAL journal mode",
+		EnvVars: prefixEnvVars("LEASE_FILE"),
+	}
+	LeaseDurationFlag = &cli.DurationFlag{
+		Name:    "lease-duration",
+		Usage:   "How long the lease is held without renewal before a standby replica takes over. The active replica renews it three times per lease duration, and stops its work as soon as a renewal fails. Must exceed the poll interval",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("LEASE_DURATION"),
+	}
+	InstanceIDFlag = &cli.StringFlag{
+		Name:    "instance-id",
+		Usage:   "The ID of this proposer replica in the lease. Defaults to the hostname",
+		EnvVars: prefixEnvVars("INSTANCE_ID"),
+	}
+	DependencySetFileFlag = &cli.StringFlag{
+		Name:    "dependency-set",
+		Usage:   "Path to the JSON dependency set of a Superchain interop chain, listing the chain ID and a rollup RPC of each chain whose messages the L2 chain executes. Span proofs then only cover blocks whose messages are covered by the finalized heads of the dependency set",
+		EnvVars: prefixEnvVars("DEPENDENCY_SET"),
+	}
+	GraphQLFlag = &cli.BoolFlag{
+		Name:    "graphql",
+		Usage:   "Serve the GraphQL API over proof requests, submitted outputs and the proving status of L2 blocks on /graphql of the RPC server",
+		EnvVars: prefixEnvVars("GRAPHQL"),
+	}
+	GRPCAddrFlag = &cli.StringFlag{
+		Name:    "grpc-addr",
+		Usage:   "Address to serve the watch API over gRPC on (see proposer/watch.proto), e.g. 0.0.0.0:8546. Disabled if empty",
+		EnvVars: prefixEnvVars("GRPC_ADDR"),
+	}
+	EventBusFlag = &cli.StringFlag{
+		Name:    "event-bus",
+		Usage:   "URL of the broker that proof and output lifecycle events are published to: nats://host:port for a NATS server, or kafka://host:port[,host:port...] for the seed brokers of a Kafka cluster",
+		EnvVars: prefixEnvVars("EVENT_BUS"),
+	}
+	EventTopicFlag = &cli.StringFlag{
+		Name:    "event-topic",
+		Usage:   "The Kafka topic, or the NATS subject prefix, of the lifecycle events",
+		Value:   "op-succinct.proposer",
+		EnvVars: prefixEnvVars("EVENT_TOPIC"),
+	}
+	PendingProofsIntervalFlag = &cli.DurationFlag{
+		Name:    "pending-proofs-interval",
+		Usage:   "How frequently to check the status of requested proofs. Defaults to the poll interval",
+		EnvVars: prefixEnvVars("PENDING_PROOFS_INTERVAL"),
+	}
+	AggProofsIntervalFlag = &cli.DurationFlag{
+		Name:    "agg-proofs-interval",
+		Usage:   "How frequently to derive agg proofs from the completed span proofs. Defaults to the poll interval",
+		EnvVars: prefixEnvVars("AGG_PROOFS_INTERVAL"),
+	}
+	RequestProofsIntervalFlag = &cli.DurationFlag{
+		Name:    "request-proofs-interval",
+		Usage:   "How frequently to request queued proofs from the prover network. Defaults to the poll interval",
+		EnvVars: prefixEnvVars("REQUEST_PROOFS_INTERVAL"),
+	}
+	SubmissionScheduleFlag = &cli.StringSliceFlag{
+		Name:    "submission-schedule",
+		Usage:   "Cron expressions (minute hour day-of-month month day-of-week, in UTC) of the windows in which agg proofs (or, with a DisputeGameFactory, proposals) are submitted, e.g. \"* 0-6 * * *\". Agg proofs are submitted outside of the windows if the output SLA is breached or predicted to be breached. Empty means any time",
+		EnvVars: prefixEnvVars("SUBMISSION_SCHEDULE"),
+	}
+	SubmissionMaxBaseFeeFlag = &cli.Uint64Flag{
+		Name:    "submission-max-base-fee",
+		Usage:   "Maximum L1 base fee in gwei at which agg proofs (or, with a DisputeGameFactory, proposals) are submitted. Agg proofs are submitted above it if the output SLA is breached or predicted to be breached. 0 means no limit",
+		Value:   0,
+		EnvVars: prefixEnvVars("SUBMISSION_MAX_BASE_FEE"),
+	}
+	MaxPendingSpanProofsFlag = &cli.Uint64Flag{
+		Name:    "max-pending-span-proofs",
+		Usage:   "Maximum number of unrequested and requested span proofs. Once reached, new span proofs aren't queued until the backlog drains to half of it. 0 means no limit",
+		Value:   0,
+		EnvVars: prefixEnvVars("MAX_PENDING_SPAN_PROOFS"),
+	}
+	TargetProofLatencyFlag = &cli.DurationFlag{
+		Name:    "target-proof-latency",
+		Usage:   "Target turnaround time of proofs. If set, the limit of concurrent proof requests adapts between min-concurrent-proof-requests and max-concurrent-proof-requests: it's lowered while proofs take longer than this or fail, and raised otherwise. 0 keeps the limit at max-concurrent-proof-requests",
+		EnvVars: prefixEnvVars("TARGET_PROOF_LATENCY"),
+	}
+	MinConcurrentProofRequestsFlag = &cli.Uint64Flag{
+		Name:    "min-concurrent-proof-requests",
+		Usage:   "Lower bound of the limit of concurrent proof requests when it adapts to the target-proof-latency",
+		Value:   1,
+		EnvVars: prefixEnvVars("MIN_CONCURRENT_PROOF_REQUESTS"),
+	}
+	L2EthRpcFlag = &cli.StringFlag{
+		Name:    "l2-eth-rpc",
+		Usage:   "HTTP provider URL for the L2 execution node. Used to estimate the proving cost of L2 blocks.",
+		EnvVars: prefixEnvVars("L2_RPC"),
+	}
+	TargetCyclesPerSpanProofFlag = &cli.Uint64Flag{
+		Name:    "target-cycles-per-span-proof",
+		Usage:   "Estimated cycle count to target per span proof. If set (and an L2 execution RPC is provided), spans are sized by estimated proving cost instead of a fixed block count",
+		Value:   0,
+		EnvVars: prefixEnvVars("TARGET_CYCLES_PER_SPAN_PROOF"),
+	}
 
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
@@ -187,6 +546,70 @@ var optionalFlags = []cli.Flag{
 	MaxConcurrentProofRequestsFlag,
 	BatchInboxFlag,
 	BatcherAddressFlag,
+	L2EthRpcFlag,
+	TargetCyclesPerSpanProofFlag,
+	SpanProofBatchSizeFlag,
+	MaxCyclesPerSpanProofFlag,
+	MaxFeePerSpanProofFlag,
+	CheckpointReuseWindowFlag,
+	AggProofL1HeadPolicyFlag,
+	AggProofL1HeadOffsetFlag,
+	MaxPipelinedAggProofsFlag,
+	OPSuccinctSecondaryServerUrlFlag,
+	FailoverMinFulfillmentRateFlag,
+	FailoverCooldownFlag,
+	ProofSystemFlag,
+	SpanProofTimeoutFlag,
+	AggProofTimeoutFlag,
+	ProofTimeoutPerBlockFlag,
+	GCIntervalFlag,
+	ConfigFileFlag,
+	NetworkFlag,
+	RPCCacheTTLFlag,
+	StatusPollConcurrencyFlag,
+	ServerMaxIdleConnsFlag,
+	ServerHTTP2Flag,
+	ShutdownTimeoutFlag,
+	WitnessGenCmdFlag,
+	ServerCompressionFlag,
+	BatchConfirmationsFlag,
+	BatchFinalizedFlag,
+	HeadPollIntervalFlag,
+	L2HeadMarginFlag,
+	FinalizedOnlyFlag,
+	MaxPricePerCycleFlag,
+	MaxTotalFeeFlag,
+	PriceCeilingActionFlag,
+	DailyProvingBudgetFlag,
+	WeeklyProvingBudgetFlag,
+	OutputSLAFlag,
+	OutputSLAAlertWindowFlag,
+	ShadowL2OOAddressFlag,
+	CrossCheckOutputRootsFlag,
+	QuorumRollupRpcsFlag,
+	RollupQuorumFlag,
+	ArchiveAfterFlag,
+	DbJournalModeFlag,
+	DbBusyTimeoutFlag,
+	DbMaxReadConnsFlag,
+	ProofEncryptionKeyFileFlag,
+	ConductorRpcFlag,
+	LeaseFileFlag,
+	LeaseDurationFlag,
+	InstanceIDFlag,
+	DependencySetFileFlag,
+	GraphQLFlag,
+	GRPCAddrFlag,
+	EventBusFlag,
+	EventTopicFlag,
+	PendingProofsIntervalFlag,
+	AggProofsIntervalFlag,
+	RequestProofsIntervalFlag,
+	SubmissionScheduleFlag,
+	SubmissionMaxBaseFeeFlag,
+	MaxPendingSpanProofsFlag,
+	TargetProofLatencyFlag,
+	MinConcurrentProofRequestsFlag,
 }
 
 func init() {
@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// maybeCollectGarbage rolls up the proving statistics and runs CollectGarbage if GCInterval has elapsed since it last
+// ran.
+func (l *L2OutputSubmitter) maybeCollectGarbage(ctx context.Context) error {
+	if l.config().GCInterval == 0 || time.Since(l.lastGC) < l.config().GCInterval {
+		return nil
+	}
+	l.lastGC = time.Now()
+	if err := l.rollUpStats(); err != nil {
+		l.Log.Error("failed to roll up proving stats", "err", err)
+	}
+	return l.CollectGarbage(ctx)
+}
+
+// CollectGarbage resolves proof requests that are stuck in an intermediate state, or that can no longer be used:
+//   - Proofs marked as PROVING without a prover request ID are failed and retried.
+//   - Unrequested span proofs whose range is already covered by other span proofs are failed.
+//   - Unfinished agg proofs that start below the latest output on the L2OO contract are failed.
+//   - COMPLETE and FAILED proof requests that were added more than ArchiveAfter ago are archived.
+func (l *L2OutputSubmitter) CollectGarbage(ctx context.Context) error {
+	orphaned, err := l.db.GetOrphanedProvingProofs()
+	if err != nil {
+		return err
+	}
+	for _, p := range orphaned {
+		l.logGarbage(p, "proving without a prover request ID")
+		if err := l.RetryRequest(p); err != nil {
+			return fmt.Errorf("failed to retry orphaned proof: %w", err)
+		}
+	}
+
+	covered, err := l.db.GetCoveredUnrequestedSpanProofs()
+	if err != nil {
+		return err
+	}
+	for _, p := range covered {
+		l.logGarbage(p, "range covered by other span proofs")
+		if err := l.db.FailWithoutRetry(p.ID, nil); err != nil {
+			return fmt.Errorf("failed to update covered span proof status: %w", err)
+		}
+	}
+
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest L2OO output: %w", err)
+	}
+	stale, err := l.db.GetStaleAggProofs(latest.Uint64())
+	if err != nil {
+		return err
+	}
+	for _, p := range stale {
+		l.logGarbage(p, "starts below the latest L2OO output")
+		if p.ProverRequestID != "" {
+			if err := l.CancelProof(p.ProverRequestID); err != nil {
+				l.Log.Warn("failed to cancel stale agg proof", "id", p.ProverRequestID, "err", err)
+			}
+		}
+		if err := l.db.FailWithoutRetry(p.ID, nil); err != nil {
+			return fmt.Errorf("failed to update stale agg proof status: %w", err)
+		}
+	}
+
+	return l.archiveProofs(latest.Uint64())
+}
+
+func (l *L2OutputSubmitter) logGarbage(p *ent.ProofRequest, reason string) {
+	l.Log.Info("collecting stale proof request", "id", p.ID, "type", p.Type, "status", p.Status, "start", p.StartBlock, "end", p.EndBlock, "reason", reason)
+}
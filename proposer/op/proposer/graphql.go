@@ -0,0 +1,529 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// SubmittedOutput is an output on the L2OO contract.
+type SubmittedOutput struct {
+	Index         uint64 `json:"index"`
+	L2BlockNumber uint64 `json:"l2_block_number"`
+	OutputRoot    string `json:"output_root"`
+	// The L1 timestamp the output was submitted at.
+	SubmittedAt uint64 `json:"submitted_at"`
+}
+
+// OutputCovering returns the output on the L2OO contract that covers the given L2 block, i.e. the first output at or
+// after it, or nil if there's no such output yet.
+func (l *L2OutputSubmitter) OutputCovering(ctx context.Context, block uint64) (*SubmittedOutput, error) {
+	latest, err := l.latestOutputBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return l.outputCovering(ctx, block, latest)
+}
+
+// outputCovering is OutputCovering, given the L2 block of the latest output.
+func (l *L2OutputSubmitter) outputCovering(ctx context.Context, block, latest uint64) (*SubmittedOutput, error) {
+	if latest < block {
+		return nil, nil
+	}
+	index, err := l.l2ooContract.GetL2OutputIndexAfter(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(block))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get index of output after block %d: %w", block, err)
+	}
+	return l.outputAtIndex(ctx, index)
+}
+
+// LatestOutput returns the latest output on the L2OO contract, or nil if there's none.
+func (l *L2OutputSubmitter) LatestOutput(ctx context.Context) (*SubmittedOutput, error) {
+	if l.l2ooContract == nil {
+		return nil, ErrNoL2OutputOracle
+	}
+	next, err := l.l2ooContract.NextOutputIndex(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next output index: %w", err)
+	}
+	if next.Sign() == 0 {
+		return nil, nil
+	}
+	return l.outputAtIndex(ctx, next.Sub(next, big.NewInt(1)))
+}
+
+func (l *L2OutputSubmitter) outputAtIndex(ctx context.Context, index *big.Int) (*SubmittedOutput, error) {
+	proposal, err := l.l2ooContract.GetL2Output(&bind.CallOpts{Context: ctx}, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get output at index %d: %w", index, err)
+	}
+	return &SubmittedOutput{
+		Index:         index.Uint64(),
+		L2BlockNumber: proposal.L2BlockNumber.Uint64(),
+		OutputRoot:    common.Hash(proposal.OutputRoot).Hex(),
+		SubmittedAt:   proposal.Timestamp.Uint64(),
+	}, nil
+}
+
+// outputsAt returns the outputs on the L2OO contract at the given L2 blocks, by block. Blocks without an output are
+// left out.
+func (l *L2OutputSubmitter) outputsAt(ctx context.Context, blocks []uint64) (map[uint64]*SubmittedOutput, error) {
+	outputs := make(map[uint64]*SubmittedOutput)
+	if len(blocks) == 0 {
+		return outputs, nil
+	}
+	latest, err := l.latestOutputBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		if _, ok := outputs[block]; ok {
+			continue
+		}
+		output, err := l.outputCovering(ctx, block, latest)
+		if err != nil {
+			return nil, err
+		}
+		if output != nil && output.L2BlockNumber == block {
+			outputs[block] = output
+		}
+	}
+	return outputs, nil
+}
+
+// aggProofEndingAt returns the completed agg proof that ends at the given L2 block, or nil if there's none.
+func (l *L2OutputSubmitter) aggProofEndingAt(block uint64) (*ProofRequestInfo, error) {
+	proofs, err := l.db.GetProofRequestsCovering(block)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range proofs {
+		if p.Type == proofrequest.TypeAGG && p.Status == proofrequest.StatusCOMPLETE && p.EndBlock == block {
+			info := newProofRequestInfo(p)
+			return &info, nil
+		}
+	}
+	return nil, nil
+}
+
+// graphQLSchema is the schema of the GraphQL API, which joins the proof requests with the outputs on the L2OO contract
+// and the proving status of L2 blocks. ProofRequest has the fields of ProofRequestInfo, Output the fields of
+// SubmittedOutput and Block the fields of BlockStatus, in camel case.
+const graphQLSchema = `
+	schema {
+		query: Query
+	}
+
+	# An unsigned 64-bit integer. Values above 2^31 - 1 are passed as decimal strings or variables, as GraphQL number
+	# literals are 32-bit.
+	scalar Long
+
+	type Query {
+		proofRequest(id: Int!): ProofRequest
+		proofRequests(status: String, type: String, label: String, start: Long, end: Long, limit: Int, cursor: Int): [ProofRequest!]!
+		block(number: Long!): Block
+		# The output that covers the block, i.e. the first output at or after it.
+		output(block: Long!): Output
+		latestOutput: Output
+	}
+
+	type ProofRequest {
+		id: Int!
+		type: String!
+		startBlock: Long!
+		endBlock: Long!
+		status: String!
+		backfill: Boolean!
+		parentId: Int!
+		requestAddedTime: Long!
+		lastUpdatedTime: Long!
+		proverRequestId: String!
+		proofRequestTime: Long!
+		programVersion: String!
+		l1BlockNumber: Long!
+		l1BlockHash: String!
+		estimatedCycles: Long!
+		estimatedFee: Long!
+		fulfilledCycles: Long!
+		fulfilledFee: Long!
+		prover: String!
+		fulfilledTime: Long!
+		failureStage: String!
+		errorCode: String!
+		failureReason: String!
+		labels: [String!]!
+		challengeStatus: String!
+		# The request that this request replaces.
+		parent: ProofRequest
+		# The output submitted with this agg proof.
+		output: Output
+	}
+
+	type Output {
+		index: Long!
+		l2BlockNumber: Long!
+		outputRoot: String!
+		submittedAt: Long!
+		# The agg proof the output was submitted with.
+		proof: ProofRequest
+	}
+
+	type Block {
+		block: Long!
+		stage: String!
+		latestOutputBlock: Long!
+		proofs: [ProofRequest!]!
+		# The output that covers the block.
+		output: Output
+	}
+`
+
+// The limits of GraphQL requests: the size of the request, and the depth of queries, which bounds the lookups that a
+// query can chain through the parent, output and proof fields.
+const (
+	maxGraphQLRequestSize = 1 << 20
+	maxGraphQLQueryDepth  = 8
+)
+
+// Long is the GraphQL type of block numbers, timestamps, cycles and fees, which can overflow the 32-bit Int of GraphQL.
+type Long uint64
+
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (n *Long) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case int32:
+		if v < 0 {
+			return fmt.Errorf("invalid Long %d", v)
+		}
+		*n = Long(v)
+	case float64:
+		if v < 0 || v >= math.MaxUint64 || v != math.Trunc(v) {
+			return fmt.Errorf("invalid Long %v", v)
+		}
+		*n = Long(v)
+	case string:
+		u, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Long %q", v)
+		}
+		*n = Long(u)
+	default:
+		return fmt.Errorf("invalid Long %v", input)
+	}
+	return nil
+}
+
+func (n Long) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(n), 10), nil
+}
+
+// batchLoader loads a field of all the objects of a list at once, the first time the field of one of them is resolved,
+// instead of once per object.
+type batchLoader[K comparable, V any] struct {
+	keys   []K
+	fetch  func(ctx context.Context, keys []K) (map[K]V, error)
+	once   sync.Once
+	values map[K]V
+	err    error
+}
+
+func (b *batchLoader[K, V]) load(ctx context.Context, key K) (V, error) {
+	b.once.Do(func() {
+		b.values, b.err = b.fetch(ctx, b.keys)
+	})
+	return b.values[key], b.err
+}
+
+// graphQLResolver resolves the queries of the GraphQL API.
+type graphQLResolver struct {
+	l *L2OutputSubmitter
+}
+
+func (r *graphQLResolver) ProofRequest(args struct{ ID int32 }) (*proofRequestResolver, error) {
+	p, err := r.l.db.GetProofRequestMetadata(int(args.ID))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return newProofRequestResolvers(r.l, []ProofRequestInfo{newProofRequestInfo(p)})[0], nil
+}
+
+// ProofRequests lists the proof requests like the request history API, with the filter and pagination as arguments.
+func (r *graphQLResolver) ProofRequests(args struct {
+	Status, Type, Label *string
+	Start, End          *Long
+	Limit, Cursor       *int32
+}) ([]*proofRequestResolver, error) {
+	var filter db.ProofRequestFilter
+	if args.Status != nil {
+		filter.Status = proofrequest.Status(strings.ToUpper(*args.Status))
+		if err := proofrequest.StatusValidator(filter.Status); err != nil {
+			return nil, err
+		}
+	}
+	if args.Type != nil {
+		filter.Type = proofrequest.Type(strings.ToUpper(*args.Type))
+		if err := proofrequest.TypeValidator(filter.Type); err != nil {
+			return nil, err
+		}
+	}
+	if args.Label != nil {
+		filter.Label = *args.Label
+	}
+	if args.Start != nil {
+		filter.StartBlock = uint64(*args.Start)
+	}
+	if args.End != nil {
+		filter.EndBlock = uint64(*args.End)
+	}
+	cursor, limit := 0, defaultHistoryPageSize
+	if args.Cursor != nil {
+		cursor = int(*args.Cursor)
+	}
+	if args.Limit != nil && *args.Limit > 0 {
+		limit = min(int(*args.Limit), maxHistoryPageSize)
+	}
+
+	proofs, err := r.l.db.ListProofRequests(filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]ProofRequestInfo, 0, len(proofs))
+	for _, p := range proofs {
+		infos = append(infos, newProofRequestInfo(p))
+	}
+	return newProofRequestResolvers(r.l, infos), nil
+}
+
+func (r *graphQLResolver) Block(ctx context.Context, args struct{ Number Long }) (*blockResolver, error) {
+	status, err := r.l.BlockStatus(ctx, uint64(args.Number))
+	if err != nil {
+		return nil, err
+	}
+	return &blockResolver{l: r.l, status: status, proofs: newProofRequestResolvers(r.l, status.Proofs)}, nil
+}
+
+func (r *graphQLResolver) Output(ctx context.Context, args struct{ Block Long }) (*outputResolver, error) {
+	output, err := r.l.OutputCovering(ctx, uint64(args.Block))
+	if err != nil || output == nil {
+		return nil, err
+	}
+	return &outputResolver{l: r.l, output: output}, nil
+}
+
+func (r *graphQLResolver) LatestOutput(ctx context.Context) (*outputResolver, error) {
+	output, err := r.l.LatestOutput(ctx)
+	if err != nil || output == nil {
+		return nil, err
+	}
+	return &outputResolver{l: r.l, output: output}, nil
+}
+
+// proofRequestResolver resolves a ProofRequest. The parents and outputs of the requests of a list are loaded together.
+type proofRequestResolver struct {
+	l       *L2OutputSubmitter
+	info    ProofRequestInfo
+	parents *batchLoader[int, *proofRequestResolver]
+	outputs *batchLoader[uint64, *SubmittedOutput]
+}
+
+// newProofRequestResolvers returns the resolvers of the proof requests of a list.
+func newProofRequestResolvers(l *L2OutputSubmitter, infos []ProofRequestInfo) []*proofRequestResolver {
+	parents := &batchLoader[int, *proofRequestResolver]{fetch: func(_ context.Context, ids []int) (map[int]*proofRequestResolver, error) {
+		proofs, err := l.db.GetProofRequestsMetadata(ids)
+		if err != nil {
+			return nil, err
+		}
+		infos := make([]ProofRequestInfo, 0, len(proofs))
+		for _, p := range proofs {
+			infos = append(infos, newProofRequestInfo(p))
+		}
+		resolvers := make(map[int]*proofRequestResolver, len(infos))
+		for _, r := range newProofRequestResolvers(l, infos) {
+			resolvers[r.info.ID] = r
+		}
+		return resolvers, nil
+	}}
+	outputs := &batchLoader[uint64, *SubmittedOutput]{fetch: l.outputsAt}
+
+	resolvers := make([]*proofRequestResolver, 0, len(infos))
+	for _, info := range infos {
+		if info.ParentID != 0 {
+			parents.keys = append(parents.keys, info.ParentID)
+		}
+		if isCompleteAggProof(info) {
+			outputs.keys = append(outputs.keys, info.EndBlock)
+		}
+		resolvers = append(resolvers, &proofRequestResolver{l: l, info: info, parents: parents, outputs: outputs})
+	}
+	return resolvers
+}
+
+func isCompleteAggProof(info ProofRequestInfo) bool {
+	return info.Type == proofrequest.TypeAGG.String() && info.Status == proofrequest.StatusCOMPLETE.String()
+}
+
+func (r *proofRequestResolver) ID() int32               { return int32(r.info.ID) }
+func (r *proofRequestResolver) Type() string            { return r.info.Type }
+func (r *proofRequestResolver) StartBlock() Long        { return Long(r.info.StartBlock) }
+func (r *proofRequestResolver) EndBlock() Long          { return Long(r.info.EndBlock) }
+func (r *proofRequestResolver) Status() string          { return r.info.Status }
+func (r *proofRequestResolver) Backfill() bool          { return r.info.Backfill }
+func (r *proofRequestResolver) ParentID() int32         { return int32(r.info.ParentID) }
+func (r *proofRequestResolver) RequestAddedTime() Long  { return Long(r.info.RequestAddedTime) }
+func (r *proofRequestResolver) LastUpdatedTime() Long   { return Long(r.info.LastUpdatedTime) }
+func (r *proofRequestResolver) ProverRequestID() string { return r.info.ProverRequestID }
+func (r *proofRequestResolver) ProofRequestTime() Long  { return Long(r.info.ProofRequestTime) }
+func (r *proofRequestResolver) ProgramVersion() string  { return r.info.ProgramVersion }
+func (r *proofRequestResolver) L1BlockNumber() Long     { return Long(r.info.L1BlockNumber) }
+func (r *proofRequestResolver) L1BlockHash() string     { return r.info.L1BlockHash }
+func (r *proofRequestResolver) EstimatedCycles() Long   { return Long(r.info.EstimatedCycles) }
+func (r *proofRequestResolver) EstimatedFee() Long      { return Long(r.info.EstimatedFee) }
+func (r *proofRequestResolver) FulfilledCycles() Long   { return Long(r.info.FulfilledCycles) }
+func (r *proofRequestResolver) FulfilledFee() Long      { return Long(r.info.FulfilledFee) }
+func (r *proofRequestResolver) Prover() string          { return r.info.Prover }
+func (r *proofRequestResolver) FulfilledTime() Long     { return Long(r.info.FulfilledTime) }
+func (r *proofRequestResolver) FailureStage() string    { return r.info.FailureStage }
+func (r *proofRequestResolver) ErrorCode() string       { return r.info.ErrorCode }
+func (r *proofRequestResolver) FailureReason() string   { return r.info.FailureReason }
+func (r *proofRequestResolver) ChallengeStatus() string { return r.info.ChallengeStatus }
+func (r *proofRequestResolver) Labels() []string        { return append([]string{}, r.info.Labels...) }
+
+func (r *proofRequestResolver) Parent(ctx context.Context) (*proofRequestResolver, error) {
+	if r.info.ParentID == 0 {
+		return nil, nil
+	}
+	return r.parents.load(ctx, r.info.ParentID)
+}
+
+func (r *proofRequestResolver) Output(ctx context.Context) (*outputResolver, error) {
+	if !isCompleteAggProof(r.info) {
+		return nil, nil
+	}
+	output, err := r.outputs.load(ctx, r.info.EndBlock)
+	if err != nil || output == nil {
+		return nil, err
+	}
+	return &outputResolver{l: r.l, output: output, proof: r}, nil
+}
+
+// outputResolver resolves an Output.
+type outputResolver struct {
+	l      *L2OutputSubmitter
+	output *SubmittedOutput
+	// The agg proof the output was submitted with, if the output was resolved through it.
+	proof *proofRequestResolver
+}
+
+func (r *outputResolver) Index() Long         { return Long(r.output.Index) }
+func (r *outputResolver) L2BlockNumber() Long { return Long(r.output.L2BlockNumber) }
+func (r *outputResolver) OutputRoot() string  { return r.output.OutputRoot }
+func (r *outputResolver) SubmittedAt() Long   { return Long(r.output.SubmittedAt) }
+
+func (r *outputResolver) Proof() (*proofRequestResolver, error) {
+	if r.proof != nil {
+		return r.proof, nil
+	}
+	info, err := r.l.aggProofEndingAt(r.output.L2BlockNumber)
+	if err != nil || info == nil {
+		return nil, err
+	}
+	return newProofRequestResolvers(r.l, []ProofRequestInfo{*info})[0], nil
+}
+
+// blockResolver resolves a Block.
+type blockResolver struct {
+	l      *L2OutputSubmitter
+	status *BlockStatus
+	proofs []*proofRequestResolver
+}
+
+func (r *blockResolver) Block() Long                     { return Long(r.status.Block) }
+func (r *blockResolver) Stage() string                   { return string(r.status.Stage) }
+func (r *blockResolver) LatestOutputBlock() Long         { return Long(r.status.LatestOutputBlock) }
+func (r *blockResolver) Proofs() []*proofRequestResolver { return r.proofs }
+
+func (r *blockResolver) Output(ctx context.Context) (*outputResolver, error) {
+	output, err := r.l.outputCovering(ctx, r.status.Block, r.status.LatestOutputBlock)
+	if err != nil || output == nil {
+		return nil, err
+	}
+	return &outputResolver{l: r.l, output: output}, nil
+}
+
+// graphQLRequest is a GraphQL query and its variables.
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// graphQLHandler serves the GraphQL API on /graphql, and passes other requests to next. Queries are sent as the body
+// of POST requests, or as the query, operationName and variables query parameters of GET requests.
+func (ps *ProposerService) graphQLHandler(next http.Handler) http.Handler {
+	schema := graphql.MustParseSchema(graphQLSchema, &graphQLResolver{l: ps.driver}, graphql.MaxDepth(maxGraphQLQueryDepth))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/graphql" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var req graphQLRequest
+		switch r.Method {
+		case http.MethodGet:
+			if len(r.URL.RawQuery) > maxGraphQLRequestSize {
+				writeAPIError(w, http.StatusRequestEntityTooLarge, errors.New("GraphQL request too large"))
+				return
+			}
+			query := r.URL.Query()
+			req.Query = query.Get("query")
+			req.OperationName = query.Get("operationName")
+			if vars := query.Get("variables"); vars != "" {
+				if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+					writeAPIError(w, http.StatusBadRequest, fmt.Errorf("invalid variables: %w", err))
+					return
+				}
+			}
+		case http.MethodPost:
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLRequestSize)).Decode(&req); err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					writeAPIError(w, http.StatusRequestEntityTooLarge, errors.New("GraphQL request too large"))
+				} else {
+					writeAPIError(w, http.StatusBadRequest, fmt.Errorf("invalid GraphQL request: %w", err))
+				}
+				return
+			}
+		default:
+			writeAPIError(w, http.StatusMethodNotAllowed, errors.New("only GET and POST are supported"))
+			return
+		}
+
+		resp := schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+		w.Header().Set("Content-Type", "application/json")
+		if resp.Data == nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			ps.Log.Error("failed to write GraphQL response", "err", err)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package proposer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// TestGraphQLHandler tests that the GraphQL API serves the proof requests over GET and POST.
+func TestGraphQLHandler(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 0, 200))
+
+	ps := &ProposerService{Log: testlog.Logger(t, log.LevelInfo), driver: &L2OutputSubmitter{db: *proofDB}}
+	handler := ps.graphQLHandler(http.NotFoundHandler())
+
+	rec := httptest.NewRecorder()
+	body := `{"query":"query($type: String) { proofRequests(type: $type) { id startBlock endBlock status } }","variables":{"type":"span"}}`
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data":{"proofRequests":[
+		{"id":2,"startBlock":100,"endBlock":200,"status":"UNREQ"},
+		{"id":1,"startBlock":0,"endBlock":100,"status":"UNREQ"}
+	]}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	query := url.Values{"query": {`{ proofRequest(id: 3) { type parent { id } } missing: proofRequest(id: 9) { id } }`}}
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data":{"proofRequest":{"type":"AGG","parent":null},"missing":null}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query=%7B%20nope%20%7D", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Blocks above the range of GraphQL Int are passed as strings.
+	rec = httptest.NewRecorder()
+	query = url.Values{"query": {`{ proofRequests(start: "99", end: "9999999999") { startBlock } }`}}
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data":{"proofRequests":[{"startBlock":0},{"startBlock":100},{"startBlock":0}]}}`, rec.Body.String())
+
+	// Without an L2OO, only the fields that read it fail.
+	rec = httptest.NewRecorder()
+	query = url.Values{"query": {`{ proofRequest(id: 1) { id } latestOutput { index } }`}}
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"data":{"proofRequest":{"id":1},"latestOutput":null}`)
+	require.Contains(t, rec.Body.String(), ErrNoL2OutputOracle.Error())
+}
+
+// TestGraphQLHandlerLimits tests that the GraphQL API rejects oversized requests and deeply nested queries.
+func TestGraphQLHandlerLimits(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	ps := &ProposerService{Log: testlog.Logger(t, log.LevelInfo), driver: &L2OutputSubmitter{db: *proofDB}}
+	handler := ps.graphQLHandler(http.NotFoundHandler())
+
+	rec := httptest.NewRecorder()
+	body := `{"query":"` + strings.Repeat(" ", maxGraphQLRequestSize) + `{ latestOutput { index } }"}`
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = httptest.NewRecorder()
+	query := url.Values{"query": {"{ proofRequests " + strings.Repeat("{ parent ", maxGraphQLQueryDepth) + "{ id }" + strings.Repeat(" }", maxGraphQLQueryDepth) + " }"}}
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "exceeds max depth")
+}
+
+// TestGraphQLParents tests that the parents of the proof requests of a list are resolved.
+func TestGraphQLParents(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 0, 100))
+	require.NoError(t, proofDB.SplitProofRequest(1, 50))
+	require.NoError(t, proofDB.SplitProofRequest(2, 25))
+
+	ps := &ProposerService{Log: testlog.Logger(t, log.LevelInfo), driver: &L2OutputSubmitter{db: *proofDB}}
+	handler := ps.graphQLHandler(http.NotFoundHandler())
+	rec := httptest.NewRecorder()
+	query := url.Values{"query": {`{ proofRequests(status: "unreq") { id parent { id parent { id } } } }`}}
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?"+query.Encode(), nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"data":{"proofRequests":[
+		{"id":5,"parent":{"id":2,"parent":{"id":1}}},
+		{"id":4,"parent":{"id":2,"parent":{"id":1}}},
+		{"id":3,"parent":{"id":1,"parent":null}}
+	]}}`, rec.Body.String())
+}
@@ -0,0 +1,322 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+)
+
+// The gRPC watch service, which serves the same queries and watches as the watch API. Its messages are defined in
+// watch.proto, which clients can generate code from. The server also serves gRPC reflection, so that generic clients
+// such as grpcurl can call it without the proto file.
+const watchServiceName = "proposer.v1.Watch"
+
+// watchProto is the descriptor of watch.proto. The proto file isn't compiled into Go code, so that serving it doesn't
+// require protoc: its messages are built from the descriptor at runtime, and must be kept in sync with watch.proto,
+// which TestWatchProtoMatchesDescriptor checks.
+var watchProto = mustBuildWatchProto()
+
+func mustBuildWatchProto() protoreflect.FileDescriptor {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     typ.Enum(),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			JsonName: proto.String(name),
+		}
+	}
+	repeated := func(f *descriptorpb.FieldDescriptorProto, typeName string) *descriptorpb.FieldDescriptorProto {
+		f.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		if typeName != "" {
+			f.TypeName = proto.String(".proposer.v1." + typeName)
+		}
+		return f
+	}
+	message := func(name string, fields ...*descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{Name: proto.String(name), Field: fields}
+	}
+	method := func(name, input, output string, streaming bool) *descriptorpb.MethodDescriptorProto {
+		return &descriptorpb.MethodDescriptorProto{
+			Name:            proto.String(name),
+			InputType:       proto.String(".proposer.v1." + input),
+			OutputType:      proto.String(".proposer.v1." + output),
+			ServerStreaming: proto.Bool(streaming),
+		}
+	}
+	const (
+		u64   = descriptorpb.FieldDescriptorProto_TYPE_UINT64
+		i64   = descriptorpb.FieldDescriptorProto_TYPE_INT64
+		str   = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		boolT = descriptorpb.FieldDescriptorProto_TYPE_BOOL
+		msg   = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("proposer/v1/watch.proto"),
+		Package: proto.String("proposer.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			message("QueueRequest"),
+			message("Queue",
+				field("l2_unsafe_head_block", 1, u64),
+				field("l2_safe_head_block", 2, u64),
+				field("l2_finalized_block", 3, u64),
+				field("latest_contract_l2_block", 4, u64),
+				field("highest_proven_contiguous_l2_block", 5, u64),
+				field("num_proving", 6, u64),
+				field("num_witnessgen", 7, u64),
+				field("num_unrequested", 8, u64),
+			),
+			message("ProofRequestRequest", field("id", 1, i64)),
+			message("ProofRequest",
+				field("id", 1, i64),
+				field("type", 2, str),
+				field("start_block", 3, u64),
+				field("end_block", 4, u64),
+				field("status", 5, str),
+				field("backfill", 6, boolT),
+				field("parent_id", 7, i64),
+				field("request_added_time", 8, u64),
+				field("last_updated_time", 9, u64),
+				field("prover_request_id", 10, str),
+				field("proof_request_time", 11, u64),
+				field("program_version", 12, str),
+				field("l1_block_number", 13, u64),
+				field("l1_block_hash", 14, str),
+				field("estimated_cycles", 15, u64),
+				field("estimated_fee", 16, u64),
+				field("fulfilled_cycles", 17, u64),
+				field("fulfilled_fee", 18, u64),
+				field("prover", 19, str),
+				field("fulfilled_time", 20, u64),
+				field("failure_stage", 21, str),
+				field("error_code", 22, str),
+				field("failure_reason", 23, str),
+				repeated(field("labels", 24, str), ""),
+				field("challenge_status", 25, str),
+			),
+			message("BlockStatusRequest", field("block", 1, u64)),
+			message("BlockStatus",
+				field("block", 1, u64),
+				field("stage", 2, str),
+				field("latest_output_block", 3, u64),
+				repeated(field("proofs", 4, msg), "ProofRequest"),
+			),
+			message("WatchOutputsRequest"),
+			message("Output", field("l2_block_number", 1, u64)),
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Watch"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				method("GetQueue", "QueueRequest", "Queue", false),
+				method("GetProofRequest", "ProofRequestRequest", "ProofRequest", false),
+				method("GetBlockStatus", "BlockStatusRequest", "BlockStatus", false),
+				method("WatchBlock", "BlockStatusRequest", "BlockStatus", true),
+				method("WatchOutputs", "WatchOutputsRequest", "Output", true),
+			},
+		}},
+	}
+	fd, err := protodesc.NewFile(file, nil)
+	if err != nil {
+		panic(fmt.Sprintf("invalid watch proto: %v", err))
+	}
+	return fd
+}
+
+// newWatchMessage creates an empty message of watch.proto.
+func newWatchMessage(name protoreflect.Name) *dynamicpb.Message {
+	return dynamicpb.NewMessage(watchProto.Messages().ByName(name))
+}
+
+// toWatchMessage converts a value of the watch API to a message of watch.proto. The JSON names of the value's fields
+// are the names of the message's fields.
+func toWatchMessage(name protoreflect.Name, value any) (*dynamicpb.Message, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	m := newWatchMessage(name)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("failed to convert %s: %w", name, err)
+	}
+	return m, nil
+}
+
+// watchGRPCServer implements the gRPC watch service.
+type watchGRPCServer struct {
+	l *L2OutputSubmitter
+}
+
+func (s *watchGRPCServer) getQueue(ctx context.Context, _ *dynamicpb.Message) (proto.Message, error) {
+	m, err := s.l.GetProposerMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchMessage("Queue", map[string]uint64{
+		"l2_unsafe_head_block":               m.L2UnsafeHeadBlock,
+		"l2_safe_head_block":                 m.L2SafeHeadBlock,
+		"l2_finalized_block":                 m.L2FinalizedBlock,
+		"latest_contract_l2_block":           m.LatestContractL2Block,
+		"highest_proven_contiguous_l2_block": m.HighestProvenContiguousL2Block,
+		"num_proving":                        m.NumProving,
+		"num_witnessgen":                     m.NumWitnessgen,
+		"num_unrequested":                    m.NumUnrequested,
+	})
+}
+
+func (s *watchGRPCServer) getProofRequest(_ context.Context, req *dynamicpb.Message) (proto.Message, error) {
+	id := req.Get(req.Descriptor().Fields().ByName("id")).Int()
+	p, err := s.l.db.GetProofRequestMetadata(int(id))
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "proof request %d not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toWatchMessage("ProofRequest", newProofRequestInfo(p))
+}
+
+func (s *watchGRPCServer) getBlockStatus(ctx context.Context, req *dynamicpb.Message) (proto.Message, error) {
+	block := req.Get(req.Descriptor().Fields().ByName("block")).Uint()
+	blockStatus, err := s.l.BlockStatus(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchMessage("BlockStatus", blockStatus)
+}
+
+func (s *watchGRPCServer) watchBlock(req *dynamicpb.Message, stream grpc.ServerStream) error {
+	block := req.Get(req.Descriptor().Fields().ByName("block")).Uint()
+	return s.stream(stream, "BlockStatus", s.l.watchBlock(block), func(value any) any { return value })
+}
+
+func (s *watchGRPCServer) watchOutputs(_ *dynamicpb.Message, stream grpc.ServerStream) error {
+	return s.stream(stream, "Output", s.l.watchOutputs(), func(value any) any {
+		return map[string]uint64{"l2_block_number": value.(uint64)}
+	})
+}
+
+// stream sends the values that poll returns on the stream, converted to messages of the given name, until the client
+// cancels the call.
+func (s *watchGRPCServer) stream(stream grpc.ServerStream, name protoreflect.Name, poll watchPoll, convert func(value any) any) error {
+	if s.l.l2ooContract == nil {
+		return grpcError(ErrNoL2OutputOracle)
+	}
+	err := s.l.watch(stream.Context(), poll, func(value any) error {
+		m, err := toWatchMessage(name, convert(value))
+		if err != nil {
+			return err
+		}
+		return stream.SendMsg(m)
+	})
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// grpcError converts errors that have a gRPC status code.
+func grpcError(err error) error {
+	if errors.Is(err, ErrNoL2OutputOracle) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return err
+}
+
+// watchServiceDesc describes the gRPC watch service to the gRPC server, like the service descriptors generated by
+// protoc-gen-go-grpc.
+func watchServiceDesc() *grpc.ServiceDesc {
+	unary := func(name protoreflect.Name, input protoreflect.Name, handle func(*watchGRPCServer, context.Context, *dynamicpb.Message) (proto.Message, error)) grpc.MethodDesc {
+		fullMethod := "/" + watchServiceName + "/" + string(name)
+		return grpc.MethodDesc{
+			MethodName: string(name),
+			Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				req := newWatchMessage(input)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req any) (any, error) {
+					resp, err := handle(srv.(*watchGRPCServer), ctx, req.(*dynamicpb.Message))
+					return resp, grpcError(err)
+				}
+				if interceptor == nil {
+					return handler(ctx, req)
+				}
+				return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}, handler)
+			},
+		}
+	}
+	serverStream := func(name protoreflect.Name, input protoreflect.Name, handle func(*watchGRPCServer, *dynamicpb.Message, grpc.ServerStream) error) grpc.StreamDesc {
+		return grpc.StreamDesc{
+			StreamName:    string(name),
+			ServerStreams: true,
+			Handler: func(srv any, stream grpc.ServerStream) error {
+				req := newWatchMessage(input)
+				if err := stream.RecvMsg(req); err != nil {
+					return err
+				}
+				return handle(srv.(*watchGRPCServer), req, stream)
+			},
+		}
+	}
+	return &grpc.ServiceDesc{
+		ServiceName: watchServiceName,
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			unary("GetQueue", "QueueRequest", (*watchGRPCServer).getQueue),
+			unary("GetProofRequest", "ProofRequestRequest", (*watchGRPCServer).getProofRequest),
+			unary("GetBlockStatus", "BlockStatusRequest", (*watchGRPCServer).getBlockStatus),
+		},
+		Streams: []grpc.StreamDesc{
+			serverStream("WatchBlock", "BlockStatusRequest", (*watchGRPCServer).watchBlock),
+			serverStream("WatchOutputs", "WatchOutputsRequest", (*watchGRPCServer).watchOutputs),
+		},
+		Metadata: watchProto.Path(),
+	}
+}
+
+// newWatchGRPCServer creates the gRPC server of the watch service.
+func newWatchGRPCServer(l *L2OutputSubmitter) *grpc.Server {
+	srv := grpc.NewServer()
+	srv.RegisterService(watchServiceDesc(), &watchGRPCServer{l: l})
+	// The reflection service looks up the descriptors of the services it serves in the given registry.
+	files := new(protoregistry.Files)
+	if err := files.RegisterFile(watchProto); err != nil {
+		panic(fmt.Sprintf("failed to register watch proto: %v", err))
+	}
+	reflectionpb.RegisterServerReflectionServer(srv, reflection.NewServer(reflection.ServerOptions{Services: srv, DescriptorResolver: files}))
+	return srv
+}
+
+// startWatchGRPCServer serves the gRPC watch service on the given address.
+func startWatchGRPCServer(l *L2OutputSubmitter, addr string) (*grpc.Server, net.Addr, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	srv := newWatchGRPCServer(l)
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			l.Log.Error("gRPC server stopped", "err", err)
+		}
+	}()
+	return srv, lis.Addr(), nil
+}
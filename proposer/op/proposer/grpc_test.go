@@ -0,0 +1,90 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// TestWatchGRPCServer tests that the gRPC watch service serves proof requests, and fails the block queries of a
+// proposer without an L2OO.
+func TestWatchGRPCServer(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200))
+
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Log: testlog.Logger(t, log.LevelInfo)}, db: *proofDB}
+	srv, addr, err := startWatchGRPCServer(l, "127.0.0.1:0")
+	require.NoError(t, err)
+	defer srv.Stop()
+	conn, err := grpc.Dial(addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := context.Background()
+
+	req := newWatchMessage("ProofRequestRequest")
+	req.Set(req.Descriptor().Fields().ByName("id"), protoreflect.ValueOfInt64(1))
+	resp := newWatchMessage("ProofRequest")
+	require.NoError(t, conn.Invoke(ctx, "/proposer.v1.Watch/GetProofRequest", req, resp))
+	fields := resp.Descriptor().Fields()
+	require.Equal(t, "SPAN", resp.Get(fields.ByName("type")).String())
+	require.Equal(t, uint64(100), resp.Get(fields.ByName("start_block")).Uint())
+	require.Equal(t, uint64(200), resp.Get(fields.ByName("end_block")).Uint())
+	require.Equal(t, "UNREQ", resp.Get(fields.ByName("status")).String())
+
+	req.Set(req.Descriptor().Fields().ByName("id"), protoreflect.ValueOfInt64(2))
+	err = conn.Invoke(ctx, "/proposer.v1.Watch/GetProofRequest", req, newWatchMessage("ProofRequest"))
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Without an L2OO, there are no outputs to compare blocks to.
+	blockReq := newWatchMessage("BlockStatusRequest")
+	err = conn.Invoke(ctx, "/proposer.v1.Watch/GetBlockStatus", blockReq, newWatchMessage("BlockStatus"))
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	desc := &grpc.StreamDesc{StreamName: "WatchBlock", ServerStreams: true}
+	stream, err := conn.NewStream(ctx, desc, "/proposer.v1.Watch/WatchBlock")
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg(blockReq))
+	require.NoError(t, stream.CloseSend())
+	err = stream.RecvMsg(newWatchMessage("BlockStatus"))
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// TestWatchProtoMatchesDescriptor tests that the descriptor the gRPC watch service is built from matches watch.proto.
+func TestWatchProtoMatchesDescriptor(t *testing.T) {
+	compiler := protocompile.Compiler{Resolver: &protocompile.SourceResolver{}}
+	files, err := compiler.Compile(context.Background(), "watch.proto")
+	require.NoError(t, err)
+
+	// The file name and the JSON names of the fields differ on purpose, and the source info only exists when parsing.
+	normalize := func(fd protoreflect.FileDescriptor) *descriptorpb.FileDescriptorProto {
+		file := protodesc.ToFileDescriptorProto(fd)
+		file.Name = nil
+		file.SourceCodeInfo = nil
+		for _, msg := range file.MessageType {
+			for _, field := range msg.Field {
+				field.JsonName = nil
+			}
+		}
+		return file
+	}
+	want, got := normalize(files[0]), normalize(watchProto)
+	require.True(t, proto.Equal(want, got), "runtime descriptor doesn't match watch.proto:\nwant %v\ngot  %v", want, got)
+}
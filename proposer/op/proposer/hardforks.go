@@ -0,0 +1,110 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// forkActivation is the first L2 block at which a hardfork is active.
+type forkActivation struct {
+	name  string
+	block uint64
+}
+
+// forkSchedule holds the activation blocks of the hardforks that change the range program needed to prove L2 blocks.
+// A span proof must not cross an activation, as the blocks on either side are proven by different programs.
+type forkSchedule struct {
+	// The scheduled activations, in activation order.
+	activations []forkActivation
+}
+
+// newForkSchedule computes the activation blocks of the Fjord, Granite and Holocene hardforks from the rollup config.
+// Hardforks that aren't scheduled are skipped.
+func newForkSchedule(cfg *rollup.Config) *forkSchedule {
+	forks := []struct {
+		name string
+		time *uint64
+	}{
+		{"fjord", cfg.FjordTime},
+		{"granite", cfg.GraniteTime},
+		{"holocene", cfg.HoloceneTime},
+	}
+
+	s := &forkSchedule{}
+	for _, fork := range forks {
+		if fork.time == nil {
+			continue
+		}
+		s.activations = append(s.activations, forkActivation{name: fork.name, block: activationBlock(cfg, *fork.time)})
+	}
+	return s
+}
+
+// activationBlock returns the first L2 block whose timestamp is at or after the given activation time.
+func activationBlock(cfg *rollup.Config, time uint64) uint64 {
+	if time <= cfg.Genesis.L2Time {
+		return cfg.Genesis.L2.Number
+	}
+	return cfg.Genesis.L2.Number + (time-cfg.Genesis.L2Time+cfg.BlockTime-1)/cfg.BlockTime
+}
+
+// splitSpans splits the spans that cross a hardfork activation, so that the blocks proven by each span are all before
+// or all after the activation. A span proves the blocks (Start, End], so a span is split at the last block before the
+// activation.
+func (s *forkSchedule) splitSpans(spans []Span) []Span {
+	var result []Span
+	for _, span := range spans {
+		for _, fork := range s.activations {
+			boundary := fork.block - min(fork.block, 1)
+			if span.Start < boundary && boundary < span.End {
+				result = append(result, Span{Start: span.Start, End: boundary})
+				span.Start = boundary
+			}
+		}
+		result = append(result, span)
+	}
+	return result
+}
+
+// programVersion returns the hardfork whose range program proves the given L2 block, or "" if the block is before all
+// scheduled hardforks.
+func (s *forkSchedule) programVersion(block uint64) string {
+	version := ""
+	for _, fork := range s.activations {
+		if block >= fork.block {
+			version = fork.name
+		}
+	}
+	return version
+}
+
+// loadForkSchedule returns the hardfork schedule of the L2 chain, which is loaded from the rollup node on first use.
+func (l *L2OutputSubmitter) loadForkSchedule(ctx context.Context) (*forkSchedule, error) {
+	if s := l.forks.Load(); s != nil {
+		return s, nil
+	}
+
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting rollup config: %w", err)
+	}
+	s := newForkSchedule(rollupCfg)
+	l.forks.Store(s)
+	return s, nil
+}
+
+// programVersion returns the hardfork whose range program proves the span proof ending at the given L2 block, or "" if
+// the hardfork schedule isn't loaded.
+func (l *L2OutputSubmitter) programVersion(end uint64) string {
+	s := l.forks.Load()
+	if s == nil {
+		return ""
+	}
+	return s.programVersion(end)
+}
@@ -0,0 +1,117 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// L2Heads are the L2 heads tracked from the rollup node. Each block ref includes the L1 block it was derived from.
+type L2Heads struct {
+	Unsafe    eth.L2BlockRef
+	Safe      eth.L2BlockRef
+	Finalized eth.L2BlockRef
+	// The time the heads were fetched from the rollup node.
+	UpdatedAt time.Time
+}
+
+// headTracker polls the sync status of the rollup node in the background, so that the driver reads the L2 safe and
+// finalized heads without a round trip to the rollup node on every use.
+type headTracker struct {
+	rollupProvider dial.RollupProvider
+	log            log.Logger
+
+	mu        sync.RWMutex
+	status    *eth.SyncStatus
+	updatedAt time.Time
+	// The tracked status is only used while it's younger than maxAge, so that a stalled tracker doesn't hold the
+	// driver back on old heads.
+	maxAge time.Duration
+}
+
+func newHeadTracker(rollupProvider dial.RollupProvider, log log.Logger) *headTracker {
+	return &headTracker{rollupProvider: rollupProvider, log: log}
+}
+
+// run polls the sync status every interval until the context is done.
+func (t *headTracker) run(ctx context.Context, interval time.Duration) {
+	t.mu.Lock()
+	t.maxAge = 2 * interval
+	t.mu.Unlock()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := t.update(ctx); err != nil && ctx.Err() == nil {
+			t.log.Warn("failed to update L2 heads", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update fetches the sync status from the rollup node and records it as the tracked status.
+func (t *headTracker) update(ctx context.Context) (*eth.SyncStatus, error) {
+	rollupClient, err := t.rollupProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting rollup client: %w", err)
+	}
+	status, err := rollupClient.SyncStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting sync status: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if prev := t.status; prev != nil {
+		if status.SafeL2.Number < prev.SafeL2.Number {
+			t.log.Warn("L2 safe head moved back", "prev", prev.SafeL2.ID(), "new", status.SafeL2.ID())
+		}
+		if status.FinalizedL2.Number < prev.FinalizedL2.Number {
+			t.log.Warn("L2 finalized head moved back", "prev", prev.FinalizedL2.ID(), "new", status.FinalizedL2.ID())
+		}
+	}
+	t.status = status
+	t.updatedAt = time.Now()
+	return status, nil
+}
+
+// syncStatus returns the tracked sync status, or fetches it from the rollup node if it isn't tracked or is too old.
+func (t *headTracker) syncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	t.mu.RLock()
+	status, updatedAt, maxAge := t.status, t.updatedAt, t.maxAge
+	t.mu.RUnlock()
+
+	if status != nil && time.Since(updatedAt) < maxAge {
+		return status, nil
+	}
+	return t.update(ctx)
+}
+
+// Heads returns the tracked L2 heads, and false if they haven't been fetched yet.
+func (t *headTracker) Heads() (L2Heads, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.status == nil {
+		return L2Heads{}, false
+	}
+	return L2Heads{
+		Unsafe:    t.status.UnsafeL2,
+		Safe:      t.status.SafeL2,
+		Finalized: t.status.FinalizedL2,
+		UpdatedAt: t.updatedAt,
+	}, true
+}
+
+// L2Heads returns the L2 heads tracked by the proposer, and false if they haven't been fetched yet.
+func (l *L2OutputSubmitter) L2Heads() (L2Heads, bool) {
+	return l.heads.Heads()
+}
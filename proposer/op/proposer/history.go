@@ -0,0 +1,227 @@
+package proposer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// The page sizes of the request history API.
+const (
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 1000
+)
+
+// ProofRequestInfo is a proof request as served by the request history API. Proofs aren't included.
+type ProofRequestInfo struct {
+	ID               int    `json:"id"`
+	Type             string `json:"type"`
+	StartBlock       uint64 `json:"start_block"`
+	EndBlock         uint64 `json:"end_block"`
+	Status           string `json:"status"`
+	Backfill         bool   `json:"backfill"`
+	ParentID         int    `json:"parent_id,omitempty"`
+	RequestAddedTime uint64 `json:"request_added_time"`
+	LastUpdatedTime  uint64 `json:"last_updated_time"`
+	ProverRequestID  string `json:"prover_request_id,omitempty"`
+	ProofRequestTime uint64 `json:"proof_request_time,omitempty"`
+	ProgramVersion   string `json:"program_version,omitempty"`
+	L1BlockNumber    uint64 `json:"l1_block_number,omitempty"`
+	L1BlockHash      string `json:"l1_block_hash,omitempty"`
+
+	EstimatedCycles uint64 `json:"estimated_cycles,omitempty"`
+	EstimatedFee    uint64 `json:"estimated_fee,omitempty"`
+	FulfilledCycles uint64 `json:"fulfilled_cycles,omitempty"`
+	FulfilledFee    uint64 `json:"fulfilled_fee,omitempty"`
+	Prover          string `json:"prover,omitempty"`
+	FulfilledTime   uint64 `json:"fulfilled_time,omitempty"`
+
+	FailureStage  string `json:"failure_stage,omitempty"`
+	ErrorCode     string `json:"error_code,omitempty"`
+	FailureReason string `json:"failure_reason,omitempty"`
+
+	Labels []string `json:"labels,omitempty"`
+
+	ChallengeStatus string `json:"challenge_status,omitempty"`
+}
+
+func newProofRequestInfo(p *ent.ProofRequest) ProofRequestInfo {
+	return ProofRequestInfo{
+		ID:               p.ID,
+		Type:             p.Type.String(),
+		StartBlock:       p.StartBlock,
+		EndBlock:         p.EndBlock,
+		Status:           p.Status.String(),
+		Backfill:         p.Backfill,
+		ParentID:         p.ParentID,
+		RequestAddedTime: p.RequestAddedTime,
+		LastUpdatedTime:  p.LastUpdatedTime,
+		ProverRequestID:  p.ProverRequestID,
+		ProofRequestTime: p.ProofRequestTime,
+		ProgramVersion:   p.ProgramVersion,
+		L1BlockNumber:    p.L1BlockNumber,
+		L1BlockHash:      p.L1BlockHash,
+		EstimatedCycles:  p.EstimatedCycles,
+		EstimatedFee:     p.EstimatedFee,
+		FulfilledCycles:  p.FulfilledCycles,
+		FulfilledFee:     p.FulfilledFee,
+		Prover:           p.Prover,
+		FulfilledTime:    p.FulfilledTime,
+		FailureStage:     string(p.FailureStage),
+		ErrorCode:        p.ErrorCode,
+		FailureReason:    p.FailureReason,
+		Labels:           p.Labels,
+		ChallengeStatus:  string(p.ChallengeStatus),
+	}
+}
+
+// ProofRequestPage is a page of the request history API. If there are more requests, NextCursor is passed as the cursor
+// parameter to get the next page.
+type ProofRequestPage struct {
+	Requests   []ProofRequestInfo `json:"requests"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+// historyHandler serves the request history API, and passes other requests to next, so that dashboards can query the
+// proof requests without access to the DB:
+//
+//   - GET /requests lists the proof requests, newest first. They're filtered by the status, type, label, start and end
+//     (block range overlap), and since and until (Unix time the request was added) query parameters, and paginated with
+//     the limit and cursor query parameters.
+//   - GET /requests/{id} returns a single proof request.
+func (ps *ProposerService) historyHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, ok := strings.CutPrefix(r.URL.Path, "/requests")
+		if !ok || (path != "" && !strings.HasPrefix(path, "/")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			writeAPIError(w, http.StatusMethodNotAllowed, errors.New("only GET is supported"))
+			return
+		}
+
+		var resp any
+		var err error
+		if id := strings.TrimPrefix(path, "/"); id != "" {
+			resp, err = ps.getProofRequest(id)
+		} else {
+			resp, err = ps.listProofRequests(r)
+		}
+		if err != nil {
+			var notFound *ent.NotFoundError
+			switch {
+			case errors.As(err, &notFound):
+				writeAPIError(w, http.StatusNotFound, err)
+			case errors.Is(err, errInvalidHistoryQuery):
+				writeAPIError(w, http.StatusBadRequest, err)
+			default:
+				ps.Log.Error("failed to serve request history", "err", err)
+				writeAPIError(w, http.StatusInternalServerError, err)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			ps.Log.Error("failed to write request history", "err", err)
+		}
+	})
+}
+
+// errInvalidHistoryQuery is returned for query parameters of the request history API that can't be parsed.
+var errInvalidHistoryQuery = errors.New("invalid query")
+
+func (ps *ProposerService) getProofRequest(idParam string) (*ProofRequestInfo, error) {
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid proof request ID %q", errInvalidHistoryQuery, idParam)
+	}
+	p, err := ps.driver.db.GetProofRequestMetadata(id)
+	if err != nil {
+		return nil, err
+	}
+	info := newProofRequestInfo(p)
+	return &info, nil
+}
+
+func (ps *ProposerService) listProofRequests(r *http.Request) (*ProofRequestPage, error) {
+	query := r.URL.Query()
+	uintParam := func(name string) (uint64, error) {
+		value := query.Get(name)
+		if value == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid %s %q", errInvalidHistoryQuery, name, value)
+		}
+		return n, nil
+	}
+
+	filter := db.ProofRequestFilter{
+		Status: proofrequest.Status(strings.ToUpper(query.Get("status"))),
+		Type:   proofrequest.Type(strings.ToUpper(query.Get("type"))),
+		Label:  query.Get("label"),
+	}
+	if filter.Status != "" {
+		if err := proofrequest.StatusValidator(filter.Status); err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidHistoryQuery, err)
+		}
+	}
+	if filter.Type != "" {
+		if err := proofrequest.TypeValidator(filter.Type); err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidHistoryQuery, err)
+		}
+	}
+	var err error
+	if filter.StartBlock, err = uintParam("start"); err != nil {
+		return nil, err
+	}
+	if filter.EndBlock, err = uintParam("end"); err != nil {
+		return nil, err
+	}
+	if filter.Since, err = uintParam("since"); err != nil {
+		return nil, err
+	}
+	if filter.Until, err = uintParam("until"); err != nil {
+		return nil, err
+	}
+	cursor, err := uintParam("cursor")
+	if err != nil {
+		return nil, err
+	}
+	limit, err := uintParam("limit")
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultHistoryPageSize
+	}
+	limit = min(limit, maxHistoryPageSize)
+
+	proofs, err := ps.driver.db.ListProofRequests(filter, int(cursor), int(limit))
+	if err != nil {
+		return nil, err
+	}
+	page := &ProofRequestPage{Requests: make([]ProofRequestInfo, 0, len(proofs))}
+	for _, p := range proofs {
+		page.Requests = append(page.Requests, newProofRequestInfo(p))
+	}
+	if len(proofs) == int(limit) {
+		page.NextCursor = strconv.Itoa(proofs[len(proofs)-1].ID)
+	}
+	return page, nil
+}
+
+func writeAPIError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// TestHistoryHandler tests that the request history API filters and paginates the proof requests.
+func TestHistoryHandler(t *testing.T) {
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	for start := uint64(0); start < 500; start += 100 {
+		require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, start, start+100))
+	}
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeAGG, 0, 500))
+
+	ps := &ProposerService{Log: testlog.Logger(t, log.LevelInfo), driver: &L2OutputSubmitter{db: *proofDB}}
+	handler := ps.historyHandler(http.NotFoundHandler())
+	get := func(url string) (int, ProofRequestPage) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		var page ProofRequestPage
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		}
+		return rec.Code, page
+	}
+
+	// The span proofs overlapping blocks 150-350, newest first, two per page.
+	code, page := get("/requests?type=span&start=150&end=350&limit=2")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, page.Requests, 2)
+	require.Equal(t, uint64(300), page.Requests[0].StartBlock)
+	require.Equal(t, uint64(200), page.Requests[1].StartBlock)
+	require.NotEmpty(t, page.NextCursor)
+
+	code, page = get("/requests?type=span&start=150&end=350&limit=2&cursor=" + page.NextCursor)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, page.Requests, 1)
+	require.Equal(t, uint64(100), page.Requests[0].StartBlock)
+	require.Empty(t, page.NextCursor)
+
+	code, _ = get("/requests?status=bogus")
+	require.Equal(t, http.StatusBadRequest, code)
+	code, _ = get("/requests/1000")
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = get("/requestsfoo")
+	require.Equal(t, http.StatusNotFound, code)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/requests/6", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var info ProofRequestInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &info))
+	require.Equal(t, "AGG", info.Type)
+}
@@ -0,0 +1,158 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// DependencySetConfig is the dependency set of a Superchain interop chain, as read from the dependency set file. It
+// lists the chains whose messages the L2 chain can execute, with a rollup node of each.
+type DependencySetConfig struct {
+	Chains []DependencyChainConfig `json:"chains"`
+}
+
+// DependencyChainConfig is a chain in the dependency set.
+type DependencyChainConfig struct {
+	ChainID   uint64 `json:"chain_id"`
+	RollupRpc string `json:"rollup_rpc"`
+}
+
+// LoadDependencySet reads the dependency set file at the given path.
+func LoadDependencySet(path string) (*DependencySetConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dependency set: %w", err)
+	}
+	var cfg DependencySetConfig
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode dependency set: %w", err)
+	}
+	for _, chain := range cfg.Chains {
+		if chain.ChainID == 0 || chain.RollupRpc == "" {
+			return nil, fmt.Errorf("dependency set chain %d needs a chain ID and a rollup RPC", chain.ChainID)
+		}
+	}
+	return &cfg, nil
+}
+
+// DependencyHead is the finalized head of a chain in the dependency set. Span proof requests include the heads that
+// the span was planned against, so that the server proves the messages executed within the span against the same
+// states of the other chains.
+type DependencyHead struct {
+	ChainID   uint64      `json:"chain_id"`
+	Number    uint64      `json:"number"`
+	Hash      common.Hash `json:"hash"`
+	Timestamp uint64      `json:"timestamp"`
+}
+
+// dependencySet tracks the finalized heads of the chains in the dependency set. Under interop rules, an L2 block is
+// only valid once the messages that it executes are initiated in finalized blocks of the other chains, so span proofs
+// must not cover L2 blocks that are newer than the finalized head of any chain in the dependency set.
+type dependencySet struct {
+	chains    []DependencyChainConfig
+	providers []dial.RollupProvider
+	// The heads fetched when spans were last planned.
+	heads atomic.Pointer[[]DependencyHead]
+}
+
+func newDependencySet(ctx context.Context, cfg *DependencySetConfig, log log.Logger) (*dependencySet, error) {
+	s := &dependencySet{chains: cfg.Chains}
+	for _, chain := range cfg.Chains {
+		provider, err := dial.NewStaticL2RollupProvider(ctx, log, chain.RollupRpc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial rollup node of dependency chain %d: %w", chain.ChainID, err)
+		}
+		s.providers = append(s.providers, provider)
+	}
+	return s, nil
+}
+
+// fetchHeads fetches the finalized heads of the chains in the dependency set, and records them for proof requests.
+func (s *dependencySet) fetchHeads(ctx context.Context) ([]DependencyHead, error) {
+	heads := make([]DependencyHead, len(s.chains))
+	for i, chain := range s.chains {
+		rollupClient, err := s.providers[i].RollupClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting rollup client of dependency chain %d: %w", chain.ChainID, err)
+		}
+		status, err := rollupClient.SyncStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting sync status of dependency chain %d: %w", chain.ChainID, err)
+		}
+		heads[i] = DependencyHead{
+			ChainID:   chain.ChainID,
+			Number:    status.FinalizedL2.Number,
+			Hash:      status.FinalizedL2.Hash,
+			Timestamp: status.FinalizedL2.Time,
+		}
+	}
+	s.heads.Store(&heads)
+	return heads, nil
+}
+
+// lastHeads returns the heads fetched when spans were last planned, or nil if they weren't fetched yet.
+func (s *dependencySet) lastHeads() []DependencyHead {
+	if heads := s.heads.Load(); heads != nil {
+		return *heads
+	}
+	return nil
+}
+
+// dependencyBound returns the highest L2 block up to end whose timestamp is covered by the finalized heads of all the
+// chains in the dependency set.
+func dependencyBound(cfg *rollup.Config, end uint64, heads []DependencyHead) uint64 {
+	bound := end
+	for _, dep := range heads {
+		if dep.Timestamp < cfg.Genesis.L2Time {
+			return cfg.Genesis.L2.Number
+		}
+		bound = min(bound, cfg.Genesis.L2.Number+(dep.Timestamp-cfg.Genesis.L2Time)/cfg.BlockTime)
+	}
+	return bound
+}
+
+// boundByDependencies lowers the end of the span planning range to the last L2 block whose executing messages can be
+// checked against the finalized heads of the dependency set. Without a dependency set, the end is returned as is.
+func (l *L2OutputSubmitter) boundByDependencies(ctx context.Context, end uint64) (uint64, error) {
+	if l.interop == nil {
+		return end, nil
+	}
+	heads, err := l.interop.fetchHeads(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rollupClient, err := l.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting rollup config: %w", err)
+	}
+	bound := dependencyBound(rollupCfg, end, heads)
+	if bound < end {
+		l.Log.Debug("bounded span proofs by the dependency set", "end", end, "bound", bound, "dependencies", heads)
+	}
+	return bound, nil
+}
+
+// dependencyHeads returns the dependency set heads to include in span proof requests, or nil without a dependency set.
+// The heads are fetched if spans weren't planned yet, e.g. for requests queued before a restart. Later heads still
+// cover the span, as heads only move forward.
+func (l *L2OutputSubmitter) dependencyHeads(ctx context.Context) ([]DependencyHead, error) {
+	if l.interop == nil {
+		return nil, nil
+	}
+	if heads := l.interop.lastHeads(); heads != nil {
+		return heads, nil
+	}
+	return l.interop.fetchHeads(ctx)
+}
@@ -0,0 +1,23 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyBound(t *testing.T) {
+	cfg := &rollup.Config{BlockTime: 2}
+	cfg.Genesis.L2.Number = 100
+	cfg.Genesis.L2Time = 1000
+
+	// Without dependencies, the end isn't bounded.
+	require.Equal(t, uint64(200), dependencyBound(cfg, 200, nil))
+	// Block 150 has timestamp 1100. The slowest chain bounds the end.
+	heads := []DependencyHead{{ChainID: 1, Timestamp: 1300}, {ChainID: 2, Timestamp: 1101}}
+	require.Equal(t, uint64(150), dependencyBound(cfg, 200, heads))
+	require.Equal(t, uint64(120), dependencyBound(cfg, 120, heads))
+	// A chain whose finalized head is older than the L2 genesis holds back all span proofs.
+	require.Equal(t, uint64(100), dependencyBound(cfg, 200, []DependencyHead{{ChainID: 1, Timestamp: 900}}))
+}
@@ -16,10 +16,27 @@ import (
 // This method returns a cliapp.LifecycleAction, to create an op-service CLI-lifecycle-managed L2Output-submitter
 func Main(version string) cliapp.LifecycleAction {
 	return func(cliCtx *cli.Context, _ context.CancelCauseFunc) (cliapp.Lifecycle, error) {
+		// Record the settings that were set through flags or environment variables, as they take precedence over the
+		// config file when it's reloaded.
+		explicit := make(map[string]bool)
+		for _, name := range cliCtx.FlagNames() {
+			explicit[name] = cliCtx.IsSet(name)
+		}
+		if path := cliCtx.String(flags.ConfigFileFlag.Name); path != "" {
+			if err := LoadConfigFile(cliCtx, path); err != nil {
+				return nil, err
+			}
+		}
+		if network := cliCtx.String(flags.NetworkFlag.Name); network != "" {
+			if err := ApplyNetworkPreset(cliCtx, network); err != nil {
+				return nil, err
+			}
+		}
 		if err := flags.CheckRequired(cliCtx); err != nil {
 			return nil, err
 		}
 		cfg := NewConfig(cliCtx)
+		cfg.explicitFlags = explicit
 		if err := cfg.Check(); err != nil {
 			return nil, fmt.Errorf("invalid CLI flags: %w", err)
 		}
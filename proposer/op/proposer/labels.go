@@ -0,0 +1,75 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+)
+
+// labelPattern restricts operator labels to short identifiers, e.g. "backfill-2024-09" or "incident-123", so that they
+// can be used as metric label values.
+var labelPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// validateLabels returns an error if any of the labels isn't a valid operator label.
+func validateLabels(labels []string) error {
+	if len(labels) == 0 {
+		return errors.New("no labels given")
+	}
+	for _, label := range labels {
+		if !labelPattern.MatchString(label) {
+			return fmt.Errorf("invalid label %q: labels are up to 64 letters, digits, '.', '_' or '-'", label)
+		}
+	}
+	return nil
+}
+
+// recordLabelMetrics updates the number of proof requests with each operator label.
+func (l *L2OutputSubmitter) recordLabelMetrics() error {
+	counts, err := l.db.CountLabeledProofRequests()
+	if err != nil {
+		return err
+	}
+	byLabel := make(map[string]map[string]int, len(counts))
+	for label, byStatus := range counts {
+		byLabel[label] = make(map[string]int, len(byStatus))
+		for status, count := range byStatus {
+			byLabel[label][status.String()] = count
+		}
+	}
+	l.Metr.RecordLabeledProofRequests(byLabel)
+	return nil
+}
+
+// labelsAPI lets operators organize the proof queue by attaching free-form labels to proof requests. Its methods are
+// served in the admin namespace of the RPC server, as admin_addProofLabels and admin_removeProofLabels. The labels are
+// kept by the requests that replace a labeled request when it's retried or split.
+type labelsAPI struct {
+	db *db.ProofDB
+}
+
+func getLabelsAPI(proofDB *db.ProofDB) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "admin",
+		Service:   &labelsAPI{db: proofDB},
+	}
+}
+
+// AddProofLabels attaches the labels to the proof requests with the given IDs.
+func (a *labelsAPI) AddProofLabels(_ context.Context, ids []int, labels []string) error {
+	if err := validateLabels(labels); err != nil {
+		return err
+	}
+	return a.db.AddLabels(ids, labels)
+}
+
+// RemoveProofLabels removes the labels from the proof requests with the given IDs.
+func (a *labelsAPI) RemoveProofLabels(_ context.Context, ids []int, labels []string) error {
+	if err := validateLabels(labels); err != nil {
+		return err
+	}
+	return a.db.RemoveLabels(ids, labels)
+}
@@ -0,0 +1,256 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Leadership decides which of several proposer replicas is active. Only the active replica requests and polls proofs
+// and submits outputs; the others stand by, sharing the proof DB of the active replica, and take over when it fails.
+type Leadership interface {
+	// IsLeader returns whether this replica is the active one. It's called on every driver tick, and again right
+	// before an output is submitted.
+	IsLeader(ctx context.Context) (bool, error)
+}
+
+// conductorLeadership follows the leadership of the op-conductor that the sequencer next to this replica runs, so that
+// the proposer fails over together with the sequencer.
+type conductorLeadership struct {
+	client *rpc.Client
+}
+
+func newConductorLeadership(ctx context.Context, url string) (*conductorLeadership, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial op-conductor: %w", err)
+	}
+	return &conductorLeadership{client: client}, nil
+}
+
+func (c *conductorLeadership) IsLeader(ctx context.Context) (bool, error) {
+	var leader bool
+	if err := c.client.CallContext(ctx, &leader, "conductor_leader"); err != nil {
+		return false, fmt.Errorf("failed to get op-conductor leadership: %w", err)
+	}
+	return leader, nil
+}
+
+// lease is the content of a lease file.
+type lease struct {
+	Holder string `json:"holder"`
+	// The Unix time in milliseconds at which the lease expires, unless it's renewed.
+	Expiry int64 `json:"expiry"`
+}
+
+// leaseLeadership elects the active replica with a lease file on storage shared by the replicas, next to the proof DB
+// that they share. The lease is renewed in the background by run, independently of the driver's stages, and another
+// replica only acquires it once it expired, i.e. once the leader stopped renewing it for the lease duration.
+//
+// Each time the replica acquires the lease, a term starts, which ends as soon as a renewal fails, so that the work of
+// the driver is cancelled before another replica can take over.
+type leaseLeadership struct {
+	path     string
+	holder   string
+	duration time.Duration
+
+	mu      sync.Mutex
+	leader  bool
+	err     error
+	term    context.Context
+	endTerm context.CancelFunc
+}
+
+func newLeaseLeadership(path, holder string, duration time.Duration) *leaseLeadership {
+	term, endTerm := context.WithCancel(context.Background())
+	endTerm()
+	return &leaseLeadership{path: path, holder: holder, duration: duration, term: term, endTerm: endTerm}
+}
+
+// IsLeader returns whether the replica held the lease on its last renewal.
+func (l *leaseLeadership) IsLeader(context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.leader, l.err
+}
+
+// Term returns a context that's cancelled once the current term of the replica as the leader ends. If the replica
+// isn't the leader, it's already cancelled.
+func (l *leaseLeadership) Term() context.Context {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.term
+}
+
+// run renews the lease three times per lease duration until the context is done.
+func (l *leaseLeadership) run(ctx context.Context, log log.Logger) {
+	ticker := time.NewTicker(l.duration / 3)
+	defer ticker.Stop()
+	for {
+		if _, err := l.renew(); err != nil {
+			log.Warn("failed to renew lease", "err", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			l.setLeader(false, nil)
+			return
+		}
+	}
+}
+
+// renew acquires or renews the lease if it's free or held by this replica, and returns whether the replica holds it.
+// If the lease can't be renewed, the replica's term ends.
+func (l *leaseLeadership) renew() (bool, error) {
+	leader, err := l.tryAcquire()
+	l.setLeader(leader, err)
+	return leader, err
+}
+
+func (l *leaseLeadership) setLeader(leader bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if leader && !l.leader {
+		l.term, l.endTerm = context.WithCancel(context.Background())
+	} else if !leader {
+		l.endTerm()
+	}
+	l.leader, l.err = leader, err
+}
+
+func (l *leaseLeadership) tryAcquire() (bool, error) {
+	unlock, err := l.lock()
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	now := time.Now()
+	var current lease
+	b, err := os.ReadFile(l.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return false, fmt.Errorf("failed to read lease: %w", err)
+	default:
+		if err := json.Unmarshal(b, &current); err != nil {
+			return false, fmt.Errorf("failed to decode lease: %w", err)
+		}
+	}
+	if current.Holder != l.holder && now.UnixMilli() < current.Expiry {
+		return false, nil
+	}
+
+	// Acquire or renew the lease. It's written to a temporary file first, so that a crash can't leave a torn lease.
+	b, err = json.Marshal(lease{Holder: l.holder, Expiry: now.Add(l.duration).UnixMilli()})
+	if err != nil {
+		return false, err
+	}
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return false, fmt.Errorf("failed to write lease: %w", err)
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return false, fmt.Errorf("failed to write lease: %w", err)
+	}
+	return true, nil
+}
+
+// lock takes an exclusive lock on the lease, so that two replicas can't acquire an expired lease at the same time. A
+// lock left behind by a replica that crashed while holding it is broken once it's older than the lease duration.
+func (l *leaseLeadership) lock() (func(), error) {
+	path := l.path + ".lock"
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create lease directory: %w", err)
+	}
+	for attempt := 0; ; attempt++ {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to lock lease: %w", err)
+		}
+		info, statErr := os.Stat(path)
+		if attempt > 0 || statErr != nil || time.Since(info.ModTime()) < l.duration {
+			return nil, fmt.Errorf("lease is locked by another replica: %w", err)
+		}
+		os.Remove(path)
+	}
+}
+
+// leaderContext returns a context for the work of the active replica, which is cancelled when its term as the leader
+// ends, if the leadership signal has terms.
+func (l *L2OutputSubmitter) leaderContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	lease, ok := l.leadership.(*leaseLeadership)
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(lease.Term(), cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// isActive returns whether this replica is the active proposer, logging leadership changes. Without a leadership
+// signal, the proposer is always active. If the leadership can't be determined, the replica stands by, so that two
+// replicas are never active at once.
+func (l *L2OutputSubmitter) isActive(ctx context.Context) bool {
+	if l.leadership == nil {
+		return true
+	}
+	cCtx, cancel := context.WithTimeout(ctx, l.config().NetworkTimeout)
+	defer cancel()
+	leader, err := l.leadership.IsLeader(cCtx)
+	if err != nil {
+		l.Log.Warn("failed to determine leadership, standing by", "err", err)
+		leader = false
+	}
+	if !l.leaderKnown || leader != l.wasLeader {
+		l.logLeadershipChange(leader)
+		l.leaderKnown, l.wasLeader = true, leader
+	}
+	l.Metr.RecordLeader(leader)
+	return leader
+}
+
+func (l *L2OutputSubmitter) logLeadershipChange(leader bool) {
+	if leader {
+		l.Log.Info("became the active proposer, taking over proof polling and output submission")
+	} else {
+		l.Log.Info("standing by, another replica is the active proposer")
+	}
+}
+
+// newLeadership returns the leadership signal of the proposer, or nil if it runs without replicas.
+func newLeadership(ctx context.Context, cfg ProposerConfig, log log.Logger) (Leadership, error) {
+	switch {
+	case cfg.ConductorRpc != "":
+		log.Info("following op-conductor leadership", "rpc", cfg.ConductorRpc)
+		return newConductorLeadership(ctx, cfg.ConductorRpc)
+	case cfg.LeaseFile != "":
+		holder := cfg.InstanceID
+		if holder == "" {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get hostname for the lease holder ID: %w", err)
+			}
+			holder = hostname
+		}
+		log.Info("electing the active proposer with a lease", "path", cfg.LeaseFile, "holder", holder, "duration", cfg.LeaseDuration)
+		return newLeaseLeadership(cfg.LeaseFile, holder, cfg.LeaseDuration), nil
+	default:
+		return nil, nil
+	}
+}
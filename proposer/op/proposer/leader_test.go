@@ -0,0 +1,76 @@
+package proposer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaseLeadershipFailover(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	active := newLeaseLeadership(path, "a", 100*time.Millisecond)
+	standby := newLeaseLeadership(path, "b", 100*time.Millisecond)
+
+	leader, err := active.renew()
+	require.NoError(t, err)
+	require.True(t, leader)
+	leader, err = standby.renew()
+	require.NoError(t, err)
+	require.False(t, leader)
+	require.Error(t, standby.Term().Err())
+
+	// The active replica renews the lease, then stops. Once the lease expired, the standby replica takes over, and
+	// the term of the active replica ends on its next renewal.
+	leader, err = active.renew()
+	require.NoError(t, err)
+	require.True(t, leader)
+	term := active.Term()
+	require.NoError(t, term.Err())
+	time.Sleep(150 * time.Millisecond)
+	leader, err = standby.renew()
+	require.NoError(t, err)
+	require.True(t, leader)
+	require.NoError(t, standby.Term().Err())
+	leader, err = active.renew()
+	require.NoError(t, err)
+	require.False(t, leader)
+	require.Error(t, term.Err())
+	leader, err = active.IsLeader(context.Background())
+	require.NoError(t, err)
+	require.False(t, leader)
+}
+
+// TestLeaseLeadershipRun tests that the lease is renewed in the background, however long the driver's work takes,
+// and that the term ends when the renewal stops.
+func TestLeaseLeadershipRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lease.json")
+	active := newLeaseLeadership(path, "a", 100*time.Millisecond)
+	standby := newLeaseLeadership(path, "b", 100*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		active.run(ctx, testlog.Logger(t, log.LevelInfo))
+	}()
+	require.Eventually(t, func() bool {
+		leader, err := active.IsLeader(ctx)
+		return err == nil && leader
+	}, time.Second, 10*time.Millisecond)
+	term := active.Term()
+
+	time.Sleep(300 * time.Millisecond)
+	leader, err := standby.renew()
+	require.NoError(t, err)
+	require.False(t, leader)
+	require.NoError(t, term.Err())
+
+	cancel()
+	<-done
+	require.Error(t, term.Err())
+}
@@ -0,0 +1,246 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	oputilmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+// Namespace is the namespace of the OP Succinct metrics. Unlike the op-proposer metrics, it doesn't include the
+// process name.
+const Namespace = opmetrics.Namespace
+
+// implements the Registry getter, for metrics HTTP server to hook into
+var _ oputilmetrics.RegistryMetricer = (*Metrics)(nil)
+
+// Metricer extends the op-proposer metrics with the metrics of the OP Succinct proposer.
+type Metricer interface {
+	opmetrics.Metricer
+
+	// RecordBackgroundPanic counts a panic recovered in a background worker.
+	RecordBackgroundPanic(worker string)
+	// RecordBatcherLag sets the number of L2 blocks between the unsafe head and the highest block whose batch is
+	// confirmed on L1.
+	RecordBatcherLag(blocks uint64)
+	// RecordPriceCeilingExceeded counts a span proof whose quote exceeded the price ceiling, by the action taken.
+	RecordPriceCeilingExceeded(action string)
+	// RecordProvingSpend sets the proving spend within a budget window.
+	RecordProvingSpend(window string, spent uint64)
+	// RecordOutputLag sets how far the latest output trails an L2 head.
+	RecordOutputLag(head string, lag time.Duration)
+	// RecordOutputSLASlack sets the time left until the output SLA is breached.
+	RecordOutputSLASlack(slack time.Duration)
+	// RecordOutputSLABreachPredicted sets whether the output SLA is breached, or predicted to be breached.
+	RecordOutputSLABreachPredicted(predicted bool)
+	// RecordEffectiveConcurrency sets the current limit of concurrent proof requests.
+	RecordEffectiveConcurrency(limit uint64)
+	// RecordSpanBackpressure sets whether new span proofs are held back because of the span proof backlog.
+	RecordSpanBackpressure(active bool)
+	// RecordSubmissionWindowOpen sets whether agg proofs can be submitted.
+	RecordSubmissionWindowOpen(open bool)
+	// RecordShadowComparison counts an output compared against the incumbent proposer, by result.
+	RecordShadowComparison(result string)
+	// RecordLabeledProofRequests sets the number of proof requests with each operator label, by status. Labels that
+	// aren't in the counts are no longer reported.
+	RecordLabeledProofRequests(counts map[string]map[string]int)
+	// RecordOutputRootMismatch counts an agg proof whose output root didn't match the rollup node's.
+	RecordOutputRootMismatch()
+	// RecordLeader sets whether this instance is the active proposer.
+	RecordLeader(leader bool)
+	// RecordDisputeGameChallenge counts a challenge event on a dispute game created by the proposer.
+	RecordDisputeGameChallenge(event string)
+	// RecordPublishedEvent counts a lifecycle event published to the event bus, by type and result.
+	RecordPublishedEvent(eventType, result string)
+}
+
+type Metrics struct {
+	*opmetrics.Metrics
+
+	backgroundPanics         *prometheus.CounterVec
+	batcherLag               prometheus.Gauge
+	priceCeilingExceeded     *prometheus.CounterVec
+	provingSpend             *prometheus.GaugeVec
+	outputLag                *prometheus.GaugeVec
+	outputSLASlack           prometheus.Gauge
+	outputSLABreachPredicted prometheus.Gauge
+	effectiveConcurrency     prometheus.Gauge
+	spanBackpressure         prometheus.Gauge
+	submissionWindowOpen     prometheus.Gauge
+	shadowComparisons        *prometheus.CounterVec
+	labeledProofRequests     *prometheus.GaugeVec
+	outputRootMismatches     prometheus.Counter
+	isLeader                 prometheus.Gauge
+	disputeGameChallenges    *prometheus.CounterVec
+	publishedEvents          *prometheus.CounterVec
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics(procName string) *Metrics {
+	m := opmetrics.NewMetrics(procName)
+	factory := oputilmetrics.With(m.Registry())
+
+	return &Metrics{
+		Metrics: m,
+
+		backgroundPanics: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "background_panics_total",
+			Help:      "Number of panics recovered in background workers",
+		}, []string{"worker"}),
+		batcherLag: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "batcher_lag_blocks",
+			Help:      "Number of L2 blocks between the unsafe head and the highest block whose batch is confirmed on L1",
+		}),
+		priceCeilingExceeded: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "price_ceiling_exceeded_total",
+			Help:      "Number of span proofs whose quote exceeded the price ceiling",
+		}, []string{"action"}),
+		provingSpend: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "proving_spend",
+			Help:      "Sum of the fees of the proofs fulfilled within the budget window",
+		}, []string{"window"}),
+		outputLag: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "output_lag_seconds",
+			Help:      "How far the latest output on the L2OO contract trails the L2 head",
+		}, []string{"head"}),
+		outputSLASlack: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "output_sla_slack_seconds",
+			Help:      "Time left until the lag of the latest output behind the L2 safe head breaches the output SLA",
+		}),
+		outputSLABreachPredicted: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "output_sla_breach_predicted",
+			Help:      "1 if the output SLA is breached, or predicted to be breached within the alert window at the current proving throughput",
+		}),
+		effectiveConcurrency: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "effective_max_concurrent_proof_requests",
+			Help:      "Current limit of concurrent proof requests, adjusted to the observed proof turnaround and failure rate",
+		}),
+		spanBackpressure: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "span_backpressure",
+			Help:      "1 if new span proofs aren't queued until the backlog of unrequested and requested span proofs drains",
+		}),
+		submissionWindowOpen: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "submission_window_open",
+			Help:      "1 if agg proofs can be submitted: within a submission window, and below the max L1 base fee",
+		}),
+		shadowComparisons: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "shadow_comparisons_total",
+			Help:      "Number of outputs compared against the incumbent proposer in shadow mode",
+		}, []string{"result"}),
+		labeledProofRequests: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "labeled_proof_requests",
+			Help:      "Number of proof requests with each operator label, by status",
+		}, []string{"label", "status"}),
+		outputRootMismatches: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "output_root_mismatches_total",
+			Help:      "Number of agg proofs whose output root doesn't match the output root computed by the rollup node",
+		}),
+		isLeader: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "is_leader",
+			Help:      "1 if this instance is the active proposer, 0 if it's on standby",
+		}),
+		disputeGameChallenges: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "dispute_game_challenges_total",
+			Help:      "Number of challenge events on dispute games created by the proposer",
+		}, []string{"event"}),
+		publishedEvents: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "events_published_total",
+			Help:      "Number of lifecycle events published to the event bus",
+		}, []string{"type", "result"}),
+	}
+}
+
+func (m *Metrics) RecordBackgroundPanic(worker string) {
+	m.backgroundPanics.WithLabelValues(worker).Inc()
+}
+
+func (m *Metrics) RecordBatcherLag(blocks uint64) {
+	m.batcherLag.Set(float64(blocks))
+}
+
+func (m *Metrics) RecordPriceCeilingExceeded(action string) {
+	m.priceCeilingExceeded.WithLabelValues(action).Inc()
+}
+
+func (m *Metrics) RecordProvingSpend(window string, spent uint64) {
+	m.provingSpend.WithLabelValues(window).Set(float64(spent))
+}
+
+func (m *Metrics) RecordOutputLag(head string, lag time.Duration) {
+	m.outputLag.WithLabelValues(head).Set(lag.Seconds())
+}
+
+func (m *Metrics) RecordOutputSLASlack(slack time.Duration) {
+	m.outputSLASlack.Set(slack.Seconds())
+}
+
+func (m *Metrics) RecordOutputSLABreachPredicted(predicted bool) {
+	m.outputSLABreachPredicted.Set(boolToFloat(predicted))
+}
+
+func (m *Metrics) RecordEffectiveConcurrency(limit uint64) {
+	m.effectiveConcurrency.Set(float64(limit))
+}
+
+func (m *Metrics) RecordSpanBackpressure(active bool) {
+	m.spanBackpressure.Set(boolToFloat(active))
+}
+
+func (m *Metrics) RecordSubmissionWindowOpen(open bool) {
+	m.submissionWindowOpen.Set(boolToFloat(open))
+}
+
+func (m *Metrics) RecordShadowComparison(result string) {
+	m.shadowComparisons.WithLabelValues(result).Inc()
+}
+
+func (m *Metrics) RecordLabeledProofRequests(counts map[string]map[string]int) {
+	m.labeledProofRequests.Reset()
+	for label, byStatus := range counts {
+		for status, count := range byStatus {
+			m.labeledProofRequests.WithLabelValues(label, status).Set(float64(count))
+		}
+	}
+}
+
+func (m *Metrics) RecordOutputRootMismatch() {
+	m.outputRootMismatches.Inc()
+}
+
+func (m *Metrics) RecordLeader(leader bool) {
+	m.isLeader.Set(boolToFloat(leader))
+}
+
+func (m *Metrics) RecordDisputeGameChallenge(event string) {
+	m.disputeGameChallenges.WithLabelValues(event).Inc()
+}
+
+func (m *Metrics) RecordPublishedEvent(eventType, result string) {
+	m.publishedEvents.WithLabelValues(eventType, result).Inc()
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMetricsRegistry tests that the OP Succinct metrics are registered next to the op-proposer metrics, under their
+// unprefixed names.
+func TestMetricsRegistry(t *testing.T) {
+	m := NewMetrics("default")
+	m.RecordLeader(true)
+	m.RecordBatcherLag(5)
+	m.RecordUp()
+
+	families, err := m.Registry().Gather()
+	require.NoError(t, err)
+	names := make(map[string]bool)
+	for _, f := range families {
+		names[f.GetName()] = true
+	}
+	require.True(t, names["op_proposer_is_leader"])
+	require.True(t, names["op_proposer_batcher_lag_blocks"])
+	require.True(t, names["op_proposer_default_up"])
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"time"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-proposer/metrics"
+)
+
+type noopMetrics struct {
+	opmetrics.Metricer
+}
+
+var NoopMetrics Metricer = &noopMetrics{Metricer: opmetrics.NoopMetrics}
+
+func (*noopMetrics) RecordBackgroundPanic(string)                         {}
+func (*noopMetrics) RecordBatcherLag(uint64)                              {}
+func (*noopMetrics) RecordPriceCeilingExceeded(string)                    {}
+func (*noopMetrics) RecordProvingSpend(string, uint64)                    {}
+func (*noopMetrics) RecordOutputLag(string, time.Duration)                {}
+func (*noopMetrics) RecordOutputSLASlack(time.Duration)                   {}
+func (*noopMetrics) RecordOutputSLABreachPredicted(bool)                  {}
+func (*noopMetrics) RecordEffectiveConcurrency(uint64)                    {}
+func (*noopMetrics) RecordSpanBackpressure(bool)                          {}
+func (*noopMetrics) RecordSubmissionWindowOpen(bool)                      {}
+func (*noopMetrics) RecordShadowComparison(string)                        {}
+func (*noopMetrics) RecordLabeledProofRequests(map[string]map[string]int) {}
+func (*noopMetrics) RecordOutputRootMismatch()                            {}
+func (*noopMetrics) RecordLeader(bool)                                    {}
+func (*noopMetrics) RecordDisputeGameChallenge(string)                    {}
+func (*noopMetrics) RecordPublishedEvent(string, string)                  {}
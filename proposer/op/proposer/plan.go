@@ -0,0 +1,104 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+)
+
+// SpanPlan describes a span proof that the proposer would request.
+type SpanPlan struct {
+	Span
+	// The estimated cycle count of the span, or 0 if it wasn't estimated.
+	EstimatedCycles uint64
+	// The L1 origins of the first and last L2 block of the span. The batches for the span are posted to L1 after
+	// these blocks.
+	L1OriginStart uint64
+	L1OriginEnd   uint64
+	// The index of the agg proof window (of SubmissionInterval blocks from the agg start block) the span feeds, or -1
+	// if the span doesn't fit in a single window.
+	AggWindow int
+}
+
+// AggWindowPlan describes an agg proof window, and the spans that feed it.
+type AggWindowPlan struct {
+	Start uint64
+	End   uint64
+	Spans int
+}
+
+// ProofPlan is a dry run of the proofs that the proposer would request for an L2 block range.
+type ProofPlan struct {
+	Spans []SpanPlan
+	// The blocks at the end of the range that don't fill a complete span, and would wait for more blocks.
+	Remainder *Span
+	// The agg proof windows that the spans feed. Only set if a submission interval is given.
+	AggWindows []AggWindowPlan
+}
+
+// PlanProofs computes the spans that the proposer would create for the L2 block range [start, end] with the given
+// config, without touching the DB or the OP Succinct server. If fetcher is set, span costs are estimated, and spans
+// are sized by cost if TargetCyclesPerSpanProof is set. If submissionInterval is non-zero, spans are assigned to agg
+// proof windows of submissionInterval blocks starting at aggStart.
+func PlanProofs(ctx context.Context, cfg ProposerConfig, rollupClient RollupClient, fetcher L2BlockFetcher, start, end, aggStart, submissionInterval uint64) (*ProofPlan, error) {
+	l := &L2OutputSubmitter{DriverSetup: DriverSetup{Cfg: cfg}}
+
+	spans := l.CreateSpans(start, end)
+	if cfg.TargetCyclesPerSpanProof > 0 && fetcher != nil {
+		var err error
+		spans, err = l.CreateDynamicSpans(ctx, fetcher, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create dynamic spans: %w", err)
+		}
+	}
+
+	plan := &ProofPlan{}
+	if len(spans) == 0 || spans[len(spans)-1].End < end {
+		remainderStart := start
+		if len(spans) > 0 {
+			remainderStart = spans[len(spans)-1].End
+		}
+		plan.Remainder = &Span{Start: remainderStart, End: end}
+	}
+
+	for _, span := range spans {
+		sp := SpanPlan{Span: span, AggWindow: -1}
+
+		if fetcher != nil {
+			cycles, err := EstimateRangeCycles(ctx, fetcher, span.Start, span.End)
+			if err != nil {
+				return nil, err
+			}
+			sp.EstimatedCycles = cycles
+		}
+
+		startOutput, err := rollupClient.OutputAtBlock(ctx, span.Start)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get output at block %d: %w", span.Start, err)
+		}
+		endOutput, err := rollupClient.OutputAtBlock(ctx, span.End)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get output at block %d: %w", span.End, err)
+		}
+		sp.L1OriginStart = startOutput.BlockRef.L1Origin.Number
+		sp.L1OriginEnd = endOutput.BlockRef.L1Origin.Number
+
+		if submissionInterval > 0 && span.Start >= aggStart {
+			window := (span.Start - aggStart) / submissionInterval
+			// A span feeds the window if it ends at or before the window's end block.
+			if span.End <= aggStart+(window+1)*submissionInterval {
+				sp.AggWindow = int(window)
+			}
+			for uint64(len(plan.AggWindows)) <= window {
+				windowStart := aggStart + uint64(len(plan.AggWindows))*submissionInterval
+				plan.AggWindows = append(plan.AggWindows, AggWindowPlan{Start: windowStart, End: windowStart + submissionInterval})
+			}
+			if sp.AggWindow >= 0 {
+				plan.AggWindows[window].Spans++
+			}
+		}
+
+		plan.Spans = append(plan.Spans, sp)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,50 @@
+package proposer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+)
+
+// ApplyNetworkPreset fills in the chain-specific settings of a superchain registry network (e.g. `base-sepolia`) that
+// weren't set explicitly: the batch inbox and batcher address and, if the proposer proposes to a DisputeGameFactory
+// (i.e. the proposal interval is set), the address of the chain's DisputeGameFactory. The OP Succinct L2OO address is
+// specific to each deployment, and isn't in the registry, so it must still be provided.
+func ApplyNetworkPreset(ctx *cli.Context, network string) error {
+	chainCfg := chaincfg.ChainByName(network)
+	if chainCfg == nil {
+		networks := chaincfg.AvailableNetworks()
+		sort.Strings(networks)
+		return fmt.Errorf("unknown network %q, available networks: %s", network, strings.Join(networks, ", "))
+	}
+
+	preset := map[string]string{
+		flags.BatchInboxFlag.Name:     chainCfg.BatchInboxAddr.String(),
+		flags.BatcherAddressFlag.Name: chainCfg.Genesis.SystemConfig.BatcherAddr.String(),
+	}
+	if ctx.IsSet(flags.ProposalIntervalFlag.Name) && !ctx.IsSet(flags.L2OOAddressFlag.Name) {
+		addrs := superchain.Addresses[chainCfg.ChainID]
+		switch {
+		case addrs != nil && addrs.DisputeGameFactoryProxy != (superchain.Address{}):
+			preset[flags.DisputeGameFactoryAddressFlag.Name] = addrs.DisputeGameFactoryProxy.String()
+		case !ctx.IsSet(flags.DisputeGameFactoryAddressFlag.Name):
+			return fmt.Errorf("network %q has no DisputeGameFactory in the superchain registry", network)
+		}
+	}
+	for name, value := range preset {
+		if ctx.IsSet(name) {
+			continue
+		}
+		if err := ctx.Set(name, value); err != nil {
+			return fmt.Errorf("failed to apply %s preset for %s: %w", network, name, err)
+		}
+	}
+
+	return nil
+}
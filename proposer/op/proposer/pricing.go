@@ -0,0 +1,79 @@
+package proposer
+
+import (
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// The actions taken when the quote of a span proof exceeds the price ceiling.
+const (
+	// Don't request new proofs for a while, and request the span proof as it is once prices dropped.
+	PriceCeilingWait = "wait"
+	// Split the span proof, so that each part stays within the max total fee. If the price per cycle is too high,
+	// shrinking doesn't help, and the proposer waits instead.
+	PriceCeilingShrink = "shrink"
+	// Request the span proof anyway, and only alert.
+	PriceCeilingAlert = "alert"
+)
+
+// priceCeilingWaitTime is how long new proofs aren't requested after a quote exceeded the price ceiling.
+const priceCeilingWaitTime = 5 * time.Minute
+
+// ProofPriceLimits are the price ceilings attached to proof requests, which the OP Succinct server passes through to
+// the prover network. Zero means no limit.
+type ProofPriceLimits struct {
+	MaxPricePerCycle uint64 `json:"max_price_per_cycle,omitempty"`
+	MaxTotalFee      uint64 `json:"max_total_fee,omitempty"`
+}
+
+// priceLimits returns the price ceilings to attach to proof requests.
+func (l *L2OutputSubmitter) priceLimits() ProofPriceLimits {
+	return ProofPriceLimits{MaxPricePerCycle: l.config().MaxPricePerCycle, MaxTotalFee: l.config().MaxTotalFee}
+}
+
+// priceCeilingEnabled returns whether span proof quotes are checked against a price ceiling.
+func (l *L2OutputSubmitter) priceCeilingEnabled() bool {
+	return l.config().MaxPricePerCycle != 0 || l.config().MaxTotalFee != 0
+}
+
+// exceedsTotalFee returns whether a quote exceeds the max total fee.
+func (limits ProofPriceLimits) exceedsTotalFee(estimate ProofEstimate) bool {
+	return limits.MaxTotalFee != 0 && estimate.Fee > limits.MaxTotalFee
+}
+
+// exceedsPricePerCycle returns whether a quote exceeds the max price per cycle.
+func (limits ProofPriceLimits) exceedsPricePerCycle(estimate ProofEstimate) bool {
+	return limits.MaxPricePerCycle != 0 && estimate.Cycles != 0 && estimate.Fee/estimate.Cycles > limits.MaxPricePerCycle
+}
+
+// handlePriceCeiling applies the configured PriceCeilingAction to a span proof whose quote exceeds the price ceiling.
+// Returns whether the span proof should be requested anyway.
+func (l *L2OutputSubmitter) handlePriceCeiling(p ent.ProofRequest, estimate ProofEstimate) bool {
+	action := l.config().PriceCeilingAction
+	limits := l.priceLimits()
+
+	if action == PriceCeilingAlert {
+		l.Metr.RecordPriceCeilingExceeded(action)
+		l.Log.Error("span proof quote exceeds the price ceiling, requesting it anyway", "start", p.StartBlock, "end", p.EndBlock, "cycles", estimate.Cycles, "fee", estimate.Fee)
+		return true
+	}
+
+	if action == PriceCeilingShrink && !limits.exceedsPricePerCycle(estimate) && p.EndBlock-p.StartBlock >= 2 {
+		l.Metr.RecordPriceCeilingExceeded(action)
+		l.Log.Info("span proof quote exceeds the max total fee, splitting", "start", p.StartBlock, "end", p.EndBlock, "fee", estimate.Fee, "maxTotalFee", limits.MaxTotalFee)
+		if err := l.splitSpanRequest(&p); err != nil {
+			l.Log.Error("failed to split span proof", "err", err)
+		}
+		return false
+	}
+
+	l.Metr.RecordPriceCeilingExceeded(PriceCeilingWait)
+	l.Log.Warn("span proof quote exceeds the price ceiling, waiting for prices to drop", "start", p.StartBlock, "end", p.EndBlock, "cycles", estimate.Cycles, "fee", estimate.Fee, "retryAfter", priceCeilingWaitTime)
+	l.backOffRequests(priceCeilingWaitTime)
+	if err := l.db.UpdateProofStatus(p.ID, proofrequest.StatusUNREQ); err != nil {
+		l.Log.Error("failed to reschedule proof request", "err", err, "id", p.ID)
+	}
+	return false
+}
@@ -1,20 +1,30 @@
 package proposer
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"net/http"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
 )
 
+// ErrBatchRequestsUnsupported is returned when the OP Succinct server doesn't support batch span proof requests.
+var ErrBatchRequestsUnsupported = errors.New("OP Succinct server does not support batch requests")
+
 // Process all of the pending proofs.
 func (l *L2OutputSubmitter) ProcessPendingProofs() error {
 	// Retrieve all proofs that failed without reaching the prover network (specifically, proofs that failed with no proof ID).
@@ -36,11 +46,11 @@ func (l *L2OutputSubmitter) ProcessPendingProofs() error {
 		l.Log.Info("Retrying failed and timed out proofs.", "failed", len(failedReqs), "timedOut", len(timedOutReqs))
 	}
 
+	// The outcomes of the proofs are written to the DB in a single transaction at the end of the iteration.
+	var updates []db.ProofUpdate
 	for _, req := range reqsToRetry {
-		err = l.RetryRequest(req)
-		if err != nil {
-			return fmt.Errorf("failed to retry request: %w", err)
-		}
+		l.Log.Info("Retrying proof", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
+		updates = append(updates, db.ProofUpdate{ID: req.ID})
 	}
 
 	// Get all pending proofs with a status of requested and a prover ID that is not empty.
@@ -50,42 +60,139 @@ func (l *L2OutputSubmitter) ProcessPendingProofs() error {
 	if err != nil {
 		return err
 	}
+	// Poll the proofs in parallel, so that a large number of pending proofs doesn't stall the loop. A failure to
+	// process one proof doesn't stop the others from being processed.
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		sem  = make(chan struct{}, max(1, l.config().StatusPollConcurrency))
+	)
 	for _, req := range reqs {
-		status, proof, err := l.GetProofStatus(req.ProverRequestID)
-		if err != nil {
-			l.Log.Error("failed to get proof status for ID", "id", req.ProverRequestID, "err", err)
-			return err
-		}
-		if status == "PROOF_FULFILLED" {
-			// Update the proof in the DB and update status to COMPLETE.
-			l.Log.Info("Fulfilled Proof", "id", req.ProverRequestID)
-			err = l.db.AddFulfilledProof(req.ID, proof)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(req *ent.ProofRequest) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			defer l.recoverWorker("status poll", *req)
+			update, err := l.processPendingProof(req)
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
-				l.Log.Error("failed to update completed proof status", "err", err)
-				return err
+				errs = append(errs, fmt.Errorf("proof %d: %w", req.ID, err))
+			} else if update != nil {
+				updates = append(updates, *update)
 			}
-			continue
+		}(req)
+	}
+	wg.Wait()
+	l.backends.maybeFailover(l.Log)
+
+	if len(updates) > 0 {
+		if err := l.db.ApplyProofUpdates(updates); err != nil {
+			l.Log.Error("failed to update proof statuses", "err", err)
+			errs = append(errs, err)
 		}
+		l.publishProofUpdateEvents(updates)
+	}
 
-		timeout := uint64(time.Now().Unix()) > req.ProofRequestTime+l.DriverSetup.Cfg.ProofTimeout
-		if timeout || status == "PROOF_UNCLAIMED" {
-			if timeout {
-				l.Log.Info("proof timed out", "id", req.ProverRequestID)
-			} else {
-				l.Log.Info("proof unclaimed", "id", req.ProverRequestID)
-			}
-			// update status in db to "FAILED"
-			err = l.db.UpdateProofStatus(req.ID, proofrequest.StatusFAILED)
-			if err != nil {
-				l.Log.Error("failed to update failed proof status", "err", err)
-				return err
-			}
+	return errors.Join(errs...)
+}
 
-			err = l.RetryRequest(req)
-			if err != nil {
-				return fmt.Errorf("failed to retry request: %w", err)
+// processPendingProof polls the status of a pending proof. It returns the update to record the proof if it was
+// fulfilled, or to retry it if it timed out or was unclaimed, and nil if the proof is still pending.
+func (l *L2OutputSubmitter) processPendingProof(req *ent.ProofRequest) (*db.ProofUpdate, error) {
+	// Leave the proof pending until the next tick if the backend's rate limit budget is spent.
+	if backend, _ := l.backends.resolve(req.ProverRequestID); !backend.limits.take(time.Now()) {
+		return nil, nil
+	}
+	proofStatus, err := l.GetProofStatus(req.ProverRequestID)
+	if err != nil {
+		l.Log.Error("failed to get proof status for ID", "id", req.ProverRequestID, "err", err)
+		return nil, err
+	}
+	status := proofStatus.Status
+	backend, _ := l.backends.resolve(req.ProverRequestID)
+	if status == "PROOF_FULFILLED" {
+		backend.recordOutcome(true)
+		fulfilledAt := proofStatus.FulfilledAt
+		if fulfilledAt == 0 {
+			fulfilledAt = uint64(time.Now().Unix())
+		}
+		l.concurrency.observe(time.Duration(fulfilledAt-min(fulfilledAt, req.ProofRequestTime))*time.Second, true)
+		// Add the proof to the DB and update status to COMPLETE.
+		l.Log.Info("Fulfilled Proof", "id", req.ProverRequestID, "cycles", proofStatus.Cycles, "fee", proofStatus.Fee, "prover", proofStatus.Prover)
+		metadata := db.FulfillmentMetadata{
+			Cycles:        proofStatus.Cycles,
+			Fee:           proofStatus.Fee,
+			Prover:        proofStatus.Prover,
+			FulfilledTime: proofStatus.FulfilledAt,
+		}
+		return &db.ProofUpdate{
+			ID:           req.ID,
+			Proof:        proofStatus.Proof,
+			Format:       l.proofFormat(req.Type, proofStatus),
+			Metadata:     metadata,
+			PublicValues: proofStatus.PublicValues,
+		}, nil
+	}
+
+	timeout := uint64(time.Now().Unix()) > req.ProofRequestTime+l.proofTimeout(req)
+	if timeout || status == "PROOF_UNCLAIMED" {
+		backend.recordOutcome(false)
+		l.concurrency.observe(0, false)
+		reason := ErrProofUnclaimed
+		if timeout {
+			reason = ErrProofTimedOut
+			// Stop the prover network from working on the proof, as it will be requested again.
+			if err := l.CancelProof(req.ProverRequestID); err != nil {
+				l.Log.Warn("failed to cancel timed out proof", "id", req.ProverRequestID, "err", err)
 			}
 		}
+		// Set the status to FAILED and retry the proof.
+		l.Log.Info("Retrying proof", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock, "reason", reason)
+		failure := newFailure(proofrequest.FailureStagePROVE, reason)
+		return &db.ProofUpdate{ID: req.ID, Failure: &failure}, nil
+	}
+	return nil, nil
+}
+
+// proofTimeout returns how long to wait for a proof before giving up on it, based on its type and the size of its range.
+func (l *L2OutputSubmitter) proofTimeout(req *ent.ProofRequest) uint64 {
+	cfg := l.config()
+	timeout := cfg.ProofTimeout
+	if req.Type == proofrequest.TypeSPAN && cfg.SpanProofTimeout > 0 {
+		timeout = cfg.SpanProofTimeout
+	} else if req.Type == proofrequest.TypeAGG && cfg.AggProofTimeout > 0 {
+		timeout = cfg.AggProofTimeout
+	}
+	return timeout + cfg.ProofTimeoutPerBlock*(req.EndBlock-req.StartBlock)
+}
+
+// Cancel the span proofs that are still being proven, but whose range is already covered by the latest output on the
+// L2OO contract. These proofs can never be used, so the prover network shouldn't spend cycles on them.
+func (l *L2OutputSubmitter) CancelSupersededProofs(ctx context.Context) error {
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest L2OO output: %w", err)
+	}
+
+	reqs, err := l.db.GetSupersededSpanProofs(latest.Uint64())
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		l.Log.Info("cancelling superseded proof", "id", req.ProverRequestID, "start", req.StartBlock, "end", req.EndBlock, "latestL2OOBlock", latest.Uint64())
+		if err := l.CancelProof(req.ProverRequestID); err != nil {
+			l.Log.Warn("failed to cancel superseded proof", "id", req.ProverRequestID, "err", err)
+		}
+		// The range doesn't need to be proven anymore, so the request is not retried.
+		err = l.db.UpdateProofStatus(req.ID, proofrequest.StatusFAILED)
+		if err != nil {
+			return fmt.Errorf("failed to update superseded proof status: %w", err)
+		}
 	}
 
 	return nil
@@ -100,7 +207,7 @@ func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest) error {
 
 	l.Log.Info("Retrying proof", "id", req.ID, "type", req.Type, "start", req.StartBlock, "end", req.EndBlock)
 	// TODO: For range proofs, add custom logic to split the proof into two if the error is an execution error.
-	err = l.db.NewEntry(req.Type, req.StartBlock, req.EndBlock)
+	err = l.replaceEntry(req, req.Type, req.StartBlock, req.EndBlock)
 	if err != nil {
 		l.Log.Error("failed to add new proof request", "err", err)
 		return err
@@ -110,6 +217,19 @@ func (l *L2OutputSubmitter) RetryRequest(req *ent.ProofRequest) error {
 }
 
 func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
+	if l.draining.Load() || l.requestsBackedOff() {
+		return nil
+	}
+	budget := l.backends.active().limits.available(time.Now())
+	if budget == 0 {
+		l.Log.Info("OP Succinct server rate limit budget spent, waiting for next cycle")
+		return nil
+	}
+	// Check that the server is up before claiming any proofs, so that an unreachable server doesn't fail them.
+	if err := l.backends.probeHealth(ctx, l.backends.active()); err != nil {
+		l.Log.Warn("OP Succinct server is unhealthy, skipping proof requests until next cycle", "err", err)
+		return nil
+	}
 	nextProofToRequest, err := l.db.GetNextUnrequestedProof()
 	if err != nil {
 		return fmt.Errorf("failed to get unrequested proofs: %w", err)
@@ -118,12 +238,31 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 		return nil
 	}
 
+	// If the proving budget is exhausted, only the proofs needed for the next output submission are requested.
+	budgetExhausted, err := l.provingBudgetExhausted()
+	if err != nil {
+		return fmt.Errorf("failed to check proving budget: %w", err)
+	}
+	nextOutputBlock := uint64(math.MaxUint64)
+	if budgetExhausted {
+		nextOutputBlock, err = l.nextOutputBlock(ctx)
+		if err != nil {
+			return err
+		}
+		if nextProofToRequest.Type == proofrequest.TypeAGG && nextProofToRequest.StartBlock >= nextOutputBlock {
+			return nil
+		}
+	}
+
 	if nextProofToRequest.Type == proofrequest.TypeAGG {
 		if nextProofToRequest.L1BlockHash == "" {
-			blockNumber, blockHash, err := l.checkpointBlockHash(ctx)
-			if err != nil {
-				l.Log.Error("failed to checkpoint block hash", "err", err)
-				return err
+			blockNumber, blockHash, ok := l.getReusableCheckpoint(ctx, nextProofToRequest.EndBlock)
+			if !ok {
+				blockNumber, blockHash, err = l.checkpointBlockHash(ctx)
+				if err != nil {
+					l.Log.Error("failed to checkpoint block hash", "err", err)
+					return err
+				}
 			}
 			nextProofToRequest, err = l.db.AddL1BlockInfoToAggRequest(nextProofToRequest.StartBlock, nextProofToRequest.EndBlock, blockNumber, blockHash.Hex())
 			if err != nil {
@@ -140,43 +279,268 @@ func (l *L2OutputSubmitter) RequestQueuedProofs(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to count requested proofs: %w", err)
 		}
-		if currentRequestedProofs >= int(l.Cfg.MaxConcurrentProofRequests) {
-			l.Log.Info("max concurrent proof requests reached, waiting for next cycle")
+		maxConcurrent := l.maxConcurrentProofRequests()
+		if currentRequestedProofs >= int(maxConcurrent) {
+			l.Log.Info("max concurrent proof requests reached, waiting for next cycle", "max", maxConcurrent)
 			return nil
 		}
-	}
-	go func(p ent.ProofRequest) {
-		l.Log.Info("requesting proof from server", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
-		// Set the proof status to WITNESSGEN.
-		err = l.db.UpdateProofStatus(nextProofToRequest.ID, proofrequest.StatusWITNESSGEN)
+
+		// Request as many span proofs as there is capacity for, so that the proposer catches up without waiting a
+		// tick per proof. The limit is read on each tick, so that it can be changed at runtime.
+		capacity := int(maxConcurrent) - currentRequestedProofs
+		if budget > 0 {
+			capacity = min(capacity, budget)
+		}
+		spanProofs, err := l.db.GetNextUnrequestedSpanProofs(capacity)
 		if err != nil {
-			l.Log.Error("failed to update proof status", "err", err)
-			return
+			return fmt.Errorf("failed to get unrequested span proofs: %w", err)
 		}
-
-		err = l.RequestOPSuccinctProof(p)
+		spanProofs, err = l.claimProofs(urgentProofs(spanProofs, nextOutputBlock))
 		if err != nil {
-			l.Log.Error("failed to request proof from the OP Succinct server", "err", err, "proof", p)
-			err = l.db.UpdateProofStatus(nextProofToRequest.ID, proofrequest.StatusFAILED)
-			if err != nil {
-				l.Log.Error("failed to set proof status to failed", "err", err, "proverRequestID", nextProofToRequest.ID)
-			}
+			return err
+		}
+		l.dispatchSpanProofs(spanProofs)
+		return nil
+	}
+	claimed, err := l.claimProofs([]*ent.ProofRequest{nextProofToRequest})
+	if err != nil || len(claimed) == 0 {
+		return err
+	}
+	p := *claimed[0]
+	l.goRequest([]ent.ProofRequest{p}, func() { l.requestProof(p) })
 
-			// If the proof fails to be requested, we should add it to the queue to be retried.
-			err = l.RetryRequest(nextProofToRequest)
-			if err != nil {
-				l.Log.Error("failed to retry request", "err", err)
+	return nil
+}
+
+// claimProofs moves the given proof requests to WITNESSGEN before they're requested in the background, and returns the
+// ones that were still unrequested, so that the same proof isn't requested twice.
+func (l *L2OutputSubmitter) claimProofs(reqs []*ent.ProofRequest) ([]*ent.ProofRequest, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	ids := make([]int, len(reqs))
+	for i, p := range reqs {
+		ids[i] = p.ID
+	}
+	claimedIDs, err := l.db.ClaimUnrequestedProofs(ids)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]*ent.ProofRequest, 0, len(claimedIDs))
+	for _, p := range reqs {
+		if slices.Contains(claimedIDs, p.ID) {
+			p.Status = proofrequest.StatusWITNESSGEN
+			claimed = append(claimed, p)
+		}
+	}
+	return claimed, nil
+}
+
+// dispatchSpanProofs requests the given span proofs. If batching is enabled, they are requested in batches of up to
+// SpanProofBatchSize proofs per call, otherwise each proof is requested individually.
+func (l *L2OutputSubmitter) dispatchSpanProofs(spanProofs []*ent.ProofRequest) {
+	batchSize := int(l.config().SpanProofBatchSize)
+	if batchSize <= 1 || l.batchSpanRequestsUnsupported.Load() {
+		batchSize = 1
+	}
+	l.Log.Info("dispatching span proof requests", "count", len(spanProofs), "batchSize", batchSize)
+	for len(spanProofs) > 0 {
+		n := min(batchSize, len(spanProofs))
+		if n == 1 {
+			p := *spanProofs[0]
+			l.goRequest([]ent.ProofRequest{p}, func() { l.requestProof(p) })
+		} else {
+			reqs := make([]ent.ProofRequest, n)
+			for i, p := range spanProofs[:n] {
+				reqs[i] = *p
 			}
+			l.goRequest(reqs, func() { l.requestSpanProofBatch(reqs) })
+		}
+		spanProofs = spanProofs[n:]
+	}
+}
+
+// requestProof requests a single proof from the OP Succinct server, and queues it to be retried if the request fails.
+// The proof must have been claimed with claimProofs.
+func (l *L2OutputSubmitter) requestProof(p ent.ProofRequest) {
+	l.Log.Info("requesting proof from server", "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "id", p.ID)
+	if p.Type == proofrequest.TypeSPAN && !l.checkSpanProofBudget(p) {
+		return
+	}
+
+	err := l.RequestOPSuccinctProof(p)
+	var coverageErr *db.CoverageError
+	var busyErr *ServerBusyError
+	if errors.As(err, &busyErr) {
+		l.rescheduleRequests([]ent.ProofRequest{p}, busyErr)
+	} else if errors.As(err, &coverageErr) {
+		l.Log.Warn("span proofs don't cover agg proof range", "err", err, "proof", p)
+		l.queueMissingSpanProofs(&p, coverageErr.Missing)
+	} else if err != nil {
+		l.Log.Error("failed to request proof from the OP Succinct server", "err", err, "proof", p)
+		l.retryFailedRequest(&p, err)
+	}
+}
+
+// queueMissingSpanProofs marks an agg proof request whose range isn't covered by span proofs as failed, and queues
+// span proofs for the missing ranges. The agg proof is created again once the span proofs are complete.
+func (l *L2OutputSubmitter) queueMissingSpanProofs(p *ent.ProofRequest, missing []db.BlockRange) {
+	err := l.db.UpdateProofStatus(p.ID, proofrequest.StatusFAILED)
+	if err != nil {
+		l.Log.Error("failed to set proof status to failed", "err", err, "proverRequestID", p.ID)
+		return
+	}
 
+	for _, r := range missing {
+		if err := l.replaceEntry(p, proofrequest.TypeSPAN, r.Start, r.End); err != nil {
+			l.Log.Error("failed to queue missing span proof", "err", err, "start", r.Start, "end", r.End)
+			return
 		}
-	}(*nextProofToRequest)
+		l.Log.Info("queued missing span proof", "start", r.Start, "end", r.End)
+	}
+}
 
-	return nil
+// requestSpanProofBatch requests a batch of span proofs from the OP Succinct server in a single call. If the server
+// doesn't support batch requests, each proof is requested individually instead. The proofs must have been claimed with
+// claimProofs.
+func (l *L2OutputSubmitter) requestSpanProofBatch(reqs []ent.ProofRequest) {
+	// Drop the span proofs that were split because they exceed the budget.
+	withinBudget := reqs[:0]
+	for _, p := range reqs {
+		if l.checkSpanProofBudget(p) {
+			withinBudget = append(withinBudget, p)
+		}
+	}
+	reqs = withinBudget
+	if len(reqs) == 0 {
+		return
+	}
+
+	spans := make([]Span, len(reqs))
+	for i, p := range reqs {
+		spans[i] = Span{Start: p.StartBlock, End: p.EndBlock}
+	}
+
+	l.Log.Info("requesting span proof batch from server", "count", len(reqs), "start", spans[0].Start, "end", spans[len(spans)-1].End)
+	proofIds, err := l.RequestSpanProofs(spans)
+	if errors.Is(err, ErrBatchRequestsUnsupported) {
+		l.Log.Info("OP Succinct server does not support batch span proof requests, requesting span proofs individually")
+		l.batchSpanRequestsUnsupported.Store(true)
+		for _, p := range reqs {
+			l.requestProof(p)
+		}
+		return
+	}
+	var busyErr *ServerBusyError
+	if errors.As(err, &busyErr) {
+		l.rescheduleRequests(reqs, busyErr)
+		return
+	}
+	if err != nil {
+		l.Log.Error("failed to request span proof batch from the OP Succinct server", "err", err)
+		for i := range reqs {
+			l.retryFailedRequest(&reqs[i], err)
+		}
+		return
+	}
+
+	for i, p := range reqs {
+		if err := l.setProofRequested(p, proofIds[i]); err != nil {
+			l.Log.Error("failed to record requested span proof", "err", err, "proof", p)
+			l.retryFailedRequest(&reqs[i], err)
+		}
+	}
+}
+
+// checkSpanProofBudget estimates the cost of a span proof and records the estimate in the DB. If the estimate exceeds
+// the configured budget, the request is split in two and false is returned. If it exceeds the price ceiling, the
+// PriceCeilingAction is applied.
+func (l *L2OutputSubmitter) checkSpanProofBudget(p ent.ProofRequest) bool {
+	cfg := l.config()
+	if cfg.MaxCyclesPerSpanProof == 0 && cfg.MaxFeePerSpanProof == 0 && !l.priceCeilingEnabled() {
+		return true
+	}
+
+	estimate, err := l.EstimateSpanProof(l.ctx, p.StartBlock, p.EndBlock)
+	if err != nil {
+		l.Log.Warn("failed to estimate span proof, requesting it anyway", "err", err, "start", p.StartBlock, "end", p.EndBlock)
+		return true
+	}
+	if err := l.db.SetProofEstimate(p.ID, estimate.Cycles, estimate.Fee); err != nil {
+		l.Log.Warn("failed to record span proof estimate", "err", err, "id", p.ID)
+	}
+
+	overBudget := (cfg.MaxCyclesPerSpanProof != 0 && estimate.Cycles > cfg.MaxCyclesPerSpanProof) ||
+		(cfg.MaxFeePerSpanProof != 0 && estimate.Fee > cfg.MaxFeePerSpanProof)
+	if !overBudget {
+		limits := l.priceLimits()
+		if limits.exceedsTotalFee(estimate) || limits.exceedsPricePerCycle(estimate) {
+			return l.handlePriceCeiling(p, estimate)
+		}
+		return true
+	}
+	if p.EndBlock-p.StartBlock < 2 {
+		l.Log.Warn("span proof exceeds budget but can't be split further", "start", p.StartBlock, "end", p.EndBlock, "cycles", estimate.Cycles, "fee", estimate.Fee)
+		return true
+	}
+
+	l.Log.Info("span proof exceeds budget, splitting", "start", p.StartBlock, "end", p.EndBlock, "cycles", estimate.Cycles, "fee", estimate.Fee)
+	if err := l.splitSpanRequest(&p); err != nil {
+		l.Log.Error("failed to split span proof", "err", err)
+	}
+	return false
+}
+
+// splitSpanRequest marks a span proof request as failed, and replaces it with two requests that each cover half of
+// its range.
+func (l *L2OutputSubmitter) splitSpanRequest(p *ent.ProofRequest) error {
+	return l.db.SplitProofRequest(p.ID, p.StartBlock+(p.EndBlock-p.StartBlock)/2)
+}
+
+// replaceEntry queues a proof request that replaces (part of) the failed request p, as a child of p. Replacements of
+// backfill requests are backfill requests themselves, so that the driver doesn't cancel them.
+func (l *L2OutputSubmitter) replaceEntry(p *ent.ProofRequest, proofType proofrequest.Type, start, end uint64) error {
+	_, err := l.db.NewChildEntry(p, proofType, start, end)
+	return err
+}
+
+// retryFailedRequest marks a proof request that could not be sent to the OP Succinct server as failed, and adds it to
+// the queue to be retried, unless the server reported that the failure isn't retryable.
+func (l *L2OutputSubmitter) retryFailedRequest(p *ent.ProofRequest, reason error) {
+	failure := newFailure(proofrequest.FailureStageREQUEST, reason)
+
+	// Requests the server rejected for good are marked final, so that they aren't retried with the requests that
+	// failed before reaching the prover network either.
+	var serverErr *ServerError
+	if errors.As(reason, &serverErr) && !serverErr.Retryable {
+		l.Log.Error("OP Succinct server rejected proof request with a non-retryable error, not retrying", "id", p.ID, "type", p.Type, "start", p.StartBlock, "end", p.EndBlock, "code", serverErr.Code, "message", serverErr.Message)
+		if err := l.db.FailWithoutRetry(p.ID, &failure); err != nil {
+			l.Log.Error("failed to set proof status to failed", "err", err, "id", p.ID)
+		}
+		l.publishProofEvent(EventProofFailed, p.ID)
+		return
+	}
+
+	err := l.db.UpdateProofStatus(p.ID, proofrequest.StatusFAILED)
+	if err != nil {
+		l.Log.Error("failed to set proof status to failed", "err", err, "proverRequestID", p.ID)
+	}
+	if err := l.db.SetFailure(p.ID, failure); err != nil {
+		l.Log.Error("failed to record failure reason", "err", err, "id", p.ID)
+	}
+	l.publishProofEvent(EventProofFailed, p.ID)
+
+	// If the proof fails to be requested, we should add it to the queue to be retried.
+	err = l.RetryRequest(p)
+	if err != nil {
+		l.Log.Error("failed to retry request", "err", err)
+	}
 }
 
 // Use the L2OO contract to look up the range of blocks that the next proof must cover.
 // Check the DB to see if we have sufficient span proofs to request an agg proof that covers this range.
-// If so, queue up the agg proof in the DB to be requested later.
+// If so, queue up the agg proof in the DB to be requested later. Up to MaxPipelinedAggProofs agg proofs are
+// created for consecutive output intervals, so that they can be proven concurrently.
 func (l *L2OutputSubmitter) DeriveAggProofs(ctx context.Context) error {
 	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
 	if err != nil {
@@ -190,12 +554,28 @@ func (l *L2OutputSubmitter) DeriveAggProofs(ctx context.Context) error {
 	}
 
 	l.Log.Info("Checking for AGG proof", "blocksToProve", minTo.Uint64()-latest.Uint64(), "latestProvenBlock", latest.Uint64(), "minBlockToProveToAgg", minTo.Uint64())
-	created, end, err := l.db.TryCreateAggProofFromSpanProofs(latest.Uint64(), minTo.Uint64())
-	if err != nil {
-		return fmt.Errorf("failed to create agg proof from span proofs: %w", err)
-	}
-	if created {
-		l.Log.Info("created new AGG proof", "from", latest.Uint64(), "to", end)
+	submissionInterval := minTo.Uint64() - latest.Uint64()
+	from := latest.Uint64()
+	for i := uint64(0); i < max(l.config().MaxPipelinedAggProofs, 1); i++ {
+		// If there's already an AGG proof for this interval, the next one starts where it ends.
+		existing, err := l.db.GetActiveAggProof(from)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			from = existing.EndBlock
+			continue
+		}
+
+		created, end, err := l.db.TryCreateAggProofFromSpanProofs(from, from+submissionInterval)
+		if err != nil {
+			return fmt.Errorf("failed to create agg proof from span proofs: %w", err)
+		}
+		if !created {
+			break
+		}
+		l.Log.Info("created new AGG proof", "from", from, "to", end)
+		from = end
 	}
 
 	return nil
@@ -221,145 +601,455 @@ func (l *L2OutputSubmitter) RequestOPSuccinctProof(p ent.ProofRequest) error {
 		return fmt.Errorf("unknown proof type: %s", p.Type)
 	}
 
+	return l.setProofRequested(p, proofId)
+}
+
+// setProofRequested records the prover request ID of a proof that was successfully requested from the OP Succinct server.
+func (l *L2OutputSubmitter) setProofRequested(p ent.ProofRequest, proofId string) error {
 	// Set the proof status to PROVING once the prover ID has been retrieved. Only proofs with status PROVING, SUCCESS or FAILED have a prover request ID.
-	err = l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
+	err := l.db.UpdateProofStatus(p.ID, proofrequest.StatusPROVING)
 	if err != nil {
 		return fmt.Errorf("failed to set proof status to proving: %w", err)
 	}
 
-	err = l.db.SetProverRequestID(p.ID, proofId)
+	linkedID, err := l.db.SetProverRequestID(p.ID, proofId)
 	if err != nil {
 		return fmt.Errorf("failed to set prover request ID: %w", err)
 	}
+	if linkedID != p.ID {
+		l.Log.Warn("server returned a prover request ID that is already tracked, linking to the existing proof request", "proverRequestID", proofId, "id", p.ID, "existingID", linkedID)
+	} else {
+		l.publishProofEvent(EventProofRequested, p.ID)
+	}
+
+	if version := l.programVersion(p.EndBlock); p.Type == proofrequest.TypeSPAN && version != "" {
+		if err := l.db.SetProgramVersion(p.ID, version); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 type SpanProofRequest struct {
-	Start uint64 `json:"start"`
-	End   uint64 `json:"end"`
+	Start       uint64 `json:"start"`
+	End         uint64 `json:"end"`
+	ProofSystem string `json:"proof_system,omitempty"`
+	ProofPriceLimits
+	// The hardfork whose range program proves the span, so that the server can pick the matching program and vkey.
+	ProgramVersion string `json:"program_version,omitempty"`
+	// On interop chains, the heads of the dependency set that the messages executed within the span are checked
+	// against.
+	Dependencies []DependencyHead `json:"dependencies,omitempty"`
+}
+
+type SpanProofsRequest struct {
+	Requests []SpanProofRequest `json:"requests"`
 }
 
 type AggProofRequest struct {
-	Subproofs [][]byte `json:"subproofs"`
-	L1Head    string   `json:"head"`
+	Subproofs   [][]byte `json:"subproofs"`
+	L1Head      string   `json:"head"`
+	ProofSystem string   `json:"proof_system,omitempty"`
+	ProofPriceLimits
 }
 type ProofResponse struct {
 	ProofID string `json:"proof_id"`
 }
 
+type ProofsResponse struct {
+	ProofIDs []string `json:"proof_ids"`
+}
+
 // Request a span proof for the range [l2Start, l2End].
 func (l *L2OutputSubmitter) RequestSpanProof(l2Start, l2End uint64) (string, error) {
 	if l2Start >= l2End {
 		return "", fmt.Errorf("l2Start must be less than l2End")
 	}
 
+	dependencies, err := l.dependencyHeads(l.serverCtx)
+	if err != nil {
+		return "", err
+	}
+
 	l.Log.Info("requesting span proof", "start", l2Start, "end", l2End)
 	requestBody := SpanProofRequest{
-		Start: l2Start,
-		End:   l2End,
+		Start:            l2Start,
+		End:              l2End,
+		ProofSystem:      l.config().ProofSystem,
+		ProofPriceLimits: l.priceLimits(),
+		ProgramVersion:   l.programVersion(l2End),
+		Dependencies:     dependencies,
 	}
 	jsonBody, err := json.Marshal(requestBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
+	if l.config().WitnessGenCmd != "" && !l.witnessUploadUnsupported.Load() {
+		proofId, err := l.requestSpanProofWithWitness(l2Start, l2End, jsonBody)
+		if !errors.Is(err, ErrWitnessUploadUnsupported) {
+			return proofId, err
+		}
+		l.Log.Warn("OP Succinct server does not support witness uploads, falling back to server-side witness generation")
+		l.witnessUploadUnsupported.Store(true)
+	}
+
 	return l.RequestProofFromServer("request_span_proof", jsonBody)
 }
 
+// Request span proofs for several ranges in a single call to the OP Succinct server. Returns the proof IDs in the same
+// order as the spans. If the server doesn't support batch requests, returns ErrBatchRequestsUnsupported.
+func (l *L2OutputSubmitter) RequestSpanProofs(spans []Span) ([]string, error) {
+	dependencies, err := l.dependencyHeads(l.serverCtx)
+	if err != nil {
+		return nil, err
+	}
+	requestBody := SpanProofsRequest{}
+	for _, span := range spans {
+		if span.Start >= span.End {
+			return nil, fmt.Errorf("l2Start must be less than l2End")
+		}
+		requestBody.Requests = append(requestBody.Requests, SpanProofRequest{
+			Start:            span.Start,
+			End:              span.End,
+			ProofSystem:      l.config().ProofSystem,
+			ProofPriceLimits: l.priceLimits(),
+			ProgramVersion:   l.programVersion(span.End),
+			Dependencies:     dependencies,
+		})
+	}
+	jsonBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	backend := l.backends.active()
+	statusCode, body, err := l.sendServerRequest(backend, "request_span_proofs", jsonBody)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode == http.StatusNotFound || statusCode == http.StatusMethodNotAllowed {
+		return nil, ErrBatchRequestsUnsupported
+	}
+	if statusCode != http.StatusOK {
+		return nil, newServerError(statusCode, body)
+	}
+
+	var response ProofsResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding JSON response: %v", err)
+	}
+	if len(response.ProofIDs) != len(spans) {
+		return nil, fmt.Errorf("expected %d proof IDs, got %d", len(spans), len(response.ProofIDs))
+	}
+	l.Log.Info("successfully submitted span proof batch", "proofIDs", response.ProofIDs, "backend", backend.name)
+
+	proofIds := make([]string, len(response.ProofIDs))
+	for i, id := range response.ProofIDs {
+		proofIds[i] = backend.namespaceID(id)
+	}
+	return proofIds, nil
+}
+
+// The timeout of estimate requests. Estimates are made before requesting each span proof, so unlike witness
+// generation, they must not hold up the driver loop.
+const estimateTimeout = 30 * time.Second
+
+type ProofEstimate struct {
+	Cycles uint64 `json:"cycles"`
+	Fee    uint64 `json:"fee"`
+}
+
+// Estimate the cycle count and fee of a span proof for the range [l2Start, l2End]. If the OP Succinct server doesn't
+// support estimates, the cycle count is estimated locally from the L2 blocks in the range, and the fee is left at 0.
+func (l *L2OutputSubmitter) EstimateSpanProof(ctx context.Context, l2Start, l2End uint64) (ProofEstimate, error) {
+	jsonBody, err := json.Marshal(SpanProofRequest{Start: l2Start, End: l2End})
+	if err != nil {
+		return ProofEstimate{}, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	statusCode, body, err := l.sendServerRequestWithTimeout(l.backends.active(), "estimate_span_proof", "application/json", bytes.NewReader(jsonBody), estimateTimeout)
+	if err != nil {
+		return ProofEstimate{}, err
+	}
+	if statusCode == http.StatusNotFound || statusCode == http.StatusMethodNotAllowed {
+		if l.L2Client == nil {
+			return ProofEstimate{}, fmt.Errorf("OP Succinct server does not support estimates, and no L2 execution RPC is set")
+		}
+		cycles, err := EstimateRangeCycles(ctx, l.L2Client, l2Start, l2End)
+		if err != nil {
+			return ProofEstimate{}, fmt.Errorf("failed to estimate span proof locally: %w", err)
+		}
+		return ProofEstimate{Cycles: cycles}, nil
+	}
+	if statusCode != http.StatusOK {
+		return ProofEstimate{}, newServerError(statusCode, body)
+	}
+
+	var estimate ProofEstimate
+	err = json.Unmarshal(body, &estimate)
+	if err != nil {
+		return ProofEstimate{}, fmt.Errorf("error decoding JSON response: %v", err)
+	}
+
+	return estimate, nil
+}
+
 // Request an aggregate proof for the range [start, end]. If there is not a consecutive set of span proofs,
 // which cover the range, the request will error.
 func (l *L2OutputSubmitter) RequestAggProof(start, end uint64, l1BlockHash string) (string, error) {
 	l.Log.Info("requesting agg proof", "start", start, "end", end)
 
 	// Query the DB for the consecutive span proofs that cover the range [start, end].
-	subproofs, err := l.db.GetConsecutiveSpanProofs(start, end)
+	subproofIDs, err := l.db.GetConsecutiveSpanProofIDs(start, end)
 	if err != nil {
 		return "", fmt.Errorf("failed to get subproofs: %w", err)
 	}
-	requestBody := AggProofRequest{
-		Subproofs: subproofs,
-		L1Head:    l1BlockHash,
+
+	// The subproofs can add up to hundreds of MB, so they're loaded from the DB and streamed to the server one at a
+	// time instead of marshalling the whole request in memory.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeAggProofRequest(pw, subproofIDs, l.db.GetSpanProof, l1BlockHash, l.config().ProofSystem, l.priceLimits()))
+	}()
+	// Unblocks the writer if the server responded before reading the whole body.
+	defer pr.Close()
+
+	// Request the agg proof from the server.
+	return l.RequestProofFromServerBody("request_agg_proof", "application/json", pr)
+}
+
+// writeAggProofRequest writes the JSON encoding of an AggProofRequest with the given subproofs to w, loading each
+// subproof only when it's written.
+func writeAggProofRequest(w io.Writer, subproofIDs []int, loadProof func(id int) ([]byte, error), l1Head, proofSystem string, limits ProofPriceLimits) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(`{"subproofs":[`)
+	for i, id := range subproofIDs {
+		proof, err := loadProof(id)
+		if err != nil {
+			return fmt.Errorf("failed to get subproof: %w", err)
+		}
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		// Byte slices are encoded as base64 strings, as by encoding/json.
+		bw.WriteByte('"')
+		enc := base64.NewEncoder(base64.StdEncoding, bw)
+		enc.Write(proof)
+		// Stop loading subproofs once the server stopped reading the body.
+		if err := enc.Close(); err != nil {
+			return err
+		}
+		bw.WriteByte('"')
 	}
-	jsonBody, err := json.Marshal(requestBody)
+	bw.WriteString(`],"head":`)
+	head, err := json.Marshal(l1Head)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal request body: %w", err)
+		return err
 	}
-
-	// Request the agg proof from the server.
-	return l.RequestProofFromServer("request_agg_proof", jsonBody)
+	bw.Write(head)
+	if proofSystem != "" {
+		bw.WriteString(`,"proof_system":`)
+		system, err := json.Marshal(proofSystem)
+		if err != nil {
+			return err
+		}
+		bw.Write(system)
+	}
+	// The price limits are embedded in the request, so their fields are written without the enclosing braces.
+	priceLimits, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+	if len(priceLimits) > len("{}") {
+		bw.WriteByte(',')
+		bw.Write(priceLimits[1 : len(priceLimits)-1])
+	}
+	bw.WriteByte('}')
+	// The other write errors are sticky, so they're reported by Flush.
+	return bw.Flush()
 }
 
 // Request a proof from the OP Succinct server, given the path and the body of the request. Returns
 // the proof ID on a successful request.
 func (l *L2OutputSubmitter) RequestProofFromServer(urlPath string, jsonBody []byte) (string, error) {
-	req, err := http.NewRequest("POST", l.Cfg.OPSuccinctServerUrl+"/"+urlPath, bytes.NewBuffer(jsonBody))
+	return l.RequestProofFromServerBody(urlPath, "application/json", bytes.NewReader(jsonBody))
+}
+
+// Request a proof from the OP Succinct server, given the path, and the content type and body of the request. The body
+// is streamed to the server, so that large bodies don't have to be held in memory.
+func (l *L2OutputSubmitter) RequestProofFromServerBody(urlPath string, contentType string, requestBody io.Reader) (string, error) {
+	backend := l.backends.active()
+	statusCode, body, err := l.sendServerRequestBody(backend, urlPath, contentType, requestBody)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return "", err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	return l.decodeProofResponse(backend, statusCode, body)
+}
+
+// decodeProofResponse decodes the response of the backend to a proof request, and returns the stored proof ID.
+func (l *L2OutputSubmitter) decodeProofResponse(backend *proverBackend, statusCode int, body []byte) (string, error) {
+	if statusCode != http.StatusOK {
+		return "", newServerError(statusCode, body)
+	}
+	// Create a variable of the Response type.
+	var response ProofResponse
+
+	// Unmarshal the JSON into the response variable.
+	err := json.Unmarshal(body, &response)
+	if err != nil {
+		return "", fmt.Errorf("error decoding JSON response: %v", err)
+	}
+	l.Log.Info("successfully submitted proof", "proofID", response.ProofID, "backend", backend.name)
+
+	return backend.namespaceID(response.ProofID), nil
+}
 
+// Send a POST request to an OP Succinct server, given the path and the body of the request. Returns the status code
+// and the body of the response.
+func (l *L2OutputSubmitter) sendServerRequest(backend *proverBackend, urlPath string, jsonBody []byte) (int, []byte, error) {
+	return l.sendServerRequestBody(backend, urlPath, "application/json", bytes.NewReader(jsonBody))
+}
+
+// Send a POST request with the given content type and body to an OP Succinct server. Returns the status code and the
+// body of the response.
+func (l *L2OutputSubmitter) sendServerRequestBody(backend *proverBackend, urlPath string, contentType string, requestBody io.Reader) (int, []byte, error) {
 	/// The witness generation for larger proofs can take up to 20 minutes.
 	// TODO: Given that the timeout will take a while, we should have a mechanism for querying the status of the witness generation.
-	client := &http.Client{
-		Timeout: 20 * time.Minute,
+	return l.sendServerRequestWithTimeout(backend, urlPath, contentType, requestBody, 20*time.Minute)
+}
+
+// Send a POST request to an OP Succinct server like sendServerRequestBody, failing it after the given timeout.
+func (l *L2OutputSubmitter) sendServerRequestWithTimeout(backend *proverBackend, urlPath string, contentType string, requestBody io.Reader, timeout time.Duration) (int, []byte, error) {
+	ctx, cancel := context.WithTimeout(l.serverContext(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", backend.url+"/"+urlPath, requestBody)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	resp, err := client.Do(req)
+	req.Header.Set("Content-Type", contentType)
+
+	// Requests are spent from the budget even if it's exhausted, as the caller already decided to send them.
+	backend.limits.take(time.Now())
+	resp, err := l.backends.client.Do(req)
 	if err != nil {
 		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-			return "", fmt.Errorf("request timed out after 10 minutes: %w", err)
+			return 0, nil, fmt.Errorf("%w: request timed out after %v: %w", ErrServerUnreachable, timeout, err)
 		}
-		return "", fmt.Errorf("failed to send request: %w", err)
+		return 0, nil, fmt.Errorf("%w: failed to send request: %w", ErrServerUnreachable, err)
 	}
 	defer resp.Body.Close()
+	backend.limits.observe(resp.Header, time.Now())
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return resp.StatusCode, nil, &ServerBusyError{StatusCode: resp.StatusCode, RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+	}
 
 	// Read the response body.
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("error reading the response body: %v", err)
+		return 0, nil, fmt.Errorf("error reading the response body: %v", err)
 	}
 
-	// Create a variable of the Response type.
-	var response ProofResponse
+	return resp.StatusCode, body, nil
+}
 
-	// Unmarshal the JSON into the response variable.
-	err = json.Unmarshal(body, &response)
+// Cancel a proof request on the OP Succinct server, so the prover network stops working on it.
+func (l *L2OutputSubmitter) CancelProof(proofId string) error {
+	backend, id := l.backends.resolve(proofId)
+	statusCode, body, err := l.sendServerRequest(backend, "cancel/"+id, nil)
 	if err != nil {
-		return "", fmt.Errorf("error decoding JSON response: %v", err)
+		return err
 	}
-	l.Log.Info("successfully submitted proof", "proofID", response.ProofID)
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("failed to cancel proof: %w", newServerError(statusCode, body))
+	}
+	l.Log.Info("successfully cancelled proof", "proofID", proofId)
 
-	return response.ProofID, nil
+	return nil
 }
 
+// The proof system, verification key and proof encoding fields are optional, and are only returned by servers that
+// support proof systems other than SP1. The fulfillment fields are optional, and are only returned for fulfilled
+// proofs by servers that report them.
 type ProofStatus struct {
-	Status string `json:"status"`
-	Proof  []byte `json:"proof"`
+	Status      string `json:"status"`
+	Proof       []byte `json:"proof"`
+	ProofSystem string `json:"proof_system,omitempty"`
+	VkeyHash    string `json:"vkey_hash,omitempty"`
+	ProofFormat string `json:"proof_format,omitempty"`
+
+	Cycles uint64 `json:"cycles,omitempty"`
+	Fee    uint64 `json:"fee,omitempty"`
+	Prover string `json:"prover,omitempty"`
+	// The Unix time the proof was fulfilled at.
+	FulfilledAt uint64 `json:"fulfilled_at,omitempty"`
+	// The public values committed by the proof. For agg proofs, these are the ABI-encoded AggregationOutputs.
+	PublicValues []byte `json:"public_values,omitempty"`
+}
+
+// proofFormat returns the format of a fulfilled proof. If the server didn't report it, SP1 proofs are assumed to be
+// compressed for span proofs and Groth16 for agg proofs.
+func (l *L2OutputSubmitter) proofFormat(proofType proofrequest.Type, status *ProofStatus) db.ProofFormat {
+	format := db.ProofFormat{
+		ProofSystem: status.ProofSystem,
+		VkeyHash:    status.VkeyHash,
+		Encoding:    status.ProofFormat,
+	}
+	if format.ProofSystem == "" {
+		format.ProofSystem = l.config().ProofSystem
+	}
+	if format.Encoding == "" && format.ProofSystem == "sp1" {
+		if proofType == proofrequest.TypeAGG {
+			format.Encoding = "groth16"
+		} else {
+			format.Encoding = "compressed"
+		}
+	}
+	return format
 }
 
 // Get the status of a proof given its ID.
-func (l *L2OutputSubmitter) GetProofStatus(proofId string) (string, []byte, error) {
-	req, err := http.NewRequest("GET", l.Cfg.OPSuccinctServerUrl+"/status/"+proofId, nil)
+func (l *L2OutputSubmitter) GetProofStatus(proofId string) (*ProofStatus, error) {
+	backend, id := l.backends.resolve(proofId)
+	ctx, cancel := context.WithTimeout(l.serverContext(), 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", backend.url+"/status/"+id, nil)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-
-	client := &http.Client{
-		Timeout: 30 * time.Second,
+	cached, hasCached := l.backends.statuses.Get(proofId)
+	if hasCached {
+		req.Header.Set("If-None-Match", cached.etag)
 	}
-	resp, err := client.Do(req)
+
+	resp, err := l.backends.client.Do(req)
 	if err != nil {
 		if err, ok := err.(net.Error); ok && err.Timeout() {
-			return "", nil, fmt.Errorf("request timed out after 30 seconds: %w", err)
+			return nil, fmt.Errorf("%w: request timed out after 30 seconds: %w", ErrServerUnreachable, err)
 		}
-		return "", nil, fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("%w: failed to send request: %w", ErrServerUnreachable, err)
 	}
 	defer resp.Body.Close()
+	backend.limits.observe(resp.Header, time.Now())
+
+	// The status didn't change since the last poll.
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		status := cached.status
+		return &status, nil
+	}
 
 	// Read the response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", nil, fmt.Errorf("error reading the response body: %v", err)
+		return nil, fmt.Errorf("error reading the response body: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newServerError(resp.StatusCode, body)
 	}
 
 	// Create a variable of the Response type
@@ -368,8 +1058,15 @@ func (l *L2OutputSubmitter) GetProofStatus(proofId string) (string, []byte, erro
 	// Unmarshal the JSON into the response variable
 	err = json.Unmarshal(body, &response)
 	if err != nil {
-		return "", nil, fmt.Errorf("error decoding JSON response: %v", err)
+		return nil, fmt.Errorf("error decoding JSON response: %v", err)
+	}
+
+	// Fulfilled proofs aren't polled again, so only pending statuses are cached.
+	if etag := resp.Header.Get("ETag"); etag != "" && response.Status != "PROOF_FULFILLED" {
+		l.backends.statuses.Add(proofId, cachedStatus{etag: etag, status: response})
+	} else {
+		l.backends.statuses.Remove(proofId)
 	}
 
-	return response.Status, response.Proof, nil
+	return &response, nil
 }
@@ -0,0 +1,213 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// newTestSubmitter creates an L2OutputSubmitter that talks to the given OP Succinct server URL.
+func newTestSubmitter(t *testing.T, serverUrl string) *L2OutputSubmitter {
+	l := &L2OutputSubmitter{}
+	l.Log = testlog.Logger(t, log.LevelInfo)
+	l.Cfg = ProposerConfig{OPSuccinctServerUrl: serverUrl}
+	l.backends = newProverBackends(l.Cfg)
+	return l
+}
+
+// TestRequestSpanProofs tests that a batch span proof request returns one proof ID per span, and that a server without
+// the batch endpoint is reported as unsupported.
+func TestRequestSpanProofs(t *testing.T) {
+	spans := []Span{{Start: 100, End: 110}, {Start: 110, End: 120}}
+
+	t.Run("Supported", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/request_span_proofs", r.URL.Path)
+			var req SpanProofsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, []SpanProofRequest{{Start: 100, End: 110}, {Start: 110, End: 120}}, req.Requests)
+			json.NewEncoder(w).Encode(ProofsResponse{ProofIDs: []string{"a", "b"}})
+		}))
+		defer srv.Close()
+
+		ids, err := newTestSubmitter(t, srv.URL).RequestSpanProofs(spans)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, ids)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		defer srv.Close()
+
+		_, err := newTestSubmitter(t, srv.URL).RequestSpanProofs(spans)
+		assert.ErrorIs(t, err, ErrBatchRequestsUnsupported)
+	})
+}
+
+// TestGetProofStatusNotModified tests that a status the server reports as unchanged is answered from the cache.
+func TestGetProofStatusNotModified(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		json.NewEncoder(w).Encode(ProofStatus{Status: "PROOF_CLAIMED"})
+	}))
+	defer srv.Close()
+
+	l := newTestSubmitter(t, srv.URL)
+	for i := 0; i < 2; i++ {
+		status, err := l.GetProofStatus("a")
+		require.NoError(t, err)
+		assert.Equal(t, "PROOF_CLAIMED", status.Status)
+	}
+	assert.Equal(t, 2, requests)
+}
+
+// TestWriteAggProofRequest tests that a streamed agg proof request matches the JSON encoding of an AggProofRequest.
+func TestWriteAggProofRequest(t *testing.T) {
+	proofs := map[int][]byte{1: {1, 2, 3}, 2: make([]byte, 10000)}
+	loadProof := func(id int) ([]byte, error) { return proofs[id], nil }
+
+	for _, proofSystem := range []string{"", "sp1"} {
+		limits := ProofPriceLimits{}
+		if proofSystem != "" {
+			limits = ProofPriceLimits{MaxPricePerCycle: 2, MaxTotalFee: 1000}
+		}
+		var buf bytes.Buffer
+		require.NoError(t, writeAggProofRequest(&buf, []int{1, 2}, loadProof, "0xabc", proofSystem, limits))
+
+		expected, err := json.Marshal(AggProofRequest{Subproofs: [][]byte{proofs[1], proofs[2]}, L1Head: "0xabc", ProofSystem: proofSystem, ProofPriceLimits: limits})
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), buf.String())
+	}
+}
+
+// TestRequestProofFromServerBusy tests that a busy server's Retry-After hint is returned with the error.
+func TestRequestProofFromServerBusy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := newTestSubmitter(t, srv.URL).RequestProofFromServer("request_span_proof", []byte("{}"))
+	var busyErr *ServerBusyError
+	require.ErrorAs(t, err, &busyErr)
+	assert.Equal(t, 2*time.Minute, busyErr.RetryAfter)
+	assert.ErrorIs(t, err, ErrServerBusy)
+}
+
+// TestNegotiateServerVersion tests that a primary server with a different API version is rejected, that servers
+// whose compatibility can't be checked are accepted, and that optional endpoints a server doesn't support are disabled.
+func TestNegotiateServerVersion(t *testing.T) {
+	newServer := func(version string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/version", r.URL.Path)
+			w.Write([]byte(version))
+		}))
+	}
+
+	t.Run("Compatible", func(t *testing.T) {
+		srv := newServer(`{"api_version":"v1","features":["witness_upload"]}`)
+		defer srv.Close()
+
+		l := newTestSubmitter(t, srv.URL)
+		require.NoError(t, l.negotiateServerVersion(context.Background()))
+		assert.True(t, l.batchSpanRequestsUnsupported.Load())
+	})
+
+	t.Run("Incompatible", func(t *testing.T) {
+		srv := newServer(`{"api_version":"v2"}`)
+		defer srv.Close()
+
+		err := newTestSubmitter(t, srv.URL).negotiateServerVersion(context.Background())
+		assert.ErrorIs(t, err, ErrServerIncompatible)
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		srv := newServer(`{"api_version":"v1"}`)
+		srv.Close()
+
+		require.NoError(t, newTestSubmitter(t, srv.URL).negotiateServerVersion(context.Background()))
+	})
+
+	t.Run("NoAPIVersion", func(t *testing.T) {
+		srv := newServer(`{"features":[]}`)
+		defer srv.Close()
+
+		l := newTestSubmitter(t, srv.URL)
+		require.NoError(t, l.negotiateServerVersion(context.Background()))
+		assert.True(t, l.batchSpanRequestsUnsupported.Load())
+	})
+
+	t.Run("IncompatibleSecondary", func(t *testing.T) {
+		primary, secondary := newServer(`{"api_version":"v1"}`), newServer(`{"api_version":"v2"}`)
+		defer primary.Close()
+		defer secondary.Close()
+
+		l := newTestSubmitter(t, primary.URL)
+		l.Cfg.OPSuccinctSecondaryServerUrl = secondary.URL
+		l.backends = newProverBackends(l.Cfg)
+		require.NoError(t, l.negotiateServerVersion(context.Background()))
+	})
+}
+
+// TestRequestProofFromServerError tests that the error envelope of an unsuccessful response is returned.
+func TestRequestProofFromServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"INVALID_RANGE","message":"end block is not finalized","retryable":true}`))
+	}))
+	defer srv.Close()
+
+	_, err := newTestSubmitter(t, srv.URL).RequestProofFromServer("request_span_proof", []byte("{}"))
+	var serverErr *ServerError
+	require.ErrorAs(t, err, &serverErr)
+	assert.Equal(t, ServerError{StatusCode: http.StatusBadRequest, Code: "INVALID_RANGE", Message: "end block is not finalized", Retryable: true}, *serverErr)
+}
+
+// TestNonRetryableRequestNotRetried tests that a proof request the server rejected as non-retryable stays failed on
+// the next tick, instead of being queued again.
+func TestNonRetryableRequestNotRetried(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":"INVALID_RANGE","message":"span exceeds the max block range","retryable":false}`))
+	}))
+	defer srv.Close()
+
+	proofDB, err := db.InitDB(filepath.Join(t.TempDir(), "proofs.db"), false)
+	require.NoError(t, err)
+	defer proofDB.CloseDB()
+	l := newTestSubmitter(t, srv.URL)
+	l.db = *proofDB
+
+	require.NoError(t, proofDB.NewEntry(proofrequest.TypeSPAN, 100, 200))
+	p, err := proofDB.GetNextUnrequestedProof()
+	require.NoError(t, err)
+	l.requestProof(*p)
+
+	p, err = proofDB.GetProofRequest(p.ID)
+	require.NoError(t, err)
+	require.Equal(t, proofrequest.StatusFAILED, p.Status)
+
+	require.NoError(t, l.ProcessPendingProofs())
+	unrequested, err := proofDB.GetNumberOfRequestsWithStatuses(proofrequest.StatusUNREQ)
+	require.NoError(t, err)
+	require.Zero(t, unrequested)
+}
@@ -0,0 +1,78 @@
+package proposer
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Reset values above this are Unix times, the others are seconds until the reset.
+const rateLimitResetUnixThreshold = 1_000_000_000
+
+// rateLimit tracks the request budget that a server reports in the rate limit headers of its responses, so that
+// requests can be paced to stay within it instead of tripping the limit during catch-up.
+type rateLimit struct {
+	mu sync.Mutex
+	// Whether the server reported a budget that hasn't been reset yet.
+	known     bool
+	remaining int
+	reset     time.Time
+}
+
+// observe records the budget reported in the headers of a response. Both the X-RateLimit-* and the RateLimit-*
+// headers are supported.
+func (r *rateLimit) observe(header http.Header, now time.Time) {
+	remaining, ok := rateLimitHeader(header, "Remaining")
+	if !ok {
+		return
+	}
+	reset, ok := rateLimitHeader(header, "Reset")
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.known = true
+	r.remaining = int(remaining)
+	if reset > rateLimitResetUnixThreshold {
+		r.reset = time.Unix(int64(reset), 0)
+	} else {
+		r.reset = now.Add(time.Duration(reset) * time.Second)
+	}
+}
+
+func rateLimitHeader(header http.Header, name string) (uint64, bool) {
+	value := header.Get("X-RateLimit-" + name)
+	if value == "" {
+		value = header.Get("RateLimit-" + name)
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	return n, err == nil
+}
+
+// available returns the number of requests left in the budget, or -1 if the server didn't report a budget.
+func (r *rateLimit) available(now time.Time) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.known || !now.Before(r.reset) {
+		return -1
+	}
+	return r.remaining
+}
+
+// take spends a request from the budget. Returns false if the budget is exhausted until the reset.
+func (r *rateLimit) take(now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.known || !now.Before(r.reset) {
+		r.known = false
+		return true
+	}
+	if r.remaining == 0 {
+		return false
+	}
+	r.remaining--
+	return true
+}
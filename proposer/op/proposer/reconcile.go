@@ -0,0 +1,153 @@
+package proposer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// BillingRecord is a proof request that the prover network billed for, from its billing export.
+type BillingRecord struct {
+	RequestID string
+	Cycles    uint64
+	Fee       uint64
+	Prover    string
+}
+
+// ReadBillingCSV reads the billing export of the prover network. The CSV must have a header row with the columns
+// request_id and fee, and optionally cycles and prover, in any order. Unknown columns are ignored.
+func ReadBillingCSV(r io.Reader) ([]BillingRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read billing CSV header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"request_id", "fee"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("billing CSV has no %s column", required)
+		}
+	}
+
+	var records []BillingRecord
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read billing CSV: %w", err)
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		uintField := func(name string) (uint64, error) {
+			value := field(name)
+			if value == "" {
+				return 0, nil
+			}
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %s on line %d of billing CSV: %w", name, line, err)
+			}
+			return n, nil
+		}
+
+		record := BillingRecord{RequestID: field("request_id"), Prover: field("prover")}
+		if record.Cycles, err = uintField("cycles"); err != nil {
+			return nil, err
+		}
+		if record.Fee, err = uintField("fee"); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+}
+
+// ReconciledProof is a proof request in the DB, and the billing record of the prover network for it.
+type ReconciledProof struct {
+	Local  *ent.ProofRequest
+	Billed BillingRecord
+}
+
+// ReconciliationReport compares the fulfilled proofs recorded in the DB against the billing of the prover network.
+type ReconciliationReport struct {
+	// Proofs whose recorded cycles, fee and prover match the billing.
+	Matched []ReconciledProof
+	// Proofs whose recorded cycles, fee or prover differ from the billing. Fields that either side didn't report
+	// aren't compared.
+	Mismatched []ReconciledProof
+	// Proofs that were billed, but aren't complete in the DB, e.g. because they timed out and were requested again.
+	Unfulfilled []ReconciledProof
+	// Billing records for request IDs that aren't in the DB.
+	Unknown []BillingRecord
+	// Proofs that are complete in the DB, but weren't billed.
+	Unbilled []*ent.ProofRequest
+
+	// The sum of the fees recorded in the DB, and billed by the prover network.
+	LocalFee  uint64
+	BilledFee uint64
+}
+
+// Reconcile compares the proof requests in the DB against the billing records of the prover network, by request ID.
+func Reconcile(local []*ent.ProofRequest, billing []BillingRecord) *ReconciliationReport {
+	report := &ReconciliationReport{}
+	byRequestID := make(map[string]*ent.ProofRequest, len(local))
+	for _, p := range local {
+		byRequestID[networkRequestID(p)] = p
+		report.LocalFee += p.FulfilledFee
+	}
+
+	billed := make(map[string]bool, len(billing))
+	for _, record := range billing {
+		billed[record.RequestID] = true
+		report.BilledFee += record.Fee
+		p, ok := byRequestID[record.RequestID]
+		switch {
+		case !ok:
+			report.Unknown = append(report.Unknown, record)
+		case p.Status != proofrequest.StatusCOMPLETE:
+			report.Unfulfilled = append(report.Unfulfilled, ReconciledProof{Local: p, Billed: record})
+		case billingMatches(p, record):
+			report.Matched = append(report.Matched, ReconciledProof{Local: p, Billed: record})
+		default:
+			report.Mismatched = append(report.Mismatched, ReconciledProof{Local: p, Billed: record})
+		}
+	}
+
+	for _, p := range local {
+		if p.Status == proofrequest.StatusCOMPLETE && !billed[networkRequestID(p)] {
+			report.Unbilled = append(report.Unbilled, p)
+		}
+	}
+	return report
+}
+
+// networkRequestID returns the ID of a proof request on the prover network. Requests sent to the secondary backend are
+// recorded with its prefix, which the network doesn't know about.
+func networkRequestID(p *ent.ProofRequest) string {
+	id, _ := strings.CutPrefix(p.ProverRequestID, secondaryBackendName+":")
+	return id
+}
+
+// billingMatches returns whether the fulfillment metadata recorded for a proof matches its billing record.
+func billingMatches(p *ent.ProofRequest, record BillingRecord) bool {
+	differs := func(local, billed uint64) bool { return local != 0 && billed != 0 && local != billed }
+	if differs(p.FulfilledCycles, record.Cycles) || p.FulfilledFee != record.Fee {
+		return false
+	}
+	return p.Prover == "" || record.Prover == "" || strings.EqualFold(p.Prover, record.Prover)
+}
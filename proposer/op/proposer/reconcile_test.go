@@ -0,0 +1,43 @@
+package proposer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// TestReconcile tests that the proofs in the DB are classified by how they compare against the prover network's billing.
+func TestReconcile(t *testing.T) {
+	billing, err := ReadBillingCSV(strings.NewReader("fee,request_id,cycles,prover\n" +
+		"10,0x01,1000,0xabc\n" +
+		"20,0x02,2000,0xabc\n" +
+		"30,0x03,,\n" +
+		"40,0x05,4000,0xabc\n"))
+	require.NoError(t, err)
+	require.Equal(t, BillingRecord{RequestID: "0x03", Fee: 30}, billing[2])
+
+	complete := func(id int, requestID string, cycles, fee uint64) *ent.ProofRequest {
+		return &ent.ProofRequest{ID: id, ProverRequestID: requestID, Status: proofrequest.StatusCOMPLETE, FulfilledCycles: cycles, FulfilledFee: fee, Prover: "0xABC"}
+	}
+	local := []*ent.ProofRequest{
+		complete(1, "0x01", 1000, 10),
+		complete(2, "0x02", 2000, 25),
+		{ID: 3, ProverRequestID: secondaryBackendName + ":0x03", Status: proofrequest.StatusFAILED},
+		complete(4, "0x04", 3000, 15),
+	}
+
+	report := Reconcile(local, billing)
+	require.Len(t, report.Matched, 1)
+	require.Equal(t, 1, report.Matched[0].Local.ID)
+	require.Len(t, report.Mismatched, 1)
+	require.Equal(t, 2, report.Mismatched[0].Local.ID)
+	require.Len(t, report.Unfulfilled, 1)
+	require.Equal(t, 3, report.Unfulfilled[0].Local.ID)
+	require.Equal(t, []BillingRecord{billing[3]}, report.Unknown)
+	require.Equal(t, []*ent.ProofRequest{local[3]}, report.Unbilled)
+	require.Equal(t, uint64(50), report.LocalFee)
+	require.Equal(t, uint64(100), report.BilledFee)
+}
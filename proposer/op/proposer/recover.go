@@ -0,0 +1,32 @@
+package proposer
+
+import (
+	"runtime/debug"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// recoverWorker recovers from a panic in a background worker, so that it doesn't take down the whole proposer. The
+// proof requests the worker was handling are marked as FAILED, as their state is unknown. Must be deferred.
+func (l *L2OutputSubmitter) recoverWorker(worker string, reqs ...ent.ProofRequest) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	l.Metr.RecordBackgroundPanic(worker)
+
+	ids := make([]int, len(reqs))
+	for i, req := range reqs {
+		ids[i] = req.ID
+	}
+	l.Log.Error("ALERT: recovered from panic in background worker", "worker", worker, "panic", r, "proofIDs", ids, "stack", string(debug.Stack()))
+
+	for _, req := range reqs {
+		if err := l.db.UpdateProofStatus(req.ID, proofrequest.StatusFAILED); err != nil {
+			l.Log.Error("failed to mark proof as failed after panic", "id", req.ID, "err", err)
+			continue
+		}
+		l.publishProofEvent(EventProofFailed, req.ID)
+	}
+}
@@ -0,0 +1,158 @@
+package proposer
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/urfave/cli/v2"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/flags"
+)
+
+// The settings that can be changed without restarting the proposer, by editing the config file and sending SIGHUP.
+var tunableSettings = []struct {
+	flag  cli.Flag
+	apply func(ctx *cli.Context, cfg *ProposerConfig)
+}{
+	{flags.PollIntervalFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.PollInterval = ctx.Duration(flags.PollIntervalFlag.Name)
+	}},
+	{flags.PendingProofsIntervalFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.PendingProofsInterval = ctx.Duration(flags.PendingProofsIntervalFlag.Name)
+	}},
+	{flags.AggProofsIntervalFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.AggProofsInterval = ctx.Duration(flags.AggProofsIntervalFlag.Name)
+	}},
+	{flags.RequestProofsIntervalFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.RequestProofsInterval = ctx.Duration(flags.RequestProofsIntervalFlag.Name)
+	}},
+	{flags.MaxConcurrentProofRequestsFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxConcurrentProofRequests = ctx.Uint64(flags.MaxConcurrentProofRequestsFlag.Name)
+	}},
+	{flags.TargetProofLatencyFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.TargetProofLatency = ctx.Duration(flags.TargetProofLatencyFlag.Name)
+	}},
+	{flags.MinConcurrentProofRequestsFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MinConcurrentProofRequests = ctx.Uint64(flags.MinConcurrentProofRequestsFlag.Name)
+	}},
+	{flags.MaxPendingSpanProofsFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxPendingSpanProofs = ctx.Uint64(flags.MaxPendingSpanProofsFlag.Name)
+	}},
+	{flags.SpanProofBatchSizeFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.SpanProofBatchSize = max(ctx.Uint64(flags.SpanProofBatchSizeFlag.Name), 1)
+	}},
+	{flags.MaxCyclesPerSpanProofFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxCyclesPerSpanProof = ctx.Uint64(flags.MaxCyclesPerSpanProofFlag.Name)
+	}},
+	{flags.MaxFeePerSpanProofFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxFeePerSpanProof = ctx.Uint64(flags.MaxFeePerSpanProofFlag.Name)
+	}},
+	{flags.MaxPricePerCycleFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxPricePerCycle = ctx.Uint64(flags.MaxPricePerCycleFlag.Name)
+	}},
+	{flags.DailyProvingBudgetFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.DailyProvingBudget = ctx.Uint64(flags.DailyProvingBudgetFlag.Name)
+	}},
+	{flags.WeeklyProvingBudgetFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.WeeklyProvingBudget = ctx.Uint64(flags.WeeklyProvingBudgetFlag.Name)
+	}},
+	{flags.SubmissionMaxBaseFeeFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.SubmissionMaxBaseFee = ctx.Uint64(flags.SubmissionMaxBaseFeeFlag.Name)
+	}},
+	{flags.MaxTotalFeeFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxTotalFee = ctx.Uint64(flags.MaxTotalFeeFlag.Name)
+	}},
+	{flags.MaxPipelinedAggProofsFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.MaxPipelinedAggProofs = max(ctx.Uint64(flags.MaxPipelinedAggProofsFlag.Name), 1)
+	}},
+	{flags.ProofTimeoutFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.ProofTimeout = ctx.Uint64(flags.ProofTimeoutFlag.Name)
+	}},
+	{flags.SpanProofTimeoutFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.SpanProofTimeout = ctx.Uint64(flags.SpanProofTimeoutFlag.Name)
+	}},
+	{flags.AggProofTimeoutFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.AggProofTimeout = ctx.Uint64(flags.AggProofTimeoutFlag.Name)
+	}},
+	{flags.ProofTimeoutPerBlockFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.ProofTimeoutPerBlock = ctx.Uint64(flags.ProofTimeoutPerBlockFlag.Name)
+	}},
+	{flags.GCIntervalFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.GCInterval = ctx.Duration(flags.GCIntervalFlag.Name)
+	}},
+	{flags.ArchiveAfterFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.ArchiveAfter = ctx.Duration(flags.ArchiveAfterFlag.Name)
+	}},
+	{flags.StatusPollConcurrencyFlag, func(ctx *cli.Context, cfg *ProposerConfig) {
+		cfg.StatusPollConcurrency = ctx.Uint64(flags.StatusPollConcurrencyFlag.Name)
+	}},
+}
+
+// loadTunables reads the tunable settings from the config file. It returns a function that applies them to a
+// ProposerConfig, and the names of the settings that it applies. Settings that were set through flags or environment
+// variables take precedence over the config file, so they are not reloaded.
+func loadTunables(path string, explicit map[string]bool) (func(cfg *ProposerConfig), []string, error) {
+	settings, err := readConfigFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fs := flag.NewFlagSet("reload", flag.ContinueOnError)
+	var applies []func(ctx *cli.Context, cfg *ProposerConfig)
+	var names []string
+	for _, setting := range tunableSettings {
+		f := cliapp.ProtectFlags([]cli.Flag{setting.flag})[0]
+		if err := f.Apply(fs); err != nil {
+			return nil, nil, fmt.Errorf("failed to apply flag %s: %w", f.Names()[0], err)
+		}
+
+		name := f.Names()[0]
+		value, ok := settings[name]
+		if !ok || explicit[name] {
+			continue
+		}
+		s, err := configValueString(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid value for %q: %w", name, err)
+		}
+		if err := fs.Set(name, s); err != nil {
+			return nil, nil, fmt.Errorf("invalid value for %q: %w", name, err)
+		}
+		applies = append(applies, setting.apply)
+		names = append(names, name)
+	}
+
+	ctx := cli.NewContext(nil, fs, nil)
+	return func(cfg *ProposerConfig) {
+		for _, apply := range applies {
+			apply(ctx, cfg)
+		}
+	}, names, nil
+}
+
+// reloadOnSighup reloads the tunable settings from the config file whenever the process receives SIGHUP, until done
+// is closed. In-flight proof requests are unaffected, as they're tracked in the DB.
+func (ps *ProposerService) reloadOnSighup(path string, explicit map[string]bool) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	for {
+		select {
+		case <-sighup:
+			update, names, err := loadTunables(path, explicit)
+			if err != nil {
+				ps.Log.Error("failed to reload config file", "path", path, "err", err)
+				continue
+			}
+			ps.Log.Info("Reloading config file", "path", path, "settings", names)
+			ps.driver.ReloadConfig(update)
+		case <-ps.reloadDone:
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package proposer
+
+import (
+	"fmt"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// Requeue queues a FAILED proof request again, for operators that fixed the cause of the failure. Span proofs are
+// split into the given number of span proofs of (about) equal size, which helps if the span was too large to prove.
+// Agg proofs can't be split, and the agg proof is skipped if another agg proof with the same start block is active.
+// Like the driver's retries, blocks that are already covered by other span proof requests aren't queued again. Returns
+// the number of proof requests queued, which is 0 if the request is already replaced by other requests.
+func Requeue(proofDB *db.ProofDB, p *ent.ProofRequest, parts uint64) (int, error) {
+	if p.Status != proofrequest.StatusFAILED {
+		return 0, fmt.Errorf("proof request %d has status %s, only FAILED requests can be re-queued", p.ID, p.Status)
+	}
+	if p.Type == proofrequest.TypeAGG {
+		if parts > 1 {
+			return 0, fmt.Errorf("proof request %d is an AGG proof, which can't be split", p.ID)
+		}
+		if !p.Backfill {
+			active, err := proofDB.GetActiveAggProof(p.StartBlock)
+			if err != nil {
+				return 0, err
+			}
+			if active != nil {
+				return 0, nil
+			}
+		}
+		return proofDB.NewChildEntry(p, p.Type, p.StartBlock, p.EndBlock)
+	}
+
+	parts = max(1, min(parts, p.EndBlock-p.StartBlock))
+	size := p.EndBlock - p.StartBlock
+	start := p.StartBlock
+	var queued int
+	for i := uint64(1); i <= parts; i++ {
+		end := p.StartBlock + size*i/parts
+		n, err := proofDB.NewChildEntry(p, p.Type, start, end)
+		if err != nil {
+			return queued, err
+		}
+		queued += n
+		start = end
+	}
+	return queued, nil
+}
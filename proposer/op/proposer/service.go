@@ -9,7 +9,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	"github.com/ethereum-optimism/optimism/op-proposer/proposer/rpc"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
@@ -23,6 +22,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/grpc"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/metrics"
+	"github.com/succinctlabs/op-succinct-go/proposer/utils"
 )
 
 var ErrAlreadyStopped = errors.New("already stopped")
@@ -42,6 +46,9 @@ type ProposerConfig struct {
 	DisputeGameFactoryAddr *common.Address
 	DisputeGameType        uint32
 
+	// The L2OutputOracle of the incumbent proposer that outputs are compared against in shadow mode.
+	ShadowL2OOAddr *common.Address
+
 	// AllowNonFinalized enables the proposal of safe, but non-finalized L2 blocks.
 	// The L1 block-hash embedded in the proposal TX is checked and should ensure the proposal
 	// is never valid on an alternative L1 chain that would produce different L2 data.
@@ -64,6 +71,62 @@ type ProposerConfig struct {
 	MaxConcurrentProofRequests uint64
 	BatchInbox                 common.Address
 	BatcherAddress             common.Address
+	TargetCyclesPerSpanProof   uint64
+	SpanProofBatchSize         uint64
+	MaxCyclesPerSpanProof      uint64
+	MaxFeePerSpanProof         uint64
+	CheckpointReuseWindow      time.Duration
+	AggProofL1HeadPolicy       string
+	AggProofL1HeadOffset       uint64
+	MaxPipelinedAggProofs      uint64
+	// Failover to a secondary OP Succinct server.
+	OPSuccinctSecondaryServerUrl string
+	FailoverMinFulfillmentRate   float64
+	FailoverCooldown             time.Duration
+	ProofSystem                  string
+	SpanProofTimeout             uint64
+	AggProofTimeout              uint64
+	ProofTimeoutPerBlock         uint64
+	GCInterval                   time.Duration
+	StatusPollConcurrency        uint64
+	ServerMaxIdleConns           uint64
+	ServerHTTP2                  bool
+	ShutdownTimeout              time.Duration
+	WitnessGenCmd                string
+	ServerCompression            string
+	BatchConfirmations           uint64
+	BatchFinalized               bool
+	HeadPollInterval             time.Duration
+	L2HeadMargin                 uint64
+	FinalizedOnly                bool
+	MaxPricePerCycle             uint64
+	MaxTotalFee                  uint64
+	PriceCeilingAction           string
+	DailyProvingBudget           uint64
+	WeeklyProvingBudget          uint64
+	OutputSLA                    time.Duration
+	OutputSLAAlertWindow         time.Duration
+	CrossCheckOutputRoots        bool
+	ArchiveAfter                 time.Duration
+	DbJournalMode                string
+	DbBusyTimeout                time.Duration
+	DbMaxReadConns               int
+	ProofEncryptionKeyFile       string
+	ConductorRpc                 string
+	LeaseFile                    string
+	LeaseDuration                time.Duration
+	InstanceID                   string
+	DependencySetFile            string
+	EventBus                     string
+	EventTopic                   string
+	PendingProofsInterval        time.Duration
+	AggProofsInterval            time.Duration
+	RequestProofsInterval        time.Duration
+	SubmissionSchedule           []string
+	SubmissionMaxBaseFee         uint64
+	MaxPendingSpanProofs         uint64
+	TargetProofLatency           time.Duration
+	MinConcurrentProofRequests   uint64
 }
 
 type ProposerService struct {
@@ -74,6 +137,7 @@ type ProposerService struct {
 
 	TxManager      txmgr.TxManager
 	L1Client       *ethclient.Client
+	L2Client       *ethclient.Client
 	RollupProvider dial.RollupProvider
 
 	driver *L2OutputSubmitter
@@ -83,10 +147,20 @@ type ProposerService struct {
 	pprofService *oppprof.Service
 	metricsSrv   *httputil.HTTPServer
 	rpcServer    *oprpc.Server
+	// Serves the watch API over websockets.
+	watchServer *gethrpc.Server
+	// Serves the watch API over gRPC, if enabled.
+	grpcServer *grpc.Server
 
 	balanceMetricer io.Closer
 
 	stopped atomic.Bool
+
+	// The config file that tunable settings are reloaded from on SIGHUP, and the settings that were set through flags
+	// or environment variables instead. reloadDone is closed to stop reloading.
+	configFile    string
+	explicitFlags map[string]bool
+	reloadDone    chan struct{}
 }
 
 // ProposerServiceFromCLIConfig creates a new ProposerService from a CLIConfig.
@@ -126,13 +200,77 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	ps.MaxConcurrentProofRequests = cfg.MaxConcurrentProofRequests
 	ps.BatchInbox = common.HexToAddress(cfg.BatchInbox)
 	ps.BatcherAddress = common.HexToAddress(cfg.BatcherAddress)
+	ps.TargetCyclesPerSpanProof = cfg.TargetCyclesPerSpanProof
+	ps.SpanProofBatchSize = cfg.SpanProofBatchSize
+	ps.MaxCyclesPerSpanProof = cfg.MaxCyclesPerSpanProof
+	ps.MaxFeePerSpanProof = cfg.MaxFeePerSpanProof
+	ps.CheckpointReuseWindow = cfg.CheckpointReuseWindow
+	ps.AggProofL1HeadPolicy = cfg.AggProofL1HeadPolicy
+	ps.AggProofL1HeadOffset = cfg.AggProofL1HeadOffset
+	ps.MaxPipelinedAggProofs = cfg.MaxPipelinedAggProofs
+	ps.OPSuccinctSecondaryServerUrl = cfg.OPSuccinctSecondaryServerUrl
+	ps.FailoverMinFulfillmentRate = cfg.FailoverMinFulfillmentRate
+	ps.FailoverCooldown = cfg.FailoverCooldown
+	ps.ProofSystem = cfg.ProofSystem
+	ps.SpanProofTimeout = cfg.SpanProofTimeout
+	ps.AggProofTimeout = cfg.AggProofTimeout
+	ps.ProofTimeoutPerBlock = cfg.ProofTimeoutPerBlock
+	ps.GCInterval = cfg.GCInterval
+	ps.StatusPollConcurrency = cfg.StatusPollConcurrency
+	ps.ServerMaxIdleConns = cfg.ServerMaxIdleConns
+	ps.ServerHTTP2 = cfg.ServerHTTP2
+	ps.ShutdownTimeout = cfg.ShutdownTimeout
+	ps.WitnessGenCmd = cfg.WitnessGenCmd
+	ps.ServerCompression = cfg.ServerCompression
+	ps.BatchConfirmations = cfg.BatchConfirmations
+	ps.BatchFinalized = cfg.BatchFinalized
+	ps.HeadPollInterval = cfg.HeadPollInterval
+	ps.L2HeadMargin = cfg.L2HeadMargin
+	ps.FinalizedOnly = cfg.FinalizedOnly
+	ps.MaxPricePerCycle = cfg.MaxPricePerCycle
+	ps.MaxTotalFee = cfg.MaxTotalFee
+	ps.PriceCeilingAction = cfg.PriceCeilingAction
+	ps.DailyProvingBudget = cfg.DailyProvingBudget
+	ps.WeeklyProvingBudget = cfg.WeeklyProvingBudget
+	ps.OutputSLA = cfg.OutputSLA
+	ps.OutputSLAAlertWindow = cfg.OutputSLAAlertWindow
+	ps.CrossCheckOutputRoots = cfg.CrossCheckOutputRoots
+	ps.ArchiveAfter = cfg.ArchiveAfter
+	ps.DbJournalMode = cfg.DbJournalMode
+	ps.DbBusyTimeout = cfg.DbBusyTimeout
+	ps.DbMaxReadConns = cfg.DbMaxReadConns
+	ps.ProofEncryptionKeyFile = cfg.ProofEncryptionKeyFile
+	ps.ConductorRpc = cfg.ConductorRpc
+	ps.LeaseFile = cfg.LeaseFile
+	ps.LeaseDuration = cfg.LeaseDuration
+	ps.InstanceID = cfg.InstanceID
+	ps.DependencySetFile = cfg.DependencySetFile
+	ps.EventBus = cfg.EventBus
+	ps.EventTopic = cfg.EventTopic
+	ps.PendingProofsInterval = cfg.PendingProofsInterval
+	ps.AggProofsInterval = cfg.AggProofsInterval
+	ps.RequestProofsInterval = cfg.RequestProofsInterval
+	ps.SubmissionSchedule = cfg.SubmissionSchedule
+	ps.SubmissionMaxBaseFee = cfg.SubmissionMaxBaseFee
+	ps.MaxPendingSpanProofs = cfg.MaxPendingSpanProofs
+	ps.TargetProofLatency = cfg.TargetProofLatency
+	ps.MinConcurrentProofRequests = cfg.MinConcurrentProofRequests
 
 	ps.initL2ooAddress(cfg)
 	ps.initDGF(cfg)
+	if cfg.ConfigFile != "" {
+		ps.configFile = cfg.ConfigFile
+		ps.explicitFlags = cfg.explicitFlags
+		ps.reloadDone = make(chan struct{})
+	}
 
 	if err := ps.initRPCClients(ctx, cfg); err != nil {
 		return err
 	}
+	if err := ps.validateEndpoints(ctx, cfg); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	ps.logEffectiveConfig(cfg)
 	if err := ps.initTxManager(cfg); err != nil {
 		return fmt.Errorf("failed to init Tx manager: %w", err)
 	}
@@ -149,6 +287,9 @@ func (ps *ProposerService) initFromCLIConfig(ctx context.Context, version string
 	if err := ps.initRPCServer(cfg); err != nil {
 		return fmt.Errorf("failed to start RPC server: %w", err)
 	}
+	if err := ps.initGRPCServer(cfg); err != nil {
+		return fmt.Errorf("failed to start gRPC server: %w", err)
+	}
 
 	ps.Metrics.RecordInfo(ps.Version)
 	ps.Metrics.RecordUp()
@@ -162,6 +303,14 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 	}
 	ps.L1Client = l1Client
 
+	if cfg.L2EthRpc != "" {
+		l2Client, err := dial.DialEthClientWithTimeout(ctx, dial.DefaultDialTimeout, ps.Log, cfg.L2EthRpc)
+		if err != nil {
+			return fmt.Errorf("failed to dial L2 RPC: %w", err)
+		}
+		ps.L2Client = l2Client
+	}
+
 	var rollupProvider dial.RollupProvider
 	if strings.Contains(cfg.RollupRpc, ",") {
 		rollupUrls := strings.Split(cfg.RollupRpc, ",")
@@ -172,7 +321,19 @@ func (ps *ProposerService) initRPCClients(ctx context.Context, cfg *CLIConfig) e
 	if err != nil {
 		return fmt.Errorf("failed to build L2 endpoint provider: %w", err)
 	}
-	ps.RollupProvider = rollupProvider
+
+	// Verify outputs against the quorum rollup nodes, before they're cached.
+	var verifiers []dial.RollupProvider
+	for _, url := range cfg.QuorumRollupRpcs {
+		verifier, err := dial.NewStaticL2RollupProvider(ctx, ps.Log, url)
+		if err != nil {
+			return fmt.Errorf("failed to dial quorum rollup node %s: %w", url, err)
+		}
+		verifiers = append(verifiers, verifier)
+	}
+	rollupProvider = utils.NewQuorumRollupProvider(rollupProvider, verifiers, int(cfg.RollupQuorum), ps.Log)
+
+	ps.RollupProvider = utils.NewCachingRollupProvider(rollupProvider, cfg.RPCCacheTTL)
 	return nil
 }
 
@@ -244,6 +405,10 @@ func (ps *ProposerService) initL2ooAddress(cfg *CLIConfig) {
 		return
 	}
 	ps.L2OutputOracleAddr = &l2ooAddress
+
+	if shadowAddress, err := opservice.ParseAddress(cfg.ShadowL2OOAddress); err == nil {
+		ps.ShadowL2OOAddr = &shadowAddress
+	}
 }
 
 func (ps *ProposerService) initDGF(cfg *CLIConfig) {
@@ -264,6 +429,7 @@ func (ps *ProposerService) initDriver() error {
 		Cfg:            ps.ProposerConfig,
 		Txmgr:          ps.TxManager,
 		L1Client:       ps.L1Client,
+		L2Client:       ps.L2Client,
 		RollupProvider: ps.RollupProvider,
 	})
 	if err != nil {
@@ -274,15 +440,27 @@ func (ps *ProposerService) initDriver() error {
 }
 
 func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
-	server := oprpc.NewServer(
-		cfg.RPCConfig.ListenAddr,
-		cfg.RPCConfig.ListenPort,
-		ps.Version,
+	watchServer, err := newWatchServer(ps.driver)
+	if err != nil {
+		return err
+	}
+	ps.watchServer = watchServer
+	opts := []oprpc.ServerOption{
 		oprpc.WithLogger(ps.Log),
-	)
+		oprpc.WithMiddleware(ps.versionHandler),
+		oprpc.WithMiddleware(ps.historyHandler),
+		oprpc.WithMiddleware(ps.statsHandler),
+		oprpc.WithMiddleware(ps.watchHandler),
+	}
+	if cfg.GraphQL {
+		opts = append(opts, oprpc.WithMiddleware(ps.graphQLHandler))
+	}
+	server := oprpc.NewServer(cfg.RPCConfig.ListenAddr, cfg.RPCConfig.ListenPort, ps.Version, opts...)
+	server.AddAPI(getWatchAPI(ps.driver))
 	if cfg.RPCConfig.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(ps.driver, ps.Metrics, ps.Log)
 		server.AddAPI(rpc.GetAdminAPI(adminAPI))
+		server.AddAPI(getLabelsAPI(&ps.driver.db))
 		ps.Log.Info("Admin RPC enabled")
 	}
 	ps.Log.Info("Starting JSON-RPC server")
@@ -293,11 +471,30 @@ func (ps *ProposerService) initRPCServer(cfg *CLIConfig) error {
 	return nil
 }
 
+func (ps *ProposerService) initGRPCServer(cfg *CLIConfig) error {
+	if cfg.GRPCAddr == "" {
+		return nil
+	}
+	server, addr, err := startWatchGRPCServer(ps.driver, cfg.GRPCAddr)
+	if err != nil {
+		return err
+	}
+	ps.Log.Info("Started gRPC server", "addr", addr)
+	ps.grpcServer = server
+	return nil
+}
+
 // Start runs once upon start of the proposer lifecycle,
 // and starts L2Output-submission work if the proposer is configured to start submit data on startup.
 func (ps *ProposerService) Start(_ context.Context) error {
 	ps.Log.Info("Starting Proposer")
-	return ps.driver.StartL2OutputSubmitting()
+	if err := ps.driver.StartL2OutputSubmitting(); err != nil {
+		return err
+	}
+	if ps.reloadDone != nil {
+		go ps.reloadOnSighup(ps.configFile, ps.explicitFlags)
+	}
+	return nil
 }
 
 func (ps *ProposerService) Stopped() bool {
@@ -318,6 +515,9 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 		return ErrAlreadyStopped
 	}
 	ps.Log.Info("Stopping Proposer")
+	if ps.reloadDone != nil {
+		close(ps.reloadDone)
+	}
 
 	var result error
 	if ps.driver != nil {
@@ -326,12 +526,24 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 		}
 	}
 
+	if ps.driver != nil {
+		if err := ps.driver.events.Close(); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to close event bus publisher: %w", err))
+		}
+	}
+
 	if ps.rpcServer != nil {
 		// TODO(7685): the op-service RPC server is not built on top of op-service httputil Server, and has poor shutdown
 		if err := ps.rpcServer.Stop(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to stop RPC server: %w", err))
 		}
 	}
+	if ps.watchServer != nil {
+		ps.watchServer.Stop()
+	}
+	if ps.grpcServer != nil {
+		ps.grpcServer.Stop()
+	}
 	if ps.pprofService != nil {
 		if err := ps.pprofService.Stop(ctx); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to stop PProf server: %w", err))
@@ -357,6 +569,10 @@ func (ps *ProposerService) Stop(ctx context.Context) error {
 		ps.L1Client.Close()
 	}
 
+	if ps.L2Client != nil {
+		ps.L2Client.Close()
+	}
+
 	if ps.RollupProvider != nil {
 		ps.RollupProvider.Close()
 	}
@@ -0,0 +1,116 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-proposer/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// IncumbentOracle is the L2OutputOracle contract that the incumbent proposer submits outputs to.
+type IncumbentOracle interface {
+	LatestBlockNumber(*bind.CallOpts) (*big.Int, error)
+	GetL2OutputAfter(*bind.CallOpts, *big.Int) (opbindings.TypesOutputProposal, error)
+}
+
+// shadowL2OO wraps the L2OO contract of a proposer in shadow mode. As outputs aren't submitted in shadow mode, the
+// latest output on the contract doesn't advance, so the driver progresses from the latest output that it compared
+// against the incumbent proposer instead.
+type shadowL2OO struct {
+	L2OOContract
+	// The L2 block of the latest output compared against the incumbent proposer.
+	latest atomic.Uint64
+}
+
+func (c *shadowL2OO) LatestBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	latest, err := c.L2OOContract.LatestBlockNumber(opts)
+	if err != nil {
+		return nil, err
+	}
+	if shadow := c.latest.Load(); shadow > latest.Uint64() {
+		return new(big.Int).SetUint64(shadow), nil
+	}
+	return latest, nil
+}
+
+func (c *shadowL2OO) NextBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	contractLatest, err := c.L2OOContract.LatestBlockNumber(opts)
+	if err != nil {
+		return nil, err
+	}
+	contractNext, err := c.L2OOContract.NextBlockNumber(opts)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := c.LatestBlockNumber(opts)
+	if err != nil {
+		return nil, err
+	}
+	interval := new(big.Int).Sub(contractNext, contractLatest)
+	return interval.Add(interval, latest), nil
+}
+
+// newShadowL2OO sets up shadow mode, comparing outputs against the incumbent proposer's L2OutputOracle at the given
+// address.
+func newShadowL2OO(l2ooContract L2OOContract, incumbentAddr common.Address, l1Client bind.ContractCaller) (*shadowL2OO, IncumbentOracle, error) {
+	incumbent, err := opbindings.NewL2OutputOracleCaller(incumbentAddr, l1Client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create incumbent L2OO at address %s: %w", incumbentAddr, err)
+	}
+	return &shadowL2OO{L2OOContract: l2ooContract}, incumbent, nil
+}
+
+// CompareShadowOutputs is run in shadow mode instead of SubmitAggProofs. It compares the output of the next completed
+// agg proof against the output that the incumbent proposer submitted for the same L2 block, and reports divergences.
+// Once compared, the driver moves on to the next output.
+func (l *L2OutputSubmitter) CompareShadowOutputs(ctx context.Context) error {
+	latestBlockNumber, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest block number: %w", err)
+	}
+	completedAggProofs, err := l.db.GetAllCompletedAggProofs(latestBlockNumber.Uint64())
+	if err != nil {
+		return fmt.Errorf("failed to query for completed AGG proof: %w", err)
+	}
+	if len(completedAggProofs) == 0 {
+		return nil
+	}
+	aggProof := completedAggProofs[0]
+
+	incumbentLatest, err := l.incumbent.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get latest block number of the incumbent proposer: %w", err)
+	}
+	if incumbentLatest.Uint64() < aggProof.EndBlock {
+		l.Log.Info("waiting for the incumbent proposer to submit an output", "block", aggProof.EndBlock, "incumbentLatest", incumbentLatest)
+		return nil
+	}
+
+	output, err := l.FetchOutput(ctx, aggProof.EndBlock)
+	if err != nil {
+		return err
+	}
+	proposal, err := l.incumbent.GetL2OutputAfter(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(aggProof.EndBlock))
+	if err != nil {
+		return fmt.Errorf("failed to get output of the incumbent proposer: %w", err)
+	}
+
+	result := "match"
+	switch {
+	case proposal.L2BlockNumber.Uint64() != aggProof.EndBlock:
+		result = "unmatched"
+		l.Log.Warn("incumbent proposer has no output at the block, skipping comparison", "block", aggProof.EndBlock, "incumbentBlock", proposal.L2BlockNumber)
+	case common.Hash(proposal.OutputRoot) != common.Hash(output.OutputRoot):
+		result = "diverged"
+		l.Log.Error("output diverges from the incumbent proposer", "block", aggProof.EndBlock, "outputRoot", common.Hash(output.OutputRoot), "incumbentOutputRoot", common.Hash(proposal.OutputRoot))
+	default:
+		l.Log.Info("output matches the incumbent proposer", "block", aggProof.EndBlock, "outputRoot", common.Hash(output.OutputRoot))
+	}
+	l.Metr.RecordShadowComparison(result)
+	l.shadow.latest.Store(aggProof.EndBlock)
+	return nil
+}
@@ -0,0 +1,95 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// slaThroughputSmoothing is the weight of the latest sample in the moving average of the proving throughput.
+const slaThroughputSmoothing = 0.2
+
+// slaMonitor estimates the proving throughput from how fast the highest proven L2 block advances. It's only used by
+// the driver loop.
+type slaMonitor struct {
+	lastProven uint64
+	lastTime   time.Time
+	// Exponential moving average of the L2 blocks proven per second, once there are two samples.
+	throughput float64
+	hasRate    bool
+	// Whether the SLA was breached, or predicted to be breached within the alert window, on the last check.
+	critical bool
+}
+
+// observe records the highest proven L2 block at the given time.
+func (m *slaMonitor) observe(proven uint64, now time.Time) {
+	if !m.lastTime.IsZero() {
+		elapsed := now.Sub(m.lastTime).Seconds()
+		if elapsed <= 0 {
+			return
+		}
+		rate := float64(proven-min(proven, m.lastProven)) / elapsed
+		if m.hasRate {
+			m.throughput += slaThroughputSmoothing * (rate - m.throughput)
+		} else {
+			m.throughput = rate
+			m.hasRate = true
+		}
+	}
+	m.lastProven = proven
+	m.lastTime = now
+}
+
+// timeToBreach predicts how long it takes for the lag to use up the given slack at the current proving throughput, for
+// an L2 chain with the given block time in seconds. Returns false if the lag isn't growing.
+func (m *slaMonitor) timeToBreach(slack time.Duration, blockTime uint64) (time.Duration, bool) {
+	if !m.hasRate {
+		return 0, false
+	}
+	// Per second, the L2 head adds a second to the lag, and each proven block removes a block time.
+	growth := 1 - m.throughput*float64(blockTime)
+	if growth <= 0 {
+		return 0, false
+	}
+	return time.Duration(float64(max(slack, 0)) / growth), true
+}
+
+// checkOutputSLA updates the output lag metrics, and alerts if the lag of the latest output behind the L2 safe head
+// breaches the output SLA, or is predicted to breach it within the alert window.
+func (l *L2OutputSubmitter) checkOutputSLA(ctx context.Context, metrics ProposerMetrics) error {
+	if l.config().OutputSLA == 0 {
+		return nil
+	}
+
+	blockTimeU256, err := l.l2ooContract.L2BLOCKTIME(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get L2 block time: %w", err)
+	}
+	blockTime := blockTimeU256.Uint64()
+	lag := func(head uint64) time.Duration {
+		return time.Duration((head-min(head, metrics.LatestContractL2Block))*blockTime) * time.Second
+	}
+
+	l.Metr.RecordOutputLag("unsafe", lag(metrics.L2UnsafeHeadBlock))
+	l.Metr.RecordOutputLag("safe", lag(metrics.L2SafeHeadBlock))
+	slack := l.config().OutputSLA - lag(metrics.L2SafeHeadBlock)
+	l.Metr.RecordOutputSLASlack(slack)
+	l.sla.observe(metrics.HighestProvenContiguousL2Block, time.Now())
+
+	l.sla.critical = true
+	if slack <= 0 {
+		l.Metr.RecordOutputSLABreachPredicted(true)
+		l.Log.Error("output SLA breached", "lag", lag(metrics.L2SafeHeadBlock), "sla", l.config().OutputSLA, "latestOutput", metrics.LatestContractL2Block, "safeHead", metrics.L2SafeHeadBlock)
+		return nil
+	}
+	if breachIn, ok := l.sla.timeToBreach(slack, blockTime); ok && breachIn <= l.config().OutputSLAAlertWindow {
+		l.Metr.RecordOutputSLABreachPredicted(true)
+		l.Log.Error("output SLA breach predicted at current proving throughput", "breachIn", breachIn, "slack", slack, "throughput", l.sla.throughput)
+		return nil
+	}
+	l.Metr.RecordOutputSLABreachPredicted(false)
+	l.sla.critical = false
+	return nil
+}
@@ -0,0 +1,28 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSLAMonitorTimeToBreach tests that a breach is predicted when blocks are proven slower than they're produced.
+func TestSLAMonitorTimeToBreach(t *testing.T) {
+	var m slaMonitor
+	now := time.Unix(1700000000, 0)
+	m.observe(100, now)
+	_, ok := m.timeToBreach(time.Hour, 2)
+	require.False(t, ok)
+
+	// 25 blocks proven in 100s, while 50 blocks of 2s were produced: the lag grows by half a second per second.
+	m.observe(125, now.Add(100*time.Second))
+	breachIn, ok := m.timeToBreach(time.Hour, 2)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Hour, breachIn)
+
+	// Proving faster than the chain shrinks the lag.
+	m.observe(300, now.Add(200*time.Second))
+	_, ok = m.timeToBreach(time.Hour, 2)
+	require.False(t, ok)
+}
@@ -3,8 +3,12 @@ package proposer
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent"
 	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
 )
@@ -15,17 +19,117 @@ type Span struct {
 }
 
 func (l *L2OutputSubmitter) CreateSpans(start, end uint64) []Span {
+	cfg := l.config()
 	spans := []Span{}
 	// Create spans of size MaxBlockRangePerSpanProof from start to end.
 	// Each span starts where the previous one ended.
 	// Continue until we can't fit another full span before reaching end.
-	for i := start; i+l.Cfg.MaxBlockRangePerSpanProof <= end; i += l.Cfg.MaxBlockRangePerSpanProof {
-		spans = append(spans, Span{Start: i, End: i + l.Cfg.MaxBlockRangePerSpanProof})
+	for i := start; i+cfg.MaxBlockRangePerSpanProof <= end; i += cfg.MaxBlockRangePerSpanProof {
+		spans = append(spans, Span{Start: i, End: i + cfg.MaxBlockRangePerSpanProof})
 	}
 	return spans
 }
 
+// Rough cycle costs of the range program, derived from cost estimator runs. Derivation, oracle verification and blob
+// verification are amortized per block, while block execution scales with the gas used and the number of transactions.
+const (
+	estimatedCyclesPerBlock = 18_000_000
+	estimatedCyclesPerTx    = 500_000
+	estimatedCyclesPerGas   = 21
+)
+
+// The number of per-block cost estimates that are cached. This covers many partial spans, even at the smallest block
+// times.
+const blockCyclesCacheSize = 100_000
+
+// L2BlockFetcher fetches the L2 block data used to estimate the proving cost of a block.
+type L2BlockFetcher interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error)
+}
+
+// EstimateBlockCycles estimates the number of cycles required to prove an L2 block.
+func EstimateBlockCycles(gasUsed uint64, txCount uint64) uint64 {
+	return estimatedCyclesPerBlock + txCount*estimatedCyclesPerTx + gasUsed*estimatedCyclesPerGas
+}
+
+// CreateDynamicSpans creates spans from start to end whose estimated proving cost is close to TargetCyclesPerSpanProof.
+// A span is closed as soon as its estimated cost reaches the target, or it reaches MaxBlockRangePerSpanProof blocks.
+// Like CreateSpans, the trailing partial span is not returned, as it will be extended once more blocks are available.
+// The block estimates are cached, so the blocks of the trailing partial span are only fetched once.
+func (l *L2OutputSubmitter) CreateDynamicSpans(ctx context.Context, fetcher L2BlockFetcher, start, end uint64) ([]Span, error) {
+	cfg := l.config()
+	spans := []Span{}
+	spanStart := start
+	var spanCycles uint64
+	// A span [start, end] proves the blocks (start, end], so the cost of the start block is not included.
+	for block := start + 1; block <= end; block++ {
+		cycles, err := l.cachedL2BlockCycles(ctx, fetcher, block)
+		if err != nil {
+			return nil, err
+		}
+		spanCycles += cycles
+
+		if spanCycles >= cfg.TargetCyclesPerSpanProof || block-spanStart >= cfg.MaxBlockRangePerSpanProof {
+			spans = append(spans, Span{Start: spanStart, End: block})
+			spanStart = block
+			spanCycles = 0
+		}
+	}
+	return spans, nil
+}
+
+// EstimateRangeCycles estimates the number of cycles required to prove the span [start, end].
+func EstimateRangeCycles(ctx context.Context, fetcher L2BlockFetcher, start, end uint64) (uint64, error) {
+	var cycles uint64
+	for block := start + 1; block <= end; block++ {
+		blockCycles, err := estimateL2BlockCycles(ctx, fetcher, block)
+		if err != nil {
+			return 0, err
+		}
+		cycles += blockCycles
+	}
+	return cycles, nil
+}
+
+// cachedL2BlockCycles estimates the number of cycles required to prove an L2 block, reusing the previous estimate of
+// the block if there is one. Spans are only created for blocks that are safe, so the estimates are keyed by number.
+func (l *L2OutputSubmitter) cachedL2BlockCycles(ctx context.Context, fetcher L2BlockFetcher, block uint64) (uint64, error) {
+	l.blockCyclesOnce.Do(func() {
+		// The cache size is a positive constant, so creating the cache can't fail.
+		l.blockCycles, _ = lru.New[uint64, uint64](blockCyclesCacheSize)
+	})
+	if cycles, ok := l.blockCycles.Get(block); ok {
+		return cycles, nil
+	}
+	cycles, err := estimateL2BlockCycles(ctx, fetcher, block)
+	if err != nil {
+		return 0, err
+	}
+	l.blockCycles.Add(block, cycles)
+	return cycles, nil
+}
+
+// estimateL2BlockCycles fetches an L2 block and estimates the number of cycles required to prove it.
+func estimateL2BlockCycles(ctx context.Context, fetcher L2BlockFetcher, block uint64) (uint64, error) {
+	header, err := fetcher.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get L2 header %d: %w", block, err)
+	}
+	txCount, err := fetcher.TransactionCount(ctx, header.Hash())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get transaction count of L2 block %d: %w", block, err)
+	}
+	return EstimateBlockCycles(header.GasUsed, uint64(txCount)), nil
+}
+
 func (l *L2OutputSubmitter) DeriveNewSpanBatches(ctx context.Context) error {
+	cfg := l.config()
+	// Don't grow the queue while the prover network isn't keeping up, e.g. during an outage.
+	if backlogged, err := l.backlogged(); err != nil || backlogged {
+		return err
+	}
+
 	// nextBlock is equal to the highest value in the `EndBlock` column of the DB, plus 1.
 	latestL2EndBlock, err := l.db.GetLatestEndBlock()
 	if err != nil {
@@ -43,13 +147,8 @@ func (l *L2OutputSubmitter) DeriveNewSpanBatches(ctx context.Context) error {
 	}
 	newL2StartBlock := latestL2EndBlock
 
-	rollupClient, err := l.RollupProvider.RollupClient(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get rollup client: %w", err)
-	}
-
 	// Get the latest finalized L2 block.
-	status, err := rollupClient.SyncStatus(ctx)
+	status, err := l.heads.syncStatus(ctx)
 	if err != nil {
 		l.Log.Error("proposer unable to get sync status", "err", err)
 		return err
@@ -57,8 +156,54 @@ func (l *L2OutputSubmitter) DeriveNewSpanBatches(ctx context.Context) error {
 	// Note: Originally, this used the L1 finalized block. However, to satisfy the new API, we now use the L2 finalized block.
 	newL2EndBlock := status.FinalizedL2.Number
 
-	// Create spans of size MaxBlockRangePerSpanProof from newL2StartBlock to newL2EndBlock.
+	// In finalized-only mode, don't trust a finalized L2 head that is derived from L1 data the node doesn't consider
+	// finalized, e.g. because the node's L1 view is lagging or inconsistent.
+	if cfg.FinalizedOnly && status.FinalizedL2.L1Origin.Number > status.FinalizedL1.Number {
+		l.Log.Warn("finalized L2 head is derived from a non-finalized L1 block, not queueing span proofs",
+			"finalizedL2", status.FinalizedL2.ID(), "l1Origin", status.FinalizedL2.L1Origin, "finalizedL1", status.FinalizedL1.ID())
+		return nil
+	}
+
+	// If batch gating is enabled, the safe head is proven up to the highest block whose batch is confirmed on L1,
+	// instead of waiting for the L2 block to be finalized.
+	if l.batchGatingEnabled() {
+		rollupClient, err := l.RollupProvider.RollupClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get rollup client: %w", err)
+		}
+		confirmed, err := l.batchConfirmedL2Head(ctx, rollupClient, status)
+		if err != nil {
+			l.Log.Warn("failed to get the L2 head confirmed by batches on L1, only proving finalized blocks", "err", err)
+		} else {
+			newL2EndBlock = max(newL2EndBlock, min(status.SafeL2.Number, confirmed))
+			l.Log.Debug("gated span proofs by batch confirmations", "confirmedL2Head", confirmed, "end", newL2EndBlock)
+		}
+	}
+	// Stay L2HeadMargin blocks behind the head, so that span proofs aren't requested for blocks that may still reorg.
+	newL2EndBlock -= min(newL2EndBlock, cfg.L2HeadMargin)
+	// On interop chains, only prove the blocks whose executing messages are covered by the dependency set.
+	newL2EndBlock, err = l.boundByDependencies(ctx, newL2EndBlock)
+	if err != nil {
+		return fmt.Errorf("failed to bound span proofs by the dependency set: %w", err)
+	}
+
+	// Create spans of size MaxBlockRangePerSpanProof from newL2StartBlock to newL2EndBlock. If a target cycle count is
+	// configured, size the spans by their estimated proving cost instead.
 	spans := l.CreateSpans(newL2StartBlock, newL2EndBlock)
+	if cfg.TargetCyclesPerSpanProof > 0 && l.L2Client != nil {
+		dynamicSpans, err := l.CreateDynamicSpans(ctx, l.L2Client, newL2StartBlock, newL2EndBlock)
+		if err != nil {
+			l.Log.Warn("failed to estimate span costs, falling back to fixed-size spans", "err", err)
+		} else {
+			spans = dynamicSpans
+		}
+	}
+	// Split the spans that cross a hardfork activation, as the blocks on either side are proven by different programs.
+	forks, err := l.loadForkSchedule(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load hardfork schedule: %w", err)
+	}
+	spans = forks.splitSpans(spans)
 	// Add each span to the DB. If there are no spans, we will not create any proofs.
 	for _, span := range spans {
 		err := l.db.NewEntry(proofrequest.TypeSPAN, span.Start, span.End)
@@ -1,8 +1,13 @@
 package proposer
 
 import (
+	"context"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -75,3 +80,76 @@ func TestCreateSpans(t *testing.T) {
 		})
 	}
 }
+
+// mockL2BlockFetcher returns the configured gas used of each block (zero by default), and no transactions. It counts
+// the headers fetched.
+type mockL2BlockFetcher struct {
+	gasUsed map[uint64]uint64
+	fetched int
+}
+
+func (m *mockL2BlockFetcher) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	m.fetched++
+	return &types.Header{Number: number, GasUsed: m.gasUsed[number.Uint64()]}, nil
+}
+
+func (m *mockL2BlockFetcher) TransactionCount(_ context.Context, _ common.Hash) (uint, error) {
+	return 0, nil
+}
+
+// TestCreateDynamicSpans tests that spans are closed once their estimated cost reaches the target, and never exceed
+// MaxBlockRangePerSpanProof blocks.
+func TestCreateDynamicSpans(t *testing.T) {
+	fetcher := &mockL2BlockFetcher{gasUsed: map[uint64]uint64{}}
+	// Blocks 101-104 are expensive, the rest are empty.
+	for b := uint64(101); b <= 104; b++ {
+		fetcher.gasUsed[b] = 10_000_000
+	}
+
+	l := &L2OutputSubmitter{}
+	l.Cfg = ProposerConfig{
+		MaxBlockRangePerSpanProof: 10,
+		TargetCyclesPerSpanProof:  2 * EstimateBlockCycles(10_000_000, 0),
+	}
+
+	spans, err := l.CreateDynamicSpans(context.Background(), fetcher, 100, 125)
+	assert.NoError(t, err)
+	assert.Equal(t, []Span{
+		{Start: 100, End: 102},
+		{Start: 102, End: 104},
+		{Start: 104, End: 114},
+		{Start: 114, End: 124},
+	}, spans)
+
+	// The next tick resumes from the last span, and only fetches the new blocks.
+	fetched := fetcher.fetched
+	spans, err = l.CreateDynamicSpans(context.Background(), fetcher, 124, 134)
+	assert.NoError(t, err)
+	assert.Equal(t, []Span{{Start: 124, End: 134}}, spans)
+	assert.Equal(t, 9, fetcher.fetched-fetched)
+}
+
+// TestForkScheduleSplitSpans tests that spans crossing a hardfork activation are split at the last block before the
+// activation, and that the blocks on either side are tagged with the right program version.
+func TestForkScheduleSplitSpans(t *testing.T) {
+	fjord, granite := uint64(1100), uint64(1301)
+	cfg := &rollup.Config{BlockTime: 2, FjordTime: &fjord, GraniteTime: &granite}
+	cfg.Genesis.L2Time = 1000
+
+	s := newForkSchedule(cfg)
+	// Fjord activates at block 50, and Granite at block 151 (the first block at or after its timestamp).
+	assert.Equal(t, []forkActivation{{name: "fjord", block: 50}, {name: "granite", block: 151}}, s.activations)
+
+	spans := s.splitSpans([]Span{{Start: 0, End: 100}, {Start: 100, End: 200}, {Start: 200, End: 300}})
+	assert.Equal(t, []Span{
+		{Start: 0, End: 49},
+		{Start: 49, End: 100},
+		{Start: 100, End: 150},
+		{Start: 150, End: 200},
+		{Start: 200, End: 300},
+	}, spans)
+
+	assert.Equal(t, "", s.programVersion(49))
+	assert.Equal(t, "fjord", s.programVersion(150))
+	assert.Equal(t, "granite", s.programVersion(151))
+}
@@ -0,0 +1,116 @@
+package proposer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/succinctlabs/op-succinct-go/proposer/db"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofstat"
+)
+
+// maxStatsPeriodsPerRollUp bounds the periods rolled up per cleanup, so that the first roll-up of a large DB doesn't
+// stall the driver loop.
+const maxStatsPeriodsPerRollUp = 168
+
+// defaultStatsPeriods is the number of periods that the stats endpoint returns if no time range is given.
+const defaultStatsPeriods = 24
+
+// rollUpStats rolls up the proving statistics of the hours and days that ended since the last roll-up into the stats
+// table. It runs before old proof requests are archived, so that archived requests are still counted.
+func (l *L2OutputSubmitter) rollUpStats() error {
+	now := time.Now()
+	for _, period := range []proofstat.Period{proofstat.PeriodHOUR, proofstat.PeriodDAY} {
+		if _, err := l.db.RollUpStats(period, now, maxStatsPeriodsPerRollUp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProvingStats are the proving statistics of an hour or a day, as served by the stats endpoint.
+type ProvingStats struct {
+	PeriodStart uint64 `json:"period_start"`
+	// The number of proofs requested, and fulfilled and failed within the period.
+	Requested int `json:"requested"`
+	Fulfilled int `json:"fulfilled"`
+	Failed    int `json:"failed"`
+	// The L2 blocks proven by the span proofs fulfilled within the period.
+	BlocksProven uint64 `json:"blocks_proven"`
+	// The average time in seconds from request to fulfillment of the proofs fulfilled within the period.
+	AverageLatency float64 `json:"average_latency"`
+	// The fees of the proofs fulfilled within the period.
+	Spend uint64 `json:"spend"`
+}
+
+// statsHandler serves the rolled up proving statistics on GET /stats, and passes other requests to next. The period
+// query parameter selects hourly (the default) or daily statistics, and the since and until query parameters the Unix
+// time range of the period starts. By default, the last 24 periods are returned.
+func (ps *ProposerService) statsHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stats" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			writeAPIError(w, http.StatusMethodNotAllowed, fmt.Errorf("only GET is supported"))
+			return
+		}
+
+		stats, err := ps.getStats(r)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(stats); err != nil {
+			ps.Log.Error("failed to write stats", "err", err)
+		}
+	})
+}
+
+func (ps *ProposerService) getStats(r *http.Request) ([]ProvingStats, error) {
+	query := r.URL.Query()
+	period := proofstat.PeriodHOUR
+	if p := query.Get("period"); p != "" {
+		period = proofstat.Period(strings.ToUpper(p))
+		if err := proofstat.PeriodValidator(period); err != nil {
+			return nil, err
+		}
+	}
+	until := uint64(time.Now().Unix())
+	if u := query.Get("until"); u != "" {
+		var err error
+		if until, err = strconv.ParseUint(u, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid until %q", u)
+		}
+	}
+	since := until - min(until, defaultStatsPeriods*uint64(db.StatsPeriods[period].Seconds()))
+	if s := query.Get("since"); s != "" {
+		var err error
+		if since, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid since %q", s)
+		}
+	}
+
+	rows, err := ps.driver.db.GetStats(period, since, until)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]ProvingStats, 0, len(rows))
+	for _, s := range rows {
+		stats = append(stats, ProvingStats{
+			PeriodStart:    s.PeriodStart,
+			Requested:      s.Requested,
+			Fulfilled:      s.Fulfilled,
+			Failed:         s.Failed,
+			BlocksProven:   s.BlocksProven,
+			AverageLatency: s.AverageLatency,
+			Spend:          s.Spend,
+		})
+	}
+	return stats, nil
+}
@@ -0,0 +1,166 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// cronSchedule is a cron expression with five fields: minute, hour, day of month, month and day of week. Each field is
+// a list of values, ranges (a-b) and steps (*/n, a-b/n), or * for any value. Times are matched in UTC.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// Like in cron, if both the day of month and the day of week are restricted, a day matching either matches.
+	domAny, dowAny bool
+}
+
+// parseCronSchedule parses a cron expression.
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+	s := &cronSchedule{domAny: fields[2] == "*", dowAny: fields[4] == "*"}
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	sets := [5]*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+		}
+		*sets[i] = set
+	}
+	// Sunday can be written as 0 or 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// parseCronField parses a field of a cron expression into the set of values it matches.
+func parseCronField(field string, lo, hi int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+			step = n
+		}
+		start, end := lo, hi
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("invalid range %q", part)
+				}
+			} else if hasStep {
+				end = hi
+			}
+			if start < lo || end > hi || start > end {
+				return 0, fmt.Errorf("%q out of range [%d, %d]", part, lo, hi)
+			}
+		}
+		for v := start; v <= end; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// matches returns whether the schedule matches the minute of the given time.
+func (s *cronSchedule) matches(t time.Time) bool {
+	t = t.UTC()
+	has := func(set uint64, v int) bool { return set&(1<<v) != 0 }
+	if !has(s.minute, t.Minute()) || !has(s.hour, t.Hour()) || !has(s.month, int(t.Month())) {
+		return false
+	}
+	dom, dow := has(s.dom, t.Day()), has(s.dow, int(t.Weekday()))
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dow
+	case s.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// parseSubmissionWindows parses the cron expressions of the submission windows.
+func parseSubmissionWindows(exprs []string) ([]*cronSchedule, error) {
+	windows := make([]*cronSchedule, 0, len(exprs))
+	for _, expr := range exprs {
+		window, err := parseCronSchedule(expr)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// canSubmit returns whether agg proofs can be submitted now: within one of the submission windows, and while the base
+// fee of the latest L1 block is at most SubmissionMaxBaseFee. Outside of these, agg proofs are still submitted if the
+// output SLA is breached or predicted to be breached, as its monitor last found.
+func (l *L2OutputSubmitter) canSubmit(ctx context.Context, now time.Time) (bool, error) {
+	open, reason, err := l.inSubmissionWindow(ctx, now)
+	if err != nil {
+		return false, err
+	}
+	if open {
+		l.Metr.RecordSubmissionWindowOpen(true)
+		return true, nil
+	}
+	l.Metr.RecordSubmissionWindowOpen(false)
+	if l.sla.critical {
+		l.Log.Warn("submitting outside of the submission windows, as the output SLA is at risk", "reason", reason)
+		return true, nil
+	}
+	l.Log.Info("not submitting agg proofs outside of the submission windows", "reason", reason)
+	return false, nil
+}
+
+// inSubmissionWindow returns whether the submission window conditions hold, and if not, why.
+func (l *L2OutputSubmitter) inSubmissionWindow(ctx context.Context, now time.Time) (bool, string, error) {
+	if len(l.submissionWindows) > 0 {
+		inWindow := false
+		for _, window := range l.submissionWindows {
+			if window.matches(now) {
+				inWindow = true
+				break
+			}
+		}
+		if !inWindow {
+			return false, "outside of the submission schedule", nil
+		}
+	}
+
+	if l.config().SubmissionMaxBaseFee != 0 {
+		cCtx, cancel := context.WithTimeout(ctx, l.config().NetworkTimeout)
+		defer cancel()
+		header, err := l.L1Client.HeaderByNumber(cCtx, nil)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to get latest L1 header: %w", err)
+		}
+		maxBaseFee := new(big.Int).Mul(new(big.Int).SetUint64(l.config().SubmissionMaxBaseFee), big.NewInt(params.GWei))
+		if header.BaseFee != nil && header.BaseFee.Cmp(maxBaseFee) > 0 {
+			return false, fmt.Sprintf("L1 base fee %s wei above the max of %d gwei", header.BaseFee, l.config().SubmissionMaxBaseFee), nil
+		}
+	}
+	return true, "", nil
+}
@@ -0,0 +1,38 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCronSchedule tests that submission windows match the minutes of their cron expressions, in UTC.
+func TestCronSchedule(t *testing.T) {
+	// Wednesday, 15 May 2024.
+	at := func(hour, minute int) time.Time { return time.Date(2024, 5, 15, hour, minute, 0, 0, time.UTC) }
+
+	nightly, err := parseCronSchedule("* 0-6 * * *")
+	require.NoError(t, err)
+	require.True(t, nightly.matches(at(3, 30)))
+	require.False(t, nightly.matches(at(7, 0)))
+	require.True(t, nightly.matches(at(3, 30).In(time.FixedZone("UTC+5", 5*3600))))
+
+	quarterly, err := parseCronSchedule("*/15 12 * * 1-5")
+	require.NoError(t, err)
+	require.True(t, quarterly.matches(at(12, 45)))
+	require.False(t, quarterly.matches(at(12, 50)))
+	require.False(t, quarterly.matches(at(12, 45).AddDate(0, 0, 4)))
+
+	// Restricting both the day of month and the day of week matches either, and Sunday can be written as 7.
+	either, err := parseCronSchedule("0 0 1 * 7")
+	require.NoError(t, err)
+	require.True(t, either.matches(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, either.matches(time.Date(2024, 5, 19, 0, 0, 0, 0, time.UTC)))
+	require.False(t, either.matches(at(0, 0)))
+
+	for _, expr := range []string{"* * * *", "60 * * * *", "* 5-2 * * *", "*/0 * * * *", "a * * * *"} {
+		_, err := parseCronSchedule(expr)
+		require.Error(t, err, expr)
+	}
+}
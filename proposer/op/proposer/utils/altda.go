@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	altda "github.com/ethereum-optimism/optimism/op-alt-da"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// DALayer is the byte following the commitment type in a generic alt-DA commitment, which identifies the DA layer
+// that the batch data was posted to.
+type DALayer byte
+
+const (
+	DALayerEigenDA  DALayer = 0x00
+	DALayerCelestia DALayer = 0x0c
+)
+
+// Celestia commitments are the DA layer byte, followed by the 8 byte block height and the 32 byte blob commitment.
+const celestiaCommitmentLength = 1 + 8 + 32
+
+var ErrUnsupportedCommitment = errors.New("unsupported alt-DA commitment")
+
+// AltDAClient resolves the alt-DA commitments that a batcher posts to the batch inbox to the batch data they commit to.
+type AltDAClient interface {
+	GetInput(ctx context.Context, comm altda.CommitmentData) ([]byte, error)
+}
+
+// CelestiaDAClient resolves Celestia commitments to their blob data through a Celestia alt-DA server.
+type CelestiaDAClient struct {
+	client *altda.DAClient
+}
+
+// NewCelestiaDAClient creates a client for the Celestia alt-DA server at the given URL.
+func NewCelestiaDAClient(serverURL string) *CelestiaDAClient {
+	return &CelestiaDAClient{client: altda.NewDAClient(serverURL, false, false)}
+}
+
+// GetInput fetches the blob data for a Celestia commitment.
+func (c *CelestiaDAClient) GetInput(ctx context.Context, comm altda.CommitmentData) ([]byte, error) {
+	generic, err := genericCommitmentForLayer(comm, DALayerCelestia)
+	if err != nil {
+		return nil, err
+	}
+	if len(generic) != celestiaCommitmentLength {
+		return nil, fmt.Errorf("invalid Celestia commitment length: got %d, want %d", len(generic), celestiaCommitmentLength)
+	}
+	return c.client.GetInput(ctx, comm)
+}
+
+// EigenDAClient resolves EigenDA certificates to their blob data through an EigenDA proxy.
+type EigenDAClient struct {
+	client *altda.DAClient
+}
+
+// NewEigenDAClient creates a client for the EigenDA proxy at the given URL.
+func NewEigenDAClient(proxyURL string) *EigenDAClient {
+	return &EigenDAClient{client: altda.NewDAClient(proxyURL, false, false)}
+}
+
+// GetInput fetches the blob data for an EigenDA certificate. The proxy verifies the certificate against the blob.
+func (c *EigenDAClient) GetInput(ctx context.Context, comm altda.CommitmentData) ([]byte, error) {
+	generic, err := genericCommitmentForLayer(comm, DALayerEigenDA)
+	if err != nil {
+		return nil, err
+	}
+	// The DA layer byte is followed by the certificate version byte and the certificate itself.
+	if len(generic) < 3 {
+		return nil, fmt.Errorf("invalid EigenDA commitment length: %d", len(generic))
+	}
+	return c.client.GetInput(ctx, comm)
+}
+
+// Check that the commitment is a generic commitment to the given DA layer.
+func genericCommitmentForLayer(comm altda.CommitmentData, layer DALayer) (altda.GenericCommitment, error) {
+	generic, ok := comm.(altda.GenericCommitment)
+	if !ok || len(generic) == 0 || DALayer(generic[0]) != layer {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCommitment, comm.String())
+	}
+	return generic, nil
+}
+
+// Resolve the alt-DA commitments in the batcher transactions stored in the given directory to the frames they commit
+// to, and rewrite the stored transactions with the resolved frames.
+func resolveAltDABatches(daClient AltDAClient, directory string) error {
+	files, err := os.ReadDir(directory)
+	if err != nil {
+		return fmt.Errorf("failed to read batch directory: %w", err)
+	}
+
+	resolved := 0
+	for _, file := range files {
+		filename := filepath.Join(directory, file.Name())
+		ok, err := resolveAltDABatch(daClient, filename)
+		if err != nil {
+			return fmt.Errorf("failed to resolve alt-DA batch %s: %w", file.Name(), err)
+		}
+		if ok {
+			resolved++
+		}
+	}
+
+	fmt.Printf("Resolved %v alt-DA batches\n", resolved)
+	return nil
+}
+
+// Resolve the alt-DA commitment in a single stored batcher transaction. Returns false if the transaction doesn't
+// contain an alt-DA commitment.
+func resolveAltDABatch(daClient AltDAClient, filename string) (bool, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return false, err
+	}
+	var txm fetch.TransactionWithMetadata
+	if err := json.Unmarshal(data, &txm); err != nil {
+		return false, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+
+	txData := txm.Tx.Data()
+	if len(txData) == 0 || txData[0] != altda.TxDataVersion1 {
+		return false, nil
+	}
+	comm, err := altda.DecodeCommitmentData(txData[1:])
+	if err != nil {
+		return false, fmt.Errorf("failed to decode commitment: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	input, err := daClient.GetInput(ctx, comm)
+	if err != nil {
+		return false, fmt.Errorf("failed to get input for commitment %s: %w", comm.String(), err)
+	}
+
+	frames, err := derive.ParseFrames(input)
+	if err != nil {
+		txm.Frames = nil
+		txm.FrameErrs = []string{err.Error()}
+		txm.ValidFrames = []bool{false}
+	} else {
+		txm.Frames = frames
+		txm.FrameErrs = []string{""}
+		txm.ValidFrames = []bool{true}
+	}
+
+	out, err := json.Marshal(&txm)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	if err := os.WriteFile(filename, out, 0640); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The number of L1 blocks whose SystemConfig logs are requested at once, which stays within the block range limit of
+// common RPC providers.
+const batcherLogsChunkSize = 10_000
+
+// batcherUpdate is a change of the batcher address of the SystemConfig contract.
+type batcherUpdate struct {
+	l1Block uint64
+	batcher common.Address
+}
+
+// batcherSchedule is the batcher address of the chain over time, starting with the batcher of the genesis system
+// config. Like in derivation, a batcher update applies to the batches of the L1 block that emitted it, so that
+// batches posted across a batcher rotation are attributed to the right sender.
+type batcherSchedule struct {
+	updates []batcherUpdate
+	// The next L1 block whose SystemConfig logs aren't scanned yet.
+	next uint64
+}
+
+// batcherAt returns the batcher address in effect for the batches of the given L1 block.
+func (s *batcherSchedule) batcherAt(l1Block uint64) common.Address {
+	i := sort.Search(len(s.updates), func(i int) bool { return s.updates[i].l1Block > l1Block })
+	if i == 0 {
+		return s.updates[0].batcher
+	}
+	return s.updates[i-1].batcher
+}
+
+// The batcher schedules scanned so far, by L1 chain and SystemConfig address, so that later calls only scan the logs
+// of new L1 blocks.
+var batcherSchedules = struct {
+	sync.Mutex
+	m map[batcherScheduleKey]*batcherSchedule
+}{m: make(map[batcherScheduleKey]*batcherSchedule)}
+
+type batcherScheduleKey struct {
+	l1ChainID    uint64
+	systemConfig common.Address
+}
+
+// loadBatcherSchedule returns the batcher schedule of the chain up to the L1 block end (exclusive), built from the
+// batcher updates (ConfigUpdate events of type BATCHER) emitted by the SystemConfig contract since the L1 genesis of
+// the chain.
+func loadBatcherSchedule(ctx context.Context, config BatchDecoderConfig, rollupCfg *rollup.Config, end uint64) (*batcherSchedule, error) {
+	batcherSchedules.Lock()
+	defer batcherSchedules.Unlock()
+
+	key := batcherScheduleKey{l1ChainID: rollupCfg.L1ChainID.Uint64(), systemConfig: rollupCfg.L1SystemConfigAddress}
+	schedule, ok := batcherSchedules.m[key]
+	if !ok {
+		// The genesis system config holds the batcher as of the L1 genesis block, so the scan starts after it.
+		schedule = &batcherSchedule{
+			updates: []batcherUpdate{{l1Block: rollupCfg.Genesis.L1.Number, batcher: rollupCfg.Genesis.SystemConfig.BatcherAddr}},
+			next:    rollupCfg.Genesis.L1.Number + 1,
+		}
+		batcherSchedules.m[key] = schedule
+	}
+
+	sysCfg := rollupCfg.Genesis.SystemConfig
+	sysCfg.BatcherAddr = schedule.updates[len(schedule.updates)-1].batcher
+	for schedule.next < end {
+		chunkEnd := min(schedule.next+batcherLogsChunkSize, end)
+		logsCtx, cancel := rpcContext(ctx, config.RPCTimeout)
+		logs, err := config.L1RPC.FilterLogs(logsCtx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(schedule.next),
+			ToBlock:   new(big.Int).SetUint64(chunkEnd - 1),
+			Addresses: []common.Address{rollupCfg.L1SystemConfigAddress},
+			Topics:    [][]common.Hash{{derive.ConfigUpdateEventABIHash}, {derive.ConfigUpdateEventVersion0}, {derive.SystemConfigUpdateBatcher}},
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get SystemConfig logs in L1 blocks [%d, %d): %w", schedule.next, chunkEnd, err)
+		}
+		for _, l := range logs {
+			if err := derive.ProcessSystemConfigUpdateLogEvent(&sysCfg, &l, rollupCfg, 0); err != nil {
+				return nil, fmt.Errorf("invalid batcher update in L1 block %d: %w", l.BlockNumber, err)
+			}
+			if last := schedule.updates[len(schedule.updates)-1]; sysCfg.BatcherAddr != last.batcher {
+				fmt.Printf("Batcher changed from %v to %v in L1 block %d\n", last.batcher, sysCfg.BatcherAddr, l.BlockNumber)
+				schedule.updates = append(schedule.updates, batcherUpdate{l1Block: l.BlockNumber, batcher: sysCfg.BatcherAddr})
+			}
+		}
+		schedule.next = chunkEnd
+	}
+
+	// Updates scanned by later calls are only appended, but the copy keeps the schedule of the caller independent.
+	return &batcherSchedule{updates: slices.Clone(schedule.updates), next: schedule.next}, nil
+}
@@ -0,0 +1,35 @@
+package utils
+
+import "fmt"
+
+// RangeBoundaryPolicy is how a span batch that straddles an edge of a requested L2 block range is returned.
+type RangeBoundaryPolicy string
+
+const (
+	// Split the span batch exactly at the edge, so that the ranges cover exactly the requested range. The default.
+	BoundarySplit RangeBoundaryPolicy = "split"
+	// Snap the range outward to the span batch boundaries, so that the ranges cover whole span batches, and may extend
+	// beyond the requested range. Proofs whose boundaries align with span batch boundaries prove faster.
+	BoundarySnap RangeBoundaryPolicy = "snap"
+)
+
+// ParseRangeBoundaryPolicy parses a range boundary policy. The empty string is the default policy, BoundarySplit.
+func ParseRangeBoundaryPolicy(s string) (RangeBoundaryPolicy, error) {
+	switch p := RangeBoundaryPolicy(s); p {
+	case "":
+		return BoundarySplit, nil
+	case BoundarySplit, BoundarySnap:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown range boundary policy %q, expected %q or %q", s, BoundarySplit, BoundarySnap)
+	}
+}
+
+// apply returns the range of the span batch [batchStart, batchEnd] in the requested range [startBlock, endBlock],
+// which it overlaps.
+func (p RangeBoundaryPolicy) apply(batchStart, batchEnd, startBlock, endBlock uint64) SpanBatchRange {
+	if p == BoundarySnap {
+		return SpanBatchRange{Start: batchStart, End: batchEnd}
+	}
+	return SpanBatchRange{Start: max(startBlock, batchStart), End: min(endBlock, batchEnd)}
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// The number of outputs and L1 headers to cache. The proposer looks up outputs and headers around the few block
+// ranges it's working on, so a small cache is enough.
+const rpcCacheSize = 1024
+
+// CachingRollupProvider wraps a rollup provider, and caches the outputs returned by its rollup clients. Outputs are
+// keyed by block number, so they're only cached for a TTL in case the block is reorged.
+type CachingRollupProvider struct {
+	dial.RollupProvider
+	outputs *expirable.LRU[uint64, *eth.OutputResponse]
+}
+
+// NewCachingRollupProvider wraps the rollup provider with an output cache. If ttl is 0, the provider is returned as
+// is.
+func NewCachingRollupProvider(provider dial.RollupProvider, ttl time.Duration) dial.RollupProvider {
+	if ttl == 0 {
+		return provider
+	}
+	return &CachingRollupProvider{
+		RollupProvider: provider,
+		outputs:        expirable.NewLRU[uint64, *eth.OutputResponse](rpcCacheSize, nil, ttl),
+	}
+}
+
+// RollupClient returns the current rollup client of the provider, with the shared output cache. The cache is shared
+// between the clients, as they serve the same chain.
+func (p *CachingRollupProvider) RollupClient(ctx context.Context) (dial.RollupClientInterface, error) {
+	client, err := p.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &cachingRollupClient{RollupClientInterface: client, outputs: p.outputs}, nil
+}
+
+type cachingRollupClient struct {
+	dial.RollupClientInterface
+	outputs *expirable.LRU[uint64, *eth.OutputResponse]
+}
+
+func (c *cachingRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if output, ok := c.outputs.Get(blockNum); ok {
+		return output, nil
+	}
+	output, err := c.RollupClientInterface.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	c.outputs.Add(blockNum, output)
+	return output, nil
+}
+
+// L1 headers are keyed by hash, so they never go stale. The TTL only bounds the memory held by old headers.
+var l1HeaderCache = expirable.NewLRU[common.Hash, *types.Header](rpcCacheSize, nil, time.Hour)
+
+// Get the L1 header with the given hash, from the cache if possible.
+func headerByHash(ctx context.Context, l1Client *ethclient.Client, hash common.Hash) (*types.Header, error) {
+	if header, ok := l1HeaderCache.Get(hash); ok {
+		return header, nil
+	}
+	header, err := l1Client.HeaderByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	l1HeaderCache.Add(hash, header)
+	return header, nil
+}
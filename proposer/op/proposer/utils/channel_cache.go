@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The version of the channel cache format. Bump it when the format of cachedChannel or the rules for decoding a channel
+// change, so that entries in the old format are ignored.
+const channelCacheFormatVersion = 4
+
+// cachedBatch is the part of a decoded batch needed to compute span batch ranges.
+type cachedBatch struct {
+	StartBlock uint64 `json:"startBlock"`
+	BlockCount uint64 `json:"blockCount"`
+	// Whether the batch is a span batch. Ranges can't be computed for singular batches.
+	IsSpan bool `json:"isSpan"`
+	// The first 20 bytes of the hash of the parent of the first block of a span batch.
+	ParentCheck hexutil.Bytes `json:"parentCheck,omitempty"`
+}
+
+type cachedChannel struct {
+	Batches []cachedBatch `json:"batches"`
+	// The compression of the channel, if it's complete and any of its batches decoded.
+	Compression *channelCompression `json:"compression,omitempty"`
+}
+
+// channelCache persists the batches decoded from complete channels, so that later runs over overlapping L1 ranges
+// skip decompressing and decoding them. Entries are stored per rollup config, as the decoded batches depend on it.
+type channelCache struct {
+	dir string
+}
+
+// newChannelCache opens the channel cache in the given directory, for the given rollup config.
+func newChannelCache(dir string, rollupCfg *rollup.Config) (*channelCache, error) {
+	cfgJSON, err := json.Marshal(rollupCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode rollup config: %w", err)
+	}
+	version := fmt.Sprintf("v%d-%s", channelCacheFormatVersion, crypto.Keccak256Hash(cfgJSON).Hex()[2:18])
+	dir = filepath.Join(dir, version)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create channel cache directory: %w", err)
+	}
+	return &channelCache{dir: dir}, nil
+}
+
+func (c *channelCache) path(id derive.ChannelID) string {
+	return filepath.Join(c.dir, id.String()+".json")
+}
+
+// get returns the cached batches of a channel. A nil cache never has any entries.
+func (c *channelCache) get(id derive.ChannelID) (*cachedChannel, bool) {
+	if c == nil {
+		return nil, false
+	}
+	data, err := os.ReadFile(c.path(id))
+	if err != nil {
+		return nil, false
+	}
+	var ch cachedChannel
+	if err := json.Unmarshal(data, &ch); err != nil {
+		fmt.Printf("Ignoring corrupt channel cache entry for channel %v: %v\n", id.String(), err)
+		return nil, false
+	}
+	return &ch, true
+}
+
+// put stores the batches of a channel. Failures are only reported, as the cache is an optimization.
+func (c *channelCache) put(id derive.ChannelID, ch *cachedChannel) {
+	if c == nil {
+		return
+	}
+	data, err := json.Marshal(ch)
+	if err != nil {
+		fmt.Printf("Failed to encode channel cache entry for channel %v: %v\n", id.String(), err)
+		return
+	}
+	// Write to a temporary file first, so that a concurrent reader never sees a partial entry.
+	tmp := c.path(id) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0640); err != nil {
+		fmt.Printf("Failed to write channel cache entry for channel %v: %v\n", id.String(), err)
+		return
+	}
+	if err := os.Rename(tmp, c.path(id)); err != nil {
+		fmt.Printf("Failed to write channel cache entry for channel %v: %v\n", id.String(), err)
+	}
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The metrics of the channels decoded by the batch decoder, by compression algorithm. They aren't registered by
+// default: register DecoderMetrics with the registry of the process to export them.
+var (
+	decodedChannels = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "op_succinct_decoder",
+		Name:      "channels_decoded_total",
+		Help:      "Number of complete channels decoded, by compression algorithm",
+	}, []string{"algo"})
+	decodedChannelBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "op_succinct_decoder",
+		Name:      "channel_bytes_total",
+		Help:      "Size of the complete channels decoded, by compression algorithm, compressed and decompressed",
+	}, []string{"algo", "size"})
+)
+
+// DecoderMetrics returns the metrics of the batch decoder, to register with a metrics registry.
+func DecoderMetrics() []prometheus.Collector {
+	return []prometheus.Collector{decodedChannels, decodedChannelBytes}
+}
+
+// channelCompression is the compression algorithm of a complete channel, and the size of its data.
+type channelCompression struct {
+	Algo             derive.CompressionAlgo `json:"algo"`
+	CompressedSize   uint64                 `json:"compressedSize"`
+	DecompressedSize uint64                 `json:"decompressedSize"`
+}
+
+// AlgoStats are the compression statistics of the channels compressed with an algorithm.
+type AlgoStats struct {
+	Channels          int    `json:"channels"`
+	CompressedBytes   uint64 `json:"compressed_bytes"`
+	DecompressedBytes uint64 `json:"decompressed_bytes"`
+}
+
+// CompressionStats are the compression statistics of the complete channels that carried the batches of an L2 block
+// range, by compression algorithm.
+type CompressionStats map[derive.CompressionAlgo]*AlgoStats
+
+// compressionOf returns the compression algorithm and sizes of a decoded channel, or nil if the channel isn't complete
+// or none of its batches could be decoded. The channel data is decompressed again to measure it, as the batch reader
+// doesn't report how much it decompressed.
+func compressionOf(rollupCfg *rollup.Config, ch reassemble.ChannelWithMetadata) *channelCompression {
+	if !ch.IsReady || len(ch.ComprAlgos) == 0 {
+		return nil
+	}
+	data, ok := channelData(ch.Frames)
+	if !ok {
+		return nil
+	}
+	last := ch.Frames[len(ch.Frames)-1]
+	spec := rollup.NewChainSpec(rollupCfg)
+	// A channel that fails to decompress part way was still measured up to the failure, which is what the batch
+	// reader read from it.
+	size, _ := decompressedSize(data, spec.MaxRLPBytesPerChannel(last.Timestamp), rollupCfg.IsFjord(last.Timestamp))
+	c := &channelCompression{Algo: ch.ComprAlgos[0], CompressedSize: uint64(len(data)), DecompressedSize: size}
+	decodedChannels.WithLabelValues(string(c.Algo)).Inc()
+	decodedChannelBytes.WithLabelValues(string(c.Algo), "compressed").Add(float64(c.CompressedSize))
+	decodedChannelBytes.WithLabelValues(string(c.Algo), "decompressed").Add(float64(c.DecompressedSize))
+	return c
+}
+
+// channelData returns the data of a complete channel: the first copy of each frame, in frame order, up to the last
+// frame. Returns false if the last frame, or any frame before it, is missing.
+func channelData(frames []reassemble.FrameWithMetadata) ([]byte, bool) {
+	byNumber := make(map[uint16]derive.Frame, len(frames))
+	end := -1
+	for _, f := range frames {
+		if _, ok := byNumber[f.Frame.FrameNumber]; ok {
+			continue
+		}
+		byNumber[f.Frame.FrameNumber] = f.Frame
+		if f.Frame.IsLast && end < 0 {
+			end = int(f.Frame.FrameNumber)
+		}
+	}
+	if end < 0 {
+		return nil, false
+	}
+	var data []byte
+	for n := 0; n <= end; n++ {
+		frame, ok := byNumber[uint16(n)]
+		if !ok {
+			return nil, false
+		}
+		data = append(data, frame.Data...)
+	}
+	return data, true
+}
+
+// compressionStatsIn returns the compression statistics of the decoded channels with a batch in the given L2 block
+// range.
+func compressionStatsIn(channels []*cachedChannel, startBlock, endBlock uint64) CompressionStats {
+	stats := make(CompressionStats)
+	for _, ch := range channels {
+		if ch.Compression == nil || !channelOverlaps(ch, startBlock, endBlock) {
+			continue
+		}
+		s, ok := stats[ch.Compression.Algo]
+		if !ok {
+			s = &AlgoStats{}
+			stats[ch.Compression.Algo] = s
+		}
+		s.Channels++
+		s.CompressedBytes += ch.Compression.CompressedSize
+		s.DecompressedBytes += ch.Compression.DecompressedSize
+	}
+	return stats
+}
+
+// channelOverlaps returns whether any batch of the channel is in the given L2 block range.
+func channelOverlaps(ch *cachedChannel, startBlock, endBlock uint64) bool {
+	for _, b := range ch.Batches {
+		end := b.StartBlock
+		if b.IsSpan {
+			end = b.StartBlock + b.BlockCount - 1
+		}
+		if b.StartBlock <= endBlock && end >= startBlock {
+			return true
+		}
+	}
+	return false
+}
+
+// Algos returns the compression algorithms in the stats, sorted by name.
+func (s CompressionStats) Algos() []derive.CompressionAlgo {
+	algos := make([]derive.CompressionAlgo, 0, len(s))
+	for algo := range s {
+		algos = append(algos, algo)
+	}
+	sort.Slice(algos, func(i, j int) bool { return algos[i] < algos[j] })
+	return algos
+}
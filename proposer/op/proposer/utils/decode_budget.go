@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	// The default memory budget for decoding channels at the same time, in bytes.
+	defaultDecodeMemoryBudget = 512 << 20
+	// The assumed ratio between the decompressed and compressed size of a channel. Brotli channels of mostly empty
+	// blocks compress well beyond this, but the estimate is capped by the maximum RLP size of a channel.
+	channelExpansionRatio = 10
+)
+
+// decodeBudget limits the channels decoded at the same time by the estimated memory needed to decompress them.
+type decodeBudget struct {
+	sem   *semaphore.Weighted
+	limit int64
+}
+
+// newDecodeBudget creates a budget of the given number of bytes. If limit is 0, the default budget is used.
+func newDecodeBudget(limit uint64) *decodeBudget {
+	if limit == 0 {
+		limit = defaultDecodeMemoryBudget
+	}
+	return &decodeBudget{sem: semaphore.NewWeighted(int64(limit)), limit: int64(limit)}
+}
+
+// acquire blocks until size bytes of the budget are available, and returns a function that releases them. A channel
+// larger than the whole budget acquires the whole budget, so that it's decoded on its own.
+func (b *decodeBudget) acquire(size int64) func() {
+	size = min(max(size, 1), b.limit)
+	// Acquire only fails if the context is done, which never happens for the background context.
+	_ = b.sem.Acquire(context.Background(), size)
+	return func() { b.sem.Release(size) }
+}
+
+// estimateExpandedSize estimates the memory needed to decompress and decode the channel with the given frames.
+func estimateExpandedSize(rollupCfg *rollup.Config, frames []reassemble.FrameWithMetadata) int64 {
+	var compressed int64
+	for _, f := range frames {
+		compressed += int64(len(f.Frame.Data))
+	}
+	spec := rollup.NewChainSpec(rollupCfg)
+	maxSize := int64(spec.MaxRLPBytesPerChannel(frames[len(frames)-1].Timestamp))
+	// The compressed frame data is held in memory alongside the decompressed batches.
+	return compressed + min(compressed*channelExpansionRatio, maxSize)
+}
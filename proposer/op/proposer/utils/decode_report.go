@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// DecodeErrorKind is the stage of decoding a channel that failed.
+type DecodeErrorKind string
+
+const (
+	DecodeErrorInvalidFrame     DecodeErrorKind = "invalid frame"
+	DecodeErrorChannelTimeout   DecodeErrorKind = "channel timeout"
+	DecodeErrorChannelNotReady  DecodeErrorKind = "channel not ready"
+	DecodeErrorBatchReader      DecodeErrorKind = "batch reader"
+	DecodeErrorInvalidBatch     DecodeErrorKind = "invalid batch"
+	DecodeErrorUnknownBatchType DecodeErrorKind = "unknown batch type"
+)
+
+// DecodeError is an error encountered while decoding a channel. The batch index is the index of the batch in the
+// channel, or -1 if the error isn't specific to a batch.
+type DecodeError struct {
+	Kind       DecodeErrorKind
+	BatchIndex int
+	Err        error
+}
+
+func (e *DecodeError) Error() string {
+	if e.BatchIndex < 0 {
+		return fmt.Sprintf("%s: %v", e.Kind, e.Err)
+	}
+	return fmt.Sprintf("%s at batch %d: %v", e.Kind, e.BatchIndex, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ChannelReport collects the errors encountered while decoding a channel. Batches that failed to decode are left out
+// of the decoded channel, and reported here instead.
+type ChannelReport struct {
+	ChannelID derive.ChannelID
+	Errors    []*DecodeError
+}
+
+func (r *ChannelReport) add(kind DecodeErrorKind, batchIndex int, err error) {
+	r.Errors = append(r.Errors, &DecodeError{Kind: kind, BatchIndex: batchIndex, Err: err})
+}
+
+// OK returns whether the channel was decoded without errors.
+func (r *ChannelReport) OK() bool {
+	return len(r.Errors) == 0
+}
+
+func (r *ChannelReport) String() string {
+	errs := make([]string, len(r.Errors))
+	for i, err := range r.Errors {
+		errs[i] = err.Error()
+	}
+	return fmt.Sprintf("channel %v: %s", r.ChannelID.String(), strings.Join(errs, "; "))
+}
+
+// DecodeReportError is returned in strict decode mode when any channel had errors while decoding.
+type DecodeReportError struct {
+	Reports []*ChannelReport
+}
+
+func (e *DecodeReportError) Error() string {
+	lines := make([]string, len(e.Reports))
+	for i, report := range e.Reports {
+		lines[i] = report.String()
+	}
+	return fmt.Sprintf("%d channels failed to decode:\n  %s", len(e.Reports), strings.Join(lines, "\n  "))
+}
+
+// newDecodeReportError returns a DecodeReportError with the reports of channels that had errors, or nil if there are
+// none. Channels that are only missing frames are left out, as the frames may be posted after the fetched L1 range.
+func newDecodeReportError(reports []*ChannelReport) error {
+	var failed []*ChannelReport
+	for _, report := range reports {
+		for _, err := range report.Errors {
+			if err.Kind != DecodeErrorChannelNotReady {
+				failed = append(failed, report)
+				break
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &DecodeReportError{Reports: failed}
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// The client name the proposer connects to the event bus brokers with.
+const eventBusClientName = "op-succinct-proposer"
+
+// EventPublisher publishes messages to a topic of an event bus.
+type EventPublisher interface {
+	// Publish publishes a message with the given key, which identifies the kind of message, and returns once the broker
+	// has accepted it.
+	Publish(ctx context.Context, key string, msg []byte) error
+	Close() error
+}
+
+// NewEventPublisher returns a publisher for the broker at the given URL:
+//
+//   - nats://[user:pass@]host:port publishes to a NATS server. Messages are published on the subject <topic>.<key>, so
+//     that subscribers can select the kinds of messages they're interested in.
+//   - kafka://host:port[,host:port...] produces to the Kafka topic on the given seed brokers, with the key as the record
+//     key.
+//
+// The clients connect in the background, and reconnect and retry publishing on their own.
+func NewEventPublisher(brokerURL, topic string) (EventPublisher, error) {
+	u, err := url.Parse(brokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event bus URL: %w", err)
+	}
+	switch u.Scheme {
+	case "nats":
+		conn, err := nats.Connect(brokerURL,
+			nats.Name(eventBusClientName),
+			// The broker may not be up yet, or may restart, which mustn't stop the proposer.
+			nats.RetryOnFailedConnect(true),
+			nats.MaxReconnects(-1),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to NATS server: %w", err)
+		}
+		return &natsPublisher{conn: conn, subject: topic}, nil
+	case "kafka":
+		client, err := kgo.NewClient(
+			kgo.SeedBrokers(strings.Split(u.Host, ",")...),
+			kgo.DefaultProduceTopic(topic),
+			kgo.ClientID(eventBusClientName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Kafka client: %w", err)
+		}
+		return &kafkaPublisher{client: client}, nil
+	default:
+		return nil, fmt.Errorf("unsupported event bus scheme %q, expected nats or kafka", u.Scheme)
+	}
+}
+
+// natsPublisher publishes to a NATS server.
+type natsPublisher struct {
+	conn    *nats.Conn
+	subject string
+}
+
+// Publish publishes a message, and waits for the server to process it. While the client is reconnecting, messages are
+// buffered and sent once it's reconnected.
+func (p *natsPublisher) Publish(ctx context.Context, key string, msg []byte) error {
+	if err := p.conn.Publish(p.subject+"."+key, msg); err != nil {
+		return fmt.Errorf("failed to publish to NATS server: %w", err)
+	}
+	if err := p.conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to flush NATS connection: %w", err)
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	// Drain sends the buffered messages before closing the connection.
+	return p.conn.Drain()
+}
+
+// kafkaPublisher produces to a Kafka topic.
+type kafkaPublisher struct {
+	client *kgo.Client
+}
+
+// Publish produces a record, and waits for the brokers to acknowledge it. Failed produce requests are retried until
+// the context is done.
+func (p *kafkaPublisher) Publish(ctx context.Context, key string, msg []byte) error {
+	if err := p.client.ProduceSync(ctx, &kgo.Record{Key: []byte(key), Value: msg}).FirstErr(); err != nil {
+		return fmt.Errorf("failed to produce to Kafka: %w", err)
+	}
+	return nil
+}
+
+func (p *kafkaPublisher) Close() error {
+	p.client.Close()
+	return nil
+}
@@ -0,0 +1,234 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// The default number of L1 blocks fetched at the same time.
+	defaultL1FetchConcurrency = 10
+	// The default number of slots whose blob sidecars are fetched from the beacon node at the same time.
+	defaultBlobFetchConcurrency = 4
+	// The number of attempts to fetch the blob sidecars of a slot.
+	blobFetchAttempts = 5
+)
+
+// fetchBatches fetches the batcher transactions sent to the batch inbox in the L1 block range [start, end), and
+// stores them in config.DataDir in the format of the op-node batch decoder. Unlike fetch.Batches, the blob sidecars of
+// each slot are fetched with their own concurrency limit, and retried, as the beacon node is usually the bottleneck.
+//
+// If config.AutoBatchSender is set, the transactions are checked against the batcher in effect for each L1 block,
+// instead of config.BatchSender.
+func fetchBatches(ctx context.Context, config BatchDecoderConfig, rollupCfg *rollup.Config, start, end uint64) (totalValid, totalInvalid uint64, err error) {
+	if err := os.MkdirAll(config.DataDir, 0750); err != nil {
+		return 0, 0, err
+	}
+	chainID := rollupCfg.L1ChainID
+	signer := types.LatestSignerForChainID(chainID)
+
+	var batchers *batcherSchedule
+	if config.AutoBatchSender {
+		if batchers, err = loadBatcherSchedule(ctx, config, rollupCfg, end); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	l1Concurrency := config.L1FetchConcurrency
+	if l1Concurrency == 0 {
+		l1Concurrency = defaultL1FetchConcurrency
+	}
+	blobConcurrency := config.BlobFetchConcurrency
+	if blobConcurrency == 0 {
+		blobConcurrency = defaultBlobFetchConcurrency
+	}
+	blobSem := make(chan struct{}, blobConcurrency)
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(int(l1Concurrency))
+	for number := start; number < end; number++ {
+		if ctx.Err() != nil {
+			break
+		}
+		number := number
+		batcher := config.BatchSender
+		if batchers != nil {
+			batcher = batchers.batcherAt(number)
+		}
+		g.Go(func() error {
+			valid, invalid, err := fetchBatchesInBlock(ctx, config, signer, chainID, batcher, blobSem, number)
+			if err != nil {
+				return fmt.Errorf("failed to fetch batches in L1 block %d: %w", number, err)
+			}
+			atomic.AddUint64(&totalValid, valid)
+			atomic.AddUint64(&totalInvalid, invalid)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, 0, err
+	}
+	return totalValid, totalInvalid, nil
+}
+
+// fetchBatchesInBlock fetches the batcher transactions in an L1 block, and the blobs they reference. Only transactions
+// sent by the given batcher are valid.
+func fetchBatchesInBlock(ctx context.Context, config BatchDecoderConfig, signer types.Signer, chainID *big.Int, batcher common.Address, blobSem chan struct{}, number uint64) (valid, invalid uint64, err error) {
+	blockCtx, cancel := rpcContext(ctx, config.RPCTimeout)
+	defer cancel()
+	block, err := config.L1RPC.BlockByNumber(blockCtx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Collect the batcher transactions, and the blobs they reference.
+	var (
+		txs       []*fetch.TransactionWithMetadata
+		blobTxs   []int
+		blobCount []int
+		hashes    []eth.IndexedBlobHash
+		blobIndex uint64
+	)
+	inbox := config.inboxFilter()
+	for i, tx := range block.Transactions() {
+		if !inbox.matches(tx) {
+			blobIndex += uint64(len(tx.BlobHashes()))
+			continue
+		}
+		sender, err := signer.Sender(tx)
+		if err != nil {
+			return 0, 0, err
+		}
+		txm := &fetch.TransactionWithMetadata{
+			Tx:          tx,
+			Sender:      sender,
+			ValidSender: sender == batcher,
+			TxIndex:     uint64(i),
+			BlockNumber: block.NumberU64(),
+			BlockHash:   block.Hash(),
+			BlockTime:   block.Time(),
+			ChainId:     chainID.Uint64(),
+			InboxAddr:   *tx.To(),
+		}
+		if !txm.ValidSender {
+			fmt.Printf("Found a transaction (%s) from an invalid sender (%s)\n", tx.Hash().String(), sender.String())
+		}
+		// Only the blobs of valid batcher transactions are fetched, as the frames of the others are never loaded. The
+		// beacon client is only required once such a transaction is found, so that calldata-only ranges decode without
+		// one.
+		if tx.Type() == types.BlobTxType && !txm.ValidSender {
+			blobIndex += uint64(len(tx.BlobHashes()))
+		} else if tx.Type() == types.BlobTxType && config.L1Beacon == nil {
+			return 0, 0, fmt.Errorf("%w: transaction %s posted its batch in blobs", ErrBeaconRequired, tx.Hash())
+		} else if tx.Type() == types.BlobTxType {
+			blobTxs = append(blobTxs, len(txs))
+			blobCount = append(blobCount, len(tx.BlobHashes()))
+			for _, h := range tx.BlobHashes() {
+				hashes = append(hashes, eth.IndexedBlobHash{Index: blobIndex, Hash: h})
+				blobIndex++
+			}
+		}
+		txs = append(txs, txm)
+	}
+
+	// Fetch the blobs of all batcher transactions in the block with a single beacon request.
+	datas := make([][]hexutil.Bytes, len(txs))
+	for i, txm := range txs {
+		if txm.Tx.Type() != types.BlobTxType {
+			datas[i] = []hexutil.Bytes{txm.Tx.Data()}
+		}
+	}
+	if len(hashes) > 0 {
+		blobs, err := fetchBlobs(ctx, config, blobSem, block, hashes)
+		if err != nil {
+			return 0, 0, err
+		}
+		offset := 0
+		for j, txIdx := range blobTxs {
+			for _, blob := range blobs[offset : offset+blobCount[j]] {
+				data, err := blob.ToData()
+				if err != nil {
+					return 0, 0, fmt.Errorf("failed to parse blob: %w", err)
+				}
+				datas[txIdx] = append(datas[txIdx], data)
+			}
+			offset += blobCount[j]
+		}
+	}
+
+	for i, txm := range txs {
+		validBatch := true
+		for _, data := range datas[i] {
+			frames, err := derive.ParseFrames(data)
+			if err != nil {
+				fmt.Printf("Found a transaction (%s) with invalid data: %v\n", txm.Tx.Hash().String(), err)
+				validBatch = false
+				txm.FrameErrs = append(txm.FrameErrs, err.Error())
+				txm.ValidFrames = append(txm.ValidFrames, false)
+				continue
+			}
+			txm.Frames = append(txm.Frames, frames...)
+			txm.FrameErrs = append(txm.FrameErrs, "")
+			txm.ValidFrames = append(txm.ValidFrames, true)
+		}
+		if txm.ValidSender && validBatch {
+			valid++
+		} else {
+			invalid++
+		}
+
+		out, err := json.Marshal(txm)
+		if err != nil {
+			return 0, 0, err
+		}
+		filename := filepath.Join(config.DataDir, fmt.Sprintf("%s.json", txm.Tx.Hash().String()))
+		if err := os.WriteFile(filename, out, 0640); err != nil {
+			return 0, 0, err
+		}
+	}
+	return valid, invalid, nil
+}
+
+// fetchBlobs fetches the blobs of a slot from the beacon node, retrying with backoff if the request fails. At most
+// cap(blobSem) slots are fetched at the same time.
+func fetchBlobs(ctx context.Context, config BatchDecoderConfig, blobSem chan struct{}, block *types.Block, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	select {
+	case blobSem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-blobSem }()
+
+	ref := eth.L1BlockRef{
+		Hash:       block.Hash(),
+		Number:     block.NumberU64(),
+		ParentHash: block.ParentHash(),
+		Time:       block.Time(),
+	}
+	return retry.Do(ctx, blobFetchAttempts, retry.Exponential(), func() ([]*eth.Blob, error) {
+		reqCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		defer cancel()
+		blobs, err := config.L1Beacon.GetBlobs(reqCtx, ref, hashes)
+		if err != nil {
+			fmt.Printf("Failed to fetch blobs for L1 block %d, retrying: %v\n", ref.Number, err)
+			return nil, err
+		}
+		return blobs, nil
+	})
+}
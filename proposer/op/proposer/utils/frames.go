@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The metadata of a stored batcher transaction that determines the order of its frames.
+type batcherTxFile struct {
+	path        string
+	blockNumber uint64
+	txIndex     uint64
+}
+
+// forEachChannel groups the frames of the batcher transactions stored in the directory by channel, and calls fn with
+// the frames of each channel. Unlike reassemble.LoadFrames, the frames aren't all loaded into memory at once: the
+// transactions are read in L1 order, and a channel is passed to fn (and its frames released) as soon as all of its
+// frames are read. Channels that are never completed are passed to fn at the end. If fn returns false, the iteration
+// stops.
+//
+// Like reassemble.LoadFrames, only transactions sent to the inbox by a valid sender are included.
+func forEachChannel(directory string, inbox common.Address, fn func(id derive.ChannelID, frames []reassemble.FrameWithMetadata) bool) error {
+	files, err := listBatcherTxFiles(directory, inbox)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[derive.ChannelID][]reassemble.FrameWithMetadata)
+	// The channels that were already passed to fn. Frames for these channels that arrive later are dropped.
+	emitted := make(map[derive.ChannelID]bool)
+	// The order in which channels were first seen, so that incomplete channels are emitted deterministically.
+	var order []derive.ChannelID
+
+	for _, file := range files {
+		txm, err := readBatcherTx(file.path)
+		if err != nil {
+			return err
+		}
+		for _, frame := range txm.Frames {
+			id := frame.ID
+			if emitted[id] {
+				fmt.Printf("Dropping frame %d of channel %v, which was already complete\n", frame.FrameNumber, id.String())
+				continue
+			}
+			if _, ok := pending[id]; !ok {
+				order = append(order, id)
+			}
+			pending[id] = append(pending[id], reassemble.FrameWithMetadata{
+				TxHash:         txm.Tx.Hash(),
+				InclusionBlock: txm.BlockNumber,
+				BlockHash:      txm.BlockHash,
+				Timestamp:      txm.BlockTime,
+				Frame:          frame,
+			})
+			if channelComplete(pending[id]) {
+				frames := pending[id]
+				delete(pending, id)
+				emitted[id] = true
+				if !fn(id, frames) {
+					return nil
+				}
+			}
+		}
+	}
+
+	for _, id := range order {
+		if frames, ok := pending[id]; ok {
+			if !fn(id, frames) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// listBatcherTxFiles reads the ordering metadata of the batcher transactions stored in the directory, sorted by block
+// number and transaction index to match the order they are processed in derivation.
+func listBatcherTxFiles(directory string, inbox common.Address) ([]batcherTxFile, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read batch directory: %w", err)
+	}
+
+	var files []batcherTxFile
+	for _, entry := range entries {
+		path := filepath.Join(directory, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		// Only decode the metadata, so that the frames of all transactions aren't held in memory.
+		var meta struct {
+			TxIndex     uint64         `json:"tx_index"`
+			InboxAddr   common.Address `json:"inbox_address"`
+			BlockNumber uint64         `json:"block_number"`
+			ValidSender bool           `json:"valid_sender"`
+		}
+		if err := json.Unmarshal(data, &meta); err != nil {
+			return nil, fmt.Errorf("failed to decode transaction %s: %w", entry.Name(), err)
+		}
+		if (inbox == common.Address{} || meta.InboxAddr == inbox) && meta.ValidSender {
+			files = append(files, batcherTxFile{path: path, blockNumber: meta.BlockNumber, txIndex: meta.TxIndex})
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].blockNumber == files[j].blockNumber {
+			return files[i].txIndex < files[j].txIndex
+		}
+		return files[i].blockNumber < files[j].blockNumber
+	})
+	return files, nil
+}
+
+// Read a stored batcher transaction.
+func readBatcherTx(path string) (*fetch.TransactionWithMetadata, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var txm fetch.TransactionWithMetadata
+	if err := json.Unmarshal(data, &txm); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", filepath.Base(path), err)
+	}
+	return &txm, nil
+}
+
+// channelComplete returns whether the frames include the last frame of the channel, and every frame before it.
+func channelComplete(frames []reassemble.FrameWithMetadata) bool {
+	last := -1
+	seen := make(map[uint16]bool, len(frames))
+	for _, f := range frames {
+		seen[f.Frame.FrameNumber] = true
+		if f.Frame.IsLast {
+			last = int(f.Frame.FrameNumber)
+		}
+	}
+	if last < 0 {
+		return false
+	}
+	for i := 0; i <= last; i++ {
+		if !seen[uint16(i)] {
+			return false
+		}
+	}
+	return true
+}
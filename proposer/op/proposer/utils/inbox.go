@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// InboxFilter selects the L1 transactions that carry the batches of a chain, for chains whose batcher transactions
+// aren't all sent to the batch inbox address of the rollup config. A transaction is a batcher transaction if it's sent
+// to one of the addresses, and Match, if set, accepts it.
+type InboxFilter struct {
+	Addresses []common.Address
+	// Optional predicate on the transactions sent to one of the addresses, for chains whose batcher transactions can't
+	// be told apart by their recipient alone.
+	Match func(tx *types.Transaction) bool
+}
+
+// matches returns whether the transaction is a batcher transaction.
+func (f *InboxFilter) matches(tx *types.Transaction) bool {
+	if tx.To() == nil || !slices.Contains(f.Addresses, *tx.To()) {
+		return false
+	}
+	return f.Match == nil || f.Match(tx)
+}
+
+// inboxFilter returns the inbox filter of the config, which defaults to the transactions sent to the batch inbox
+// address.
+func (c BatchDecoderConfig) inboxFilter() *InboxFilter {
+	if c.InboxFilter != nil {
+		return c.InboxFilter
+	}
+	return &InboxFilter{Addresses: []common.Address{c.BatchInboxAddress}}
+}
+
+// frameInbox returns the inbox address that the stored batcher transactions are filtered by when loading their
+// frames. With an inbox filter of several addresses, the transactions were already filtered when they were fetched,
+// so the zero address, which loads all stored transactions, is returned.
+func (c BatchDecoderConfig) frameInbox() common.Address {
+	if f := c.inboxFilter(); len(f.Addresses) == 1 {
+		return f.Addresses[0]
+	}
+	return common.Address{}
+}
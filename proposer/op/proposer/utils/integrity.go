@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/andybalholm/brotli"
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// ChannelIntegrity is the integrity report of a channel: how its frames were posted, and whether its data
+// decompresses within the limits of the derivation pipeline.
+type ChannelIntegrity struct {
+	ChannelID string `json:"channel_id"`
+	// The L1 blocks that included the first and last frames of the channel.
+	OpenL1Block uint64 `json:"open_l1_block"`
+	LastL1Block uint64 `json:"last_l1_block"`
+	Frames      int    `json:"frames"`
+	// Whether the frame marked as last was posted.
+	HasLastFrame bool `json:"has_last_frame"`
+	// The numbers of the frames before the last frame, or before the highest frame posted if the last frame is
+	// missing, that were never posted.
+	MissingFrames []uint16 `json:"missing_frames,omitempty"`
+	// The numbers of the frames posted more than once. Derivation drops the later copies.
+	DuplicateFrames []uint16 `json:"duplicate_frames,omitempty"`
+	// The numbers of the frames posted after a frame with a higher number. Derivation accepts them, but they usually
+	// point to a batcher resubmitting frames.
+	OutOfOrderFrames []uint16 `json:"out_of_order_frames,omitempty"`
+	// The total size of the frame data, and the size of the decompressed channel data, which derivation limits to
+	// MaxRLPBytes. The decompressed size is only known for complete channels.
+	CompressedSize   uint64 `json:"compressed_size"`
+	DecompressedSize uint64 `json:"decompressed_size,omitempty"`
+	MaxRLPBytes      uint64 `json:"max_rlp_bytes"`
+	Oversized        bool   `json:"oversized"`
+	// The error decompressing the channel data, if any.
+	DecompressionError string `json:"decompression_error,omitempty"`
+	// The number of batches decoded from the channel, and the errors of decoding it.
+	Batches      int      `json:"batches"`
+	DecodeErrors []string `json:"decode_errors,omitempty"`
+}
+
+// OK returns whether the channel was posted and decoded without any integrity issues.
+func (c *ChannelIntegrity) OK() bool {
+	return c.HasLastFrame && len(c.MissingFrames) == 0 && len(c.DuplicateFrames) == 0 && len(c.OutOfOrderFrames) == 0 &&
+		!c.Oversized && c.DecompressionError == "" && len(c.DecodeErrors) == 0
+}
+
+// GetChannelIntegrityReports fetches the batches posted for the L2 block range [config.L2StartBlock,
+// config.L2EndBlock], and returns the integrity report of each channel posted in the L1 search range, in the order the
+// channels were read.
+func GetChannelIntegrityReports(ctx context.Context, config BatchDecoderConfig) ([]*ChannelIntegrity, error) {
+	if config.L2EndBlock < config.L2StartBlock {
+		return nil, fmt.Errorf("invalid L2 block range [%d, %d]", config.L2StartBlock, config.L2EndBlock)
+	}
+	rollupCfg, err := fetchBatchesForL2Ranges(ctx, &config, []SpanBatchRange{{Start: config.L2StartBlock, End: config.L2EndBlock}})
+	if err != nil {
+		return nil, err
+	}
+	reassembleConfig := newReassembleConfig(config)
+
+	var reports []*ChannelIntegrity
+	err = forEachChannel(config.DataDir, config.frameInbox(), func(id derive.ChannelID, frames []reassemble.FrameWithMetadata) bool {
+		reports = append(reports, channelIntegrity(reassembleConfig, rollupCfg, id, frames))
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load frames: %w", err)
+	}
+	return reports, nil
+}
+
+// channelIntegrity checks the frames of a channel, in the order they were posted, and decodes it.
+func channelIntegrity(cfg reassemble.Config, rollupCfg *rollup.Config, id derive.ChannelID, frames []reassemble.FrameWithMetadata) *ChannelIntegrity {
+	spec := rollup.NewChainSpec(rollupCfg)
+	last := frames[len(frames)-1]
+	report := &ChannelIntegrity{
+		ChannelID:   id.String(),
+		OpenL1Block: frames[0].InclusionBlock,
+		LastL1Block: last.InclusionBlock,
+		Frames:      len(frames),
+		MaxRLPBytes: spec.MaxRLPBytesPerChannel(last.Timestamp),
+	}
+
+	// The frame numbers seen, as derivation keeps the first copy of each frame.
+	seen := make(map[uint16]bool, len(frames))
+	var highest uint16
+	end := -1
+	for _, f := range frames {
+		n := f.Frame.FrameNumber
+		report.CompressedSize += uint64(len(f.Frame.Data))
+		if seen[n] {
+			report.DuplicateFrames = append(report.DuplicateFrames, n)
+			continue
+		}
+		if n < highest {
+			report.OutOfOrderFrames = append(report.OutOfOrderFrames, n)
+		}
+		seen[n] = true
+		highest = max(highest, n)
+		if f.Frame.IsLast && end < 0 {
+			report.HasLastFrame = true
+			end = int(n)
+		}
+	}
+	if end < 0 {
+		end = int(highest)
+	}
+	for n := 0; n <= end; n++ {
+		if !seen[uint16(n)] {
+			report.MissingFrames = append(report.MissingFrames, uint16(n))
+		}
+	}
+
+	if data, ok := channelData(frames); ok {
+		size, err := decompressedSize(data, report.MaxRLPBytes, rollupCfg.IsFjord(last.Timestamp))
+		report.DecompressedSize = size
+		report.Oversized = size > report.MaxRLPBytes
+		if err != nil {
+			report.DecompressionError = err.Error()
+		}
+	}
+
+	ch, decodeReport := processFrames(cfg, rollupCfg, id, frames)
+	report.Batches = len(ch.Batches)
+	for _, err := range decodeReport.Errors {
+		report.DecodeErrors = append(report.DecodeErrors, err.Error())
+	}
+	slices.Sort(report.DuplicateFrames)
+	report.DuplicateFrames = slices.Compact(report.DuplicateFrames)
+	return report
+}
+
+// decompressedSize decompresses the channel data like derive.BatchReader, and returns its size. Decompression stops
+// after limit+1 bytes, so that an oversized channel is detected without decompressing all of it.
+func decompressedSize(data []byte, limit uint64, isFjord bool) (uint64, error) {
+	if len(data) == 0 {
+		return 0, errors.New("empty channel data")
+	}
+	var r io.Reader
+	switch {
+	case data[0]&0x0F == derive.ZlibCM8 || data[0]&0x0F == derive.ZlibCM15:
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return 0, err
+		}
+		r = zr
+	case data[0] == derive.ChannelVersionBrotli:
+		if !isFjord {
+			return 0, errors.New("brotli compressed channel before Fjord")
+		}
+		r = brotli.NewReader(bytes.NewReader(data[1:]))
+	default:
+		return 0, fmt.Errorf("unknown compression type byte %d", data[0])
+	}
+	n, err := io.Copy(io.Discard, io.LimitReader(r, int64(limit)+1))
+	return uint64(n), err
+}
@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// BlockLocation is where the batch of an L2 block was posted to L1: the batch that contains the block, the channel
+// that carried the batch, the frames of the channel, and the batcher transactions that carried the frames.
+type BlockLocation struct {
+	Block uint64 `json:"block"`
+	// The L2 block range of the batch that contains the block, and its index in the channel.
+	Batch      SpanBatchRange `json:"batch"`
+	BatchIndex int            `json:"batch_index"`
+	// Whether the batch is a span batch. Span batch ranges can't be computed for singular batches.
+	IsSpan    bool   `json:"is_span"`
+	ChannelID string `json:"channel_id"`
+	// Whether the channel has all of its frames.
+	ChannelComplete bool `json:"channel_complete"`
+	// The errors of decoding the channel, if any. The batch of the block was still decoded.
+	DecodeErrors []string `json:"decode_errors,omitempty"`
+	// The frames of the channel, in the order they were posted.
+	Frames []FrameLocation `json:"frames"`
+	// The batcher transactions that carried the frames, in L1 order.
+	Transactions []BatcherTxLocation `json:"transactions"`
+}
+
+// FrameLocation is a frame of a channel, and the batcher transaction that carried it.
+type FrameLocation struct {
+	Number uint16 `json:"number"`
+	// The size of the frame data in bytes.
+	Size          int         `json:"size"`
+	IsLast        bool        `json:"is_last"`
+	TxHash        common.Hash `json:"tx_hash"`
+	L1BlockNumber uint64      `json:"l1_block_number"`
+}
+
+// BatcherTxLocation is a batcher transaction that carried frames of a channel.
+type BatcherTxLocation struct {
+	Hash          common.Hash    `json:"hash"`
+	L1BlockNumber uint64         `json:"l1_block_number"`
+	L1BlockHash   common.Hash    `json:"l1_block_hash"`
+	L1BlockTime   uint64         `json:"l1_block_time"`
+	TxIndex       uint64         `json:"tx_index"`
+	Sender        common.Address `json:"sender"`
+	// The versioned hashes of the blobs of the transaction, for batches posted in blobs. Empty for batches posted in
+	// calldata.
+	BlobHashes []common.Hash `json:"blob_hashes,omitempty"`
+	// The numbers of the frames of the channel the transaction carried.
+	Frames []uint16 `json:"frames"`
+}
+
+// LocateL2Block fetches the batches posted around the given L2 block, and returns where its batch was posted: the
+// channel that carried it, the frames of the channel and the batcher transactions that carried the frames. As in
+// derivation, the first channel with a batch containing the block is returned.
+//
+// If no decoded batch contains the block, ErrNoSpanBatchFound is returned, along with the reports of the channels
+// that had errors while decoding, which usually explain why.
+func LocateL2Block(ctx context.Context, config BatchDecoderConfig, block uint64) (*BlockLocation, []*ChannelReport, error) {
+	rollupCfg, err := fetchBatchesForL2Ranges(ctx, &config, []SpanBatchRange{{Start: block, End: block}})
+	if err != nil {
+		return nil, nil, err
+	}
+	reassembleConfig := newReassembleConfig(config)
+	cache := openChannelCache(config, rollupCfg)
+
+	var (
+		loc     *BlockLocation
+		reports []*ChannelReport
+	)
+	// Channels are decoded one at a time, as the search stops at the first channel containing the block. Channels in
+	// the cache are only decoded if they contain the block, to report their frames.
+	err = forEachChannel(config.DataDir, config.frameInbox(), func(id derive.ChannelID, frames []reassemble.FrameWithMetadata) bool {
+		if batches, ok := cache.get(id); ok {
+			if _, _, found := batchContaining(batches, block); !found {
+				return true
+			}
+		}
+		ch, report := processFrames(reassembleConfig, rollupCfg, id, frames)
+		if !report.OK() {
+			reports = append(reports, report)
+		}
+		summary := summarizeBatches(rollupCfg, ch.Batches)
+		idx, batch, found := batchContaining(summary, block)
+		if !found {
+			return true
+		}
+		loc = &BlockLocation{
+			Block:           block,
+			Batch:           batch,
+			BatchIndex:      idx,
+			IsSpan:          summary.Batches[idx].IsSpan,
+			ChannelID:       id.String(),
+			ChannelComplete: ch.IsReady,
+			Frames:          make([]FrameLocation, len(frames)),
+		}
+		for _, err := range report.Errors {
+			loc.DecodeErrors = append(loc.DecodeErrors, err.Error())
+		}
+		for i, frame := range frames {
+			loc.Frames[i] = FrameLocation{
+				Number:        frame.Frame.FrameNumber,
+				Size:          len(frame.Frame.Data),
+				IsLast:        frame.Frame.IsLast,
+				TxHash:        frame.TxHash,
+				L1BlockNumber: frame.InclusionBlock,
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, reports, fmt.Errorf("failed to load frames: %w", err)
+	}
+	if loc == nil {
+		return nil, reports, fmt.Errorf("%w: no batch posted in the L1 search range contains block %d", ErrNoSpanBatchFound, block)
+	}
+
+	if loc.Transactions, err = batcherTxLocations(config, loc.Frames); err != nil {
+		return nil, reports, err
+	}
+	return loc, reports, nil
+}
+
+// batchContaining returns the index and block range of the first batch of the channel that contains the block.
+func batchContaining(ch *cachedChannel, block uint64) (int, SpanBatchRange, bool) {
+	for idx, b := range ch.Batches {
+		// Singular batches contain a single block.
+		end := b.StartBlock
+		if b.IsSpan {
+			end = b.StartBlock + b.BlockCount - 1
+		}
+		if b.StartBlock <= block && block <= end {
+			return idx, SpanBatchRange{Start: b.StartBlock, End: end}, true
+		}
+	}
+	return 0, SpanBatchRange{}, false
+}
+
+// batcherTxLocations reads the stored batcher transactions that carried the frames.
+func batcherTxLocations(config BatchDecoderConfig, frames []FrameLocation) ([]BatcherTxLocation, error) {
+	txFrames := make(map[common.Hash][]uint16)
+	l1Blocks := make(map[uint64]bool)
+	for _, frame := range frames {
+		txFrames[frame.TxHash] = append(txFrames[frame.TxHash], frame.Number)
+		l1Blocks[frame.L1BlockNumber] = true
+	}
+
+	files, err := listBatcherTxFiles(config.DataDir, config.frameInbox())
+	if err != nil {
+		return nil, err
+	}
+	var txs []BatcherTxLocation
+	for _, file := range files {
+		if !l1Blocks[file.blockNumber] {
+			continue
+		}
+		txm, err := readBatcherTx(file.path)
+		if err != nil {
+			return nil, err
+		}
+		hash := txm.Tx.Hash()
+		if _, ok := txFrames[hash]; !ok {
+			continue
+		}
+		tx := BatcherTxLocation{
+			Hash:          hash,
+			L1BlockNumber: txm.BlockNumber,
+			L1BlockHash:   txm.BlockHash,
+			L1BlockTime:   txm.BlockTime,
+			TxIndex:       txm.TxIndex,
+			Sender:        txm.Sender,
+			Frames:        txFrames[hash],
+		}
+		if txm.Tx.Type() == types.BlobTxType {
+			tx.BlobHashes = txm.Tx.BlobHashes()
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
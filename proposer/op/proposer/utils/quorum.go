@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrNoQuorum is returned when not enough rollup nodes agree on an output.
+var ErrNoQuorum = errors.New("rollup nodes did not reach quorum on output")
+
+// QuorumRollupProvider wraps a rollup provider, and verifies the outputs returned by its rollup clients against
+// additional rollup nodes. An output is only returned if at least quorum of the nodes, including the primary, agree on
+// its output root and block hash, so that a single compromised or buggy node can't feed the proposer bad outputs.
+// The other RPCs are only served by the primary node.
+type QuorumRollupProvider struct {
+	dial.RollupProvider
+	verifiers []dial.RollupProvider
+	quorum    int
+	log       log.Logger
+}
+
+// NewQuorumRollupProvider wraps the primary rollup provider with output verification against the verifier providers.
+// If quorum is 0, a majority of the nodes must agree. If there are no verifiers, the provider is returned as is.
+func NewQuorumRollupProvider(primary dial.RollupProvider, verifiers []dial.RollupProvider, quorum int, log log.Logger) dial.RollupProvider {
+	if len(verifiers) == 0 {
+		return primary
+	}
+	if quorum == 0 {
+		quorum = (len(verifiers)+1)/2 + 1
+	}
+	return &QuorumRollupProvider{RollupProvider: primary, verifiers: verifiers, quorum: quorum, log: log}
+}
+
+// RollupClient returns the current rollup client of the primary provider, with quorum-verified outputs.
+func (p *QuorumRollupProvider) RollupClient(ctx context.Context) (dial.RollupClientInterface, error) {
+	client, err := p.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &quorumRollupClient{RollupClientInterface: client, provider: p}, nil
+}
+
+// Close closes the primary and verifier providers.
+func (p *QuorumRollupProvider) Close() {
+	p.RollupProvider.Close()
+	for _, v := range p.verifiers {
+		v.Close()
+	}
+}
+
+type quorumRollupClient struct {
+	dial.RollupClientInterface
+	provider *QuorumRollupProvider
+}
+
+// outputVote identifies an output that rollup nodes can agree on.
+type outputVote struct {
+	outputRoot eth.Bytes32
+	blockHash  common.Hash
+}
+
+func (c *quorumRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	p := c.provider
+	outputs := make([]*eth.OutputResponse, len(p.verifiers)+1)
+	errs := make([]error, len(p.verifiers)+1)
+
+	var wg sync.WaitGroup
+	wg.Add(len(outputs))
+	go func() {
+		defer wg.Done()
+		outputs[0], errs[0] = c.RollupClientInterface.OutputAtBlock(ctx, blockNum)
+	}()
+	for i, verifier := range p.verifiers {
+		go func() {
+			defer wg.Done()
+			client, err := verifier.RollupClient(ctx)
+			if err != nil {
+				errs[i+1] = err
+				return
+			}
+			outputs[i+1], errs[i+1] = client.OutputAtBlock(ctx, blockNum)
+		}()
+	}
+	wg.Wait()
+
+	// Count the votes of the nodes that returned an output. Nodes that failed don't vote.
+	votes := make(map[outputVote]int)
+	for _, output := range outputs {
+		if output != nil {
+			votes[outputVote{output.OutputRoot, output.BlockRef.Hash}]++
+		}
+	}
+	for _, output := range outputs {
+		if output == nil {
+			continue
+		}
+		vote := outputVote{output.OutputRoot, output.BlockRef.Hash}
+		if votes[vote] < p.quorum {
+			continue
+		}
+		if votes[vote] < len(outputs) {
+			p.log.Warn("not all rollup nodes agree on output, using quorum", "block", blockNum, "outputRoot", output.OutputRoot, "agreed", votes[vote], "nodes", len(outputs), "errs", errors.Join(errs...))
+		}
+		return output, nil
+	}
+	return nil, fmt.Errorf("%w at block %d: %d distinct outputs from %d nodes, quorum is %d: %w", ErrNoQuorum, blockNum, len(votes), len(outputs), p.quorum, errors.Join(errs...))
+}
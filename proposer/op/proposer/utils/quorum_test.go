@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRollupClient returns a fixed output, or a fixed error.
+type fakeRollupClient struct {
+	dial.RollupClientInterface
+	output *eth.OutputResponse
+	err    error
+}
+
+func (c *fakeRollupClient) OutputAtBlock(_ context.Context, _ uint64) (*eth.OutputResponse, error) {
+	return c.output, c.err
+}
+
+// fakeRollupProvider returns a fixed rollup client, or fails to dial it.
+type fakeRollupProvider struct {
+	client  dial.RollupClientInterface
+	dialErr error
+}
+
+func (p *fakeRollupProvider) RollupClient(_ context.Context) (dial.RollupClientInterface, error) {
+	return p.client, p.dialErr
+}
+
+func (p *fakeRollupProvider) Close() {}
+
+func outputProvider(output *eth.OutputResponse) *fakeRollupProvider {
+	return &fakeRollupProvider{client: &fakeRollupClient{output: output}}
+}
+
+// TestQuorumOutputAtBlock tests that an output is only returned if quorum of the rollup nodes agree on its output root
+// and block hash, and that failed nodes don't vote.
+func TestQuorumOutputAtBlock(t *testing.T) {
+	good := &eth.OutputResponse{OutputRoot: eth.Bytes32{1}, BlockRef: eth.L2BlockRef{Hash: common.Hash{1}, Number: 100}}
+	badRoot := &eth.OutputResponse{OutputRoot: eth.Bytes32{2}, BlockRef: eth.L2BlockRef{Hash: common.Hash{1}, Number: 100}}
+	badHash := &eth.OutputResponse{OutputRoot: eth.Bytes32{1}, BlockRef: eth.L2BlockRef{Hash: common.Hash{2}, Number: 100}}
+	rpcErr := errors.New("rpc error")
+
+	tests := []struct {
+		name      string
+		primary   *fakeRollupProvider
+		verifiers []*fakeRollupProvider
+		quorum    int
+		want      *eth.OutputResponse
+	}{
+		{
+			name:      "all agree",
+			primary:   outputProvider(good),
+			verifiers: []*fakeRollupProvider{outputProvider(good), outputProvider(good)},
+			want:      good,
+		},
+		{
+			name:      "primary outvoted",
+			primary:   outputProvider(badRoot),
+			verifiers: []*fakeRollupProvider{outputProvider(good), outputProvider(good)},
+			want:      good,
+		},
+		{
+			name:      "no majority",
+			primary:   outputProvider(good),
+			verifiers: []*fakeRollupProvider{outputProvider(badRoot), outputProvider(badHash)},
+		},
+		{
+			name:      "unanimity required",
+			primary:   outputProvider(good),
+			verifiers: []*fakeRollupProvider{outputProvider(good), outputProvider(badHash)},
+			quorum:    3,
+		},
+		{
+			name:    "verifier errors don't vote",
+			primary: outputProvider(good),
+			verifiers: []*fakeRollupProvider{
+				outputProvider(good),
+				{dialErr: rpcErr},
+				{client: &fakeRollupClient{err: rpcErr}},
+			},
+			quorum: 2,
+			want:   good,
+		},
+		{
+			name:      "too many verifier errors",
+			primary:   outputProvider(good),
+			verifiers: []*fakeRollupProvider{{dialErr: rpcErr}, {client: &fakeRollupClient{err: rpcErr}}},
+		},
+		{
+			name:      "quorum larger than the number of nodes",
+			primary:   outputProvider(good),
+			verifiers: []*fakeRollupProvider{outputProvider(good), outputProvider(good)},
+			quorum:    4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifiers := make([]dial.RollupProvider, len(tt.verifiers))
+			for i, v := range tt.verifiers {
+				verifiers[i] = v
+			}
+			provider := NewQuorumRollupProvider(tt.primary, verifiers, tt.quorum, testlog.Logger(t, log.LevelInfo))
+			client, err := provider.RollupClient(context.Background())
+			require.NoError(t, err)
+
+			output, err := client.OutputAtBlock(context.Background(), 100)
+			if tt.want == nil {
+				require.ErrorIs(t, err, ErrNoQuorum)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"context"
+	"time"
+)
+
+// The default timeout of the RPC requests made while fetching and decoding batches.
+const DefaultRPCTimeout = 10 * time.Second
+
+// rpcContext returns the context for an RPC request. If the parent context has a deadline, the request inherits it,
+// so that the caller controls how long it may take. Otherwise, the request times out after the given timeout, or
+// DefaultRPCTimeout if it's 0.
+func rpcContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := parent.Deadline(); ok {
+		return context.WithCancel(parent)
+	}
+	if timeout == 0 {
+		timeout = DefaultRPCTimeout
+	}
+	return context.WithTimeout(parent, timeout)
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,11 +12,12 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/fetch"
 	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
@@ -30,6 +32,19 @@ import (
 var ErrNoSpanBatchFound = errors.New("no span batch found for the given block")
 var ErrMaxDeviationExceeded = errors.New("max deviation exceeded")
 
+// ErrBeaconRequired is returned when a batch was posted in blobs, but no L1 beacon client is configured to fetch them.
+var ErrBeaconRequired = errors.New("L1 beacon client required to fetch blob batches")
+
+const (
+	// How often the L1 head is polled while waiting for the L1 chain to reach the end of the L1 search range.
+	l1HeadPollInterval = 12 * time.Second
+	// The L1 block time in seconds used if the block time measured from the L1 headers is implausible. The rollup
+	// config doesn't include the L1 block time, so this is the block time of Ethereum.
+	defaultL1BlockTime = 12
+	// The longest L1 block time in seconds that is considered plausible.
+	maxL1BlockTime = 60
+)
+
 // SpanBatchRange represents a range of L2 blocks covered by a span batch
 type SpanBatchRange struct {
 	Start uint64 `json:"start"`
@@ -50,6 +65,37 @@ type BatchDecoderConfig struct {
 	L1Beacon          *sources.L1BeaconClient
 	BatchSender       common.Address
 	DataDir           string
+	// Optional filter of the batcher transactions, for chains whose batcher transactions aren't all sent to
+	// BatchInboxAddress. Defaults to the transactions sent to BatchInboxAddress.
+	InboxFilter *InboxFilter
+	// Whether to derive the valid batch senders from the batcher updates of the SystemConfig contract, instead of using
+	// BatchSender. Handles batcher rotations within the fetched L1 range, but scans the SystemConfig logs since the L1
+	// genesis of the chain on first use.
+	AutoBatchSender bool
+	// Optional client for resolving alt-DA commitments (e.g. Celestia, EigenDA) posted to the batch inbox.
+	AltDA AltDAClient
+	// The number of L1 blocks fetched at the same time. Defaults to 10.
+	L1FetchConcurrency uint64
+	// The number of slots whose blob sidecars are fetched from the beacon node at the same time. Defaults to 4.
+	BlobFetchConcurrency uint64
+	// The directory in which the batches decoded from each channel are cached. Defaults to a channel_cache directory
+	// next to DataDir.
+	ChannelCacheDir string
+	// The memory budget in bytes for decoding channels at the same time, based on the estimated decompressed size of
+	// each channel. Defaults to 512 MiB.
+	DecodeMemoryBudget uint64
+	// The timeout of each RPC request, if the caller's context has no deadline. Defaults to DefaultRPCTimeout.
+	RPCTimeout time.Duration
+	// The L1 block time in seconds, used if the block time measured from the L1 headers is implausible. Defaults to 12.
+	L1BlockTime uint64
+	// How long to wait for the L1 chain to reach the end of the L1 search range before clamping the range to the L1
+	// head. If 0, the range is clamped without waiting.
+	L1HeadWait time.Duration
+	// Whether to fail on any invalid frame or batch with a DecodeReportError, instead of skipping it. Useful when the
+	// decoder is used to audit the batches of a chain.
+	StrictDecode bool
+	// How span batches that straddle the edges of the requested L2 block range are returned. Defaults to BoundarySplit.
+	BoundaryPolicy RangeBoundaryPolicy
 }
 
 // CustomBytes32 is a wrapper around eth.Bytes32 that can unmarshal from both
@@ -185,39 +231,193 @@ func convertBaseFeeParams(rawConfig map[string]interface{}, key string) {
 
 // GetAllSpanBatchesInBlockRange fetches span batches within a range of L2 blocks.
 func GetAllSpanBatchesInL2BlockRange(config BatchDecoderConfig) ([]SpanBatchRange, error) {
-	rollupCfg, err := setupBatchDecoderConfig(&config)
+	ranges, _, err := GetAllSpanBatchesInL2BlockRangeWithReports(context.Background(), config)
+	return ranges, err
+}
+
+// GetAllSpanBatchesInL2BlockRangeWithReports fetches span batches within a range of L2 blocks, and also returns the
+// reports of the channels that had errors while decoding. The RPC requests inherit the deadline of the context, or
+// time out after config.RPCTimeout if it has none.
+func GetAllSpanBatchesInL2BlockRangeWithReports(ctx context.Context, config BatchDecoderConfig) ([]SpanBatchRange, []*ChannelReport, error) {
+	ranges, reports, err := GetAllSpanBatchesInL2BlockRanges(ctx, config, []SpanBatchRange{{Start: config.L2StartBlock, End: config.L2EndBlock}})
+	if err != nil {
+		return nil, reports, err
+	}
+	return ranges[0], reports, nil
+}
+
+// GetAllSpanBatchesInL2BlockRanges fetches span batches within each of the given L2 block ranges, and returns the span
+// batch ranges of each L2 block range, in the order of the given ranges, along with the reports of the channels that
+// had errors while decoding. The ranges don't need to be sorted or disjoint. config.L2StartBlock and config.L2EndBlock
+// are ignored.
+//
+// Unlike calling GetAllSpanBatchesInL2BlockRangeWithReports for each range, the L1 blocks of overlapping L1 search
+// ranges are only fetched once, and the channels are reassembled and decoded once for all ranges.
+func GetAllSpanBatchesInL2BlockRanges(ctx context.Context, config BatchDecoderConfig, l2Ranges []SpanBatchRange) ([][]SpanBatchRange, []*ChannelReport, error) {
+	ranges, _, reports, err := GetAllSpanBatchesInL2BlockRangesWithStats(ctx, config, l2Ranges)
+	return ranges, reports, err
+}
+
+// GetAllSpanBatchesInL2BlockRangesWithStats is GetAllSpanBatchesInL2BlockRanges, and also returns the compression
+// statistics of the channels that carried the batches of each L2 block range.
+func GetAllSpanBatchesInL2BlockRangesWithStats(ctx context.Context, config BatchDecoderConfig, l2Ranges []SpanBatchRange) ([][]SpanBatchRange, []CompressionStats, []*ChannelReport, error) {
+	if len(l2Ranges) == 0 {
+		return nil, nil, nil, nil
+	}
+	for _, r := range l2Ranges {
+		if r.End < r.Start {
+			return nil, nil, nil, fmt.Errorf("invalid L2 block range [%d, %d]", r.Start, r.End)
+		}
+	}
+	rollupCfg, err := fetchBatchesForL2Ranges(ctx, &config, l2Ranges)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Get all span batch ranges in the given L2 block ranges.
+	opts := rangeOptions{
+		cache:      openChannelCache(config, rollupCfg),
+		budget:     newDecodeBudget(config.DecodeMemoryBudget),
+		parentHash: rollupParentHash(ctx, config.L2Node, config.RPCTimeout),
+		strict:     config.StrictDecode,
+		boundary:   config.BoundaryPolicy,
+	}
+	channels, reports, err := decodeChannels(newReassembleConfig(config), rollupCfg, opts)
+	if err != nil {
+		return nil, nil, reports, fmt.Errorf("failed to get span batch ranges: %w", err)
+	}
+	ranges := make([][]SpanBatchRange, len(l2Ranges))
+	stats := make([]CompressionStats, len(l2Ranges))
+	for i, r := range l2Ranges {
+		if ranges[i], err = spanBatchRangesIn(channels, opts, r.Start, r.End); err != nil {
+			return nil, nil, reports, fmt.Errorf("failed to get span batch ranges: %w", err)
+		}
+		stats[i] = compressionStatsIn(channels, r.Start, r.End)
+	}
+
+	return ranges, stats, reports, nil
+}
+
+// fetchBatchesForL2Ranges sets up the config, and fetches the batcher transactions of the L1 blocks that may carry
+// the batches of the given L2 block ranges to config.DataDir, resolving alt-DA commitments. Returns the rollup config.
+func fetchBatchesForL2Ranges(ctx context.Context, config *BatchDecoderConfig, l2Ranges []SpanBatchRange) (*rollup.Config, error) {
+	rollupCfg, err := setupBatchDecoderConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup config: %w", err)
 	}
 
-	l1Start, l1End, err := GetL1SearchBoundaries(config.L2Node, config.L1RPC, config.L2StartBlock, config.L2EndBlock)
+	l1BlockTime := config.L1BlockTime
+	if l1BlockTime == 0 {
+		l1BlockTime = defaultL1BlockTime
+	}
+	l1Ranges := make([]l1Range, len(l2Ranges))
+	var l1End uint64
+	for i, r := range l2Ranges {
+		l1Ranges[i].start, l1Ranges[i].end, err = l1SearchBoundaries(ctx, config.L2Node, config.L1RPC, r.Start, r.End, config.RPCTimeout, l1BlockTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L1 origin and finalized: %w", err)
+		}
+		l1End = max(l1End, l1Ranges[i].end)
+	}
+
+	// The end boundary can be ahead of the L1 chain for recent L2 blocks. Optionally wait for the L1 chain to catch
+	// up, as the batches may not be posted yet, and clamp the ranges to the L1 head.
+	l1Head, err := l1HeadNumber(ctx, config.L1RPC, config.RPCTimeout)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get L1 origin and finalized: %w", err)
+		return nil, err
+	}
+	if l1Head+1 < l1End && config.L1HeadWait > 0 {
+		if l1Head, err = waitForL1Head(ctx, config.L1RPC, l1End-1, config.L1HeadWait, config.RPCTimeout); err != nil {
+			return nil, err
+		}
+	}
+	for i := range l1Ranges {
+		l1Ranges[i].end = min(l1Ranges[i].end, l1Head+1)
 	}
 
-	// Fetch the batches posted to the BatchInbox contract in the given L1 block range and store them in config.DataDir.
-	err = fetchBatchesBetweenL1Blocks(config, rollupCfg, l1Start, l1End)
+	// Fetch the batches posted to the BatchInbox contract in the given L1 block ranges and store them in config.DataDir.
+	err = fetchBatchesInL1Ranges(ctx, *config, rollupCfg, mergeL1Ranges(l1Ranges))
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch batches: %w", err)
 	}
 
-	// Reassemble the batches into span batches from the stored transaction frames in config.DataDir.
-	reassembleConfig := reassemble.Config{
-		BatchInbox:    config.BatchInboxAddress,
+	// For chains posting batch data to an alt-DA layer, the batch inbox only holds commitments to the batch data.
+	if config.AltDA != nil {
+		if err := resolveAltDABatches(config.AltDA, config.DataDir); err != nil {
+			return nil, fmt.Errorf("failed to resolve alt-DA batches: %w", err)
+		}
+	}
+	return rollupCfg, nil
+}
+
+// openChannelCache opens the channel cache of the config. Returns nil if it can't be opened, as the cache is an
+// optimization, and the batches are still decoded without it.
+func openChannelCache(config BatchDecoderConfig, rollupCfg *rollup.Config) *channelCache {
+	cacheDir := config.ChannelCacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(filepath.Dir(config.DataDir), "channel_cache")
+	}
+	cache, err := newChannelCache(cacheDir, rollupCfg)
+	if err != nil {
+		fmt.Printf("Channel cache disabled: %v\n", err)
+		return nil
+	}
+	return cache
+}
+
+// newReassembleConfig returns the config to reassemble the batches from the stored transaction frames in
+// config.DataDir.
+func newReassembleConfig(config BatchDecoderConfig) reassemble.Config {
+	return reassemble.Config{
+		BatchInbox:    config.frameInbox(),
 		InDirectory:   config.DataDir,
 		OutDirectory:  "",
 		L2ChainID:     config.L2ChainID,
 		L2GenesisTime: config.L2GenesisTime,
 		L2BlockTime:   config.L2BlockTime,
 	}
+}
 
-	// Get all span batch ranges in the given L2 block range.
-	ranges, err := GetSpanBatchRanges(reassembleConfig, rollupCfg, config.L2StartBlock, config.L2EndBlock, 1000000)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get span batch ranges: %w", err)
+// l1Range is a range [start, end) of L1 blocks.
+type l1Range struct {
+	start, end uint64
+}
+
+// mergeL1Ranges merges overlapping and adjacent L1 block ranges, so that each L1 block is fetched once.
+func mergeL1Ranges(ranges []l1Range) []l1Range {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b l1Range) int { return cmp.Compare(a.start, b.start) })
+	var merged []l1Range
+	for _, r := range sorted {
+		if r.end <= r.start {
+			continue
+		}
+		if n := len(merged); n > 0 && r.start <= merged[n-1].end {
+			merged[n-1].end = max(merged[n-1].end, r.end)
+			continue
+		}
+		merged = append(merged, r)
 	}
+	return merged
+}
 
-	return ranges, nil
+// normalizeRanges returns the canonical coverage list of span batch ranges: sorted by start block, with duplicate and
+// overlapping ranges, e.g. of a span batch posted again in a later channel, merged. Ranges that are adjacent but don't
+// overlap are kept apart, as they are the boundaries of different span batches.
+func normalizeRanges(ranges []SpanBatchRange) []SpanBatchRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b SpanBatchRange) int {
+		return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.End, b.End))
+	})
+	var merged []SpanBatchRange
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && r.Start <= merged[n-1].End {
+			merged[n-1].End = max(merged[n-1].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
 }
 
 // / Get the L2 block number for the given L2 timestamp.
@@ -227,41 +427,141 @@ func TimestampToBlock(rollupCfg *rollup.Config, l2Timestamp uint64) uint64 {
 
 // Get the block ranges for each span batch in the given L2 block range.
 func GetSpanBatchRanges(config reassemble.Config, rollupCfg *rollup.Config, startBlock, endBlock, maxSpanBatchDeviation uint64) ([]SpanBatchRange, error) {
-	frames := reassemble.LoadFrames(config.InDirectory, config.BatchInbox)
-	framesByChannel := make(map[derive.ChannelID][]reassemble.FrameWithMetadata)
-	for _, frame := range frames {
-		framesByChannel[frame.Frame.ID] = append(framesByChannel[frame.Frame.ID], frame)
+	ranges, _, err := getSpanBatchRanges(config, rollupCfg, rangeOptions{budget: newDecodeBudget(0)}, startBlock, endBlock)
+	return ranges, err
+}
+
+// rangeOptions configures how getSpanBatchRanges decodes and validates channels.
+type rangeOptions struct {
+	// Channels in the cache aren't decoded again. May be nil.
+	cache *channelCache
+	// Channels are decoded concurrently, as long as their estimated decompressed size fits in the budget.
+	budget *decodeBudget
+	// If set, span batches in the range are checked to extend the canonical chain.
+	parentHash parentHashFn
+	// In strict mode, any invalid frame or batch, or singular batch in the range, fails the call instead of being
+	// skipped.
+	strict bool
+	// How span batches that straddle the edges of the range are returned. Defaults to BoundarySplit.
+	boundary RangeBoundaryPolicy
+}
+
+// getSpanBatchRanges gets the block ranges for each span batch in the given L2 block range. Also returns the reports
+// of the channels that had errors while decoding.
+//
+// If opts.parentHash is set, span batches in the range that don't extend the canonical chain fail the call with a
+// ParentMismatchError.
+func getSpanBatchRanges(config reassemble.Config, rollupCfg *rollup.Config, opts rangeOptions, startBlock, endBlock uint64) ([]SpanBatchRange, []*ChannelReport, error) {
+	channels, reports, err := decodeChannels(config, rollupCfg, opts)
+	if err != nil {
+		return nil, reports, err
 	}
+	ranges, err := spanBatchRangesIn(channels, opts, startBlock, endBlock)
+	return ranges, reports, err
+}
 
-	var ranges []SpanBatchRange
+// decodeChannels reassembles and decodes the channels of the frames in config.InDirectory, and returns the decoded
+// batches of each channel, in the order the channels were read, along with the reports of the channels that had
+// errors while decoding.
+func decodeChannels(config reassemble.Config, rollupCfg *rollup.Config, opts rangeOptions) ([]*cachedChannel, []*ChannelReport, error) {
+	// The decoded batches of each channel, in the order the channels were read.
+	var (
+		channels []*cachedChannel
+		reports  []*ChannelReport
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+
+	// The frames are streamed per channel, so that the memory of a channel is released once it's decoded. Waiting for
+	// the budget before reading the next channel keeps the frames of channels waiting to be decoded bounded too.
+	err := forEachChannel(config.InDirectory, config.BatchInbox, func(id derive.ChannelID, frames []reassemble.FrameWithMetadata) bool {
+		if batches, ok := opts.cache.get(id); ok {
+			channels = append(channels, batches)
+			return true
+		}
 
-	for id, frames := range framesByChannel {
-		ch := processFrames(config, rollupCfg, id, frames)
-		if len(ch.Batches) == 0 {
-			log.Fatalf("no span batches in channel")
+		batches := &cachedChannel{}
+		channels = append(channels, batches)
+		release := opts.budget.acquire(estimateExpandedSize(rollupCfg, frames))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer release()
+			ch, report := processFrames(config, rollupCfg, id, frames)
+			if !report.OK() {
+				fmt.Printf("Errors decoding %v\n", report)
+				mu.Lock()
+				reports = append(reports, report)
+				mu.Unlock()
+			}
+			// A channel without any valid batches doesn't contribute any ranges.
+			*batches = *summarizeBatches(rollupCfg, ch.Batches)
+			batches.Compression = compressionOf(rollupCfg, ch)
+			// Only complete, valid channels are cached, as an incomplete channel may get more frames in a later run.
+			if ch.IsReady && !ch.InvalidFrames && !ch.InvalidBatches {
+				opts.cache.put(id, batches)
+			}
+		}()
+		return true
+	})
+	wg.Wait()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load frames: %w", err)
+	}
+	if opts.strict {
+		if err := newDecodeReportError(reports); err != nil {
+			return nil, reports, err
 		}
+	}
+	return channels, reports, nil
+}
 
-		for idx, b := range ch.Batches {
-			batchStartBlock := TimestampToBlock(rollupCfg, b.GetTimestamp())
-			spanBatch, success := b.AsSpanBatch()
-			if !success {
+// spanBatchRangesIn returns the block ranges of the span batches of the decoded channels in the given L2 block range,
+// normalized to a sorted list without overlaps.
+func spanBatchRangesIn(channels []*cachedChannel, opts rangeOptions, startBlock, endBlock uint64) ([]SpanBatchRange, error) {
+	var ranges []SpanBatchRange
+	for _, batches := range channels {
+		for idx, b := range batches.Batches {
+			batchStartBlock := b.StartBlock
+			if !b.IsSpan && opts.strict {
+				return nil, fmt.Errorf("%w: batch %d starting at block %d is a singular batch", ErrNoSpanBatchFound, idx, b.StartBlock)
+			}
+			if !b.IsSpan {
 				// If AsSpanBatch fails, return the entire range.
 				log.Printf("couldn't convert batch %v to span batch\n", idx)
-				ranges = append(ranges, SpanBatchRange{Start: startBlock, End: endBlock})
-				return ranges, nil
+				return normalizeRanges(append(ranges, SpanBatchRange{Start: startBlock, End: endBlock})), nil
 			}
-			blockCount := spanBatch.GetBlockCount()
-			batchEndBlock := batchStartBlock + uint64(blockCount) - 1
+			batchEndBlock := batchStartBlock + b.BlockCount - 1
 
 			if batchStartBlock > endBlock || batchEndBlock < startBlock {
 				continue
 			} else {
-				ranges = append(ranges, SpanBatchRange{Start: max(startBlock, batchStartBlock), End: min(endBlock, batchEndBlock)})
+				if opts.parentHash != nil {
+					if err := validateParentLinkage(b, opts.parentHash); err != nil {
+						return nil, err
+					}
+				}
+				ranges = append(ranges, opts.boundary.apply(batchStartBlock, batchEndBlock, startBlock, endBlock))
 			}
 		}
 	}
 
-	return ranges, nil
+	return normalizeRanges(ranges), nil
+}
+
+// Summarize the decoded batches of a channel to the parts needed to compute span batch ranges.
+func summarizeBatches(rollupCfg *rollup.Config, batches []derive.Batch) *cachedChannel {
+	ch := &cachedChannel{}
+	for _, b := range batches {
+		cb := cachedBatch{StartBlock: TimestampToBlock(rollupCfg, b.GetTimestamp())}
+		if spanBatch, ok := b.AsSpanBatch(); ok {
+			cb.IsSpan = true
+			cb.BlockCount = uint64(spanBatch.GetBlockCount())
+			cb.ParentCheck = spanBatch.ParentCheck[:]
+		}
+		ch.Batches = append(ch.Batches, cb)
+	}
+	return ch
 }
 
 // Set up the batch decoder config.
@@ -295,8 +595,26 @@ func setupBatchDecoderConfig(config *BatchDecoderConfig) (*rollup.Config, error)
 // for the first block and an L1 block 10 minutes after the last block to ensure that the batches
 // were posted to L1 for these blocks in that period. Pick blocks where it's nearly guaranteeed that
 // the relevant batches were posted to L1.
-func GetL1SearchBoundaries(rollupClient dial.RollupClientInterface, l1Client ethclient.Client, startBlock, endBlock uint64) (uint64, uint64, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//
+// The end boundary is clamped to the L1 head, as it can be ahead of the L1 chain for recent L2 blocks.
+//
+// The requests inherit the deadline of the given context. If it has none, they time out after the given timeout.
+func GetL1SearchBoundaries(ctx context.Context, rollupClient dial.RollupClientInterface, l1Client ethclient.Client, startBlock, endBlock uint64, timeout time.Duration) (uint64, uint64, error) {
+	l1Start, l1End, err := l1SearchBoundaries(ctx, rollupClient, l1Client, startBlock, endBlock, timeout, defaultL1BlockTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	l1Head, err := l1HeadNumber(ctx, l1Client, timeout)
+	if err != nil {
+		return 0, 0, err
+	}
+	return l1Start, min(l1End, l1Head+1), nil
+}
+
+// l1SearchBoundaries computes the L1 search boundaries for the L2 block range, without clamping the end boundary. If
+// the L1 block time measured from the L1 headers is implausible, the given fallback L1 block time (in seconds) is used.
+func l1SearchBoundaries(ctx context.Context, rollupClient dial.RollupClientInterface, l1Client ethclient.Client, startBlock, endBlock uint64, timeout time.Duration, fallbackL1BlockTime uint64) (uint64, uint64, error) {
+	ctx, cancel := rpcContext(ctx, timeout)
 	defer cancel()
 
 	output, err := rollupClient.OutputAtBlock(ctx, startBlock)
@@ -305,19 +623,27 @@ func GetL1SearchBoundaries(rollupClient dial.RollupClientInterface, l1Client eth
 	}
 	startL1Origin := output.BlockRef.L1Origin.Number
 
-	// Get the diff in seconds between startL1Origin and startL1Origin -1 to get the L1 block time.
-	block, err := l1Client.BlockByNumber(ctx, big.NewInt(int64(startL1Origin)))
+	// Get the diff in seconds between startL1Origin and startL1Origin -1 to get the L1 block time. The headers are
+	// looked up by hash, so they can be cached.
+	header, err := headerByHash(ctx, &l1Client, output.BlockRef.L1Origin.Hash)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to get block at start L1 origin: %w", err)
 	}
-	startBlockTime := block.Time()
+	startBlockTime := header.Time
 
 	// Get the L1 block time by retrieving the timestamp diff between two consecutive L1 blocks.
-	block, err = l1Client.BlockByNumber(ctx, big.NewInt(int64(startL1Origin-1)))
+	header, err = headerByHash(ctx, &l1Client, header.ParentHash)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to get block at start L1 origin - 1: %w", err)
 	}
-	l1BlockTime := startBlockTime - block.Time()
+	// Consecutive blocks can share a timestamp, or the RPC can return odd data, so the measured block time is only used
+	// if it's plausible.
+	l1BlockTime := fallbackL1BlockTime
+	if startBlockTime > header.Time && startBlockTime-header.Time <= maxL1BlockTime {
+		l1BlockTime = startBlockTime - header.Time
+	} else {
+		fmt.Printf("Measured L1 block time is implausible (block time %v, parent time %v), using %vs\n", startBlockTime, header.Time, fallbackL1BlockTime)
+	}
 
 	// Get the L1 origin for the last block.
 	output, err = rollupClient.OutputAtBlock(ctx, endBlock)
@@ -326,14 +652,55 @@ func GetL1SearchBoundaries(rollupClient dial.RollupClientInterface, l1Client eth
 	}
 
 	// Fetch an L1 block that is at least 10 minutes after the end block to guarantee that the batches have been posted.
-	endL1Origin := output.BlockRef.L1Origin.Number + (uint64(60/l1BlockTime) * 10)
+	endL1Origin := output.BlockRef.L1Origin.Number + (10*60+l1BlockTime-1)/l1BlockTime
 
 	return startL1Origin, endL1Origin, nil
 }
 
-// Read all of the batches posted to the BatchInbox contract in the given L1 block range. Once the
+// l1HeadNumber returns the number of the latest L1 block.
+func l1HeadNumber(ctx context.Context, l1Client ethclient.Client, timeout time.Duration) (uint64, error) {
+	ctx, cancel := rpcContext(ctx, timeout)
+	defer cancel()
+	head, err := l1Client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	return head, nil
+}
+
+// waitForL1Head waits until the L1 head reaches the target block, or maxWait has elapsed. Returns the latest L1 head.
+func waitForL1Head(ctx context.Context, l1Client ethclient.Client, target uint64, maxWait, timeout time.Duration) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+	ticker := time.NewTicker(l1HeadPollInterval)
+	defer ticker.Stop()
+
+	var head uint64
+	for {
+		latest, err := l1HeadNumber(ctx, l1Client, timeout)
+		if err != nil {
+			// Give up waiting with the last known head once the wait is over.
+			if ctx.Err() != nil && head > 0 {
+				return head, nil
+			}
+			return 0, err
+		}
+		head = latest
+		if head >= target {
+			return head, nil
+		}
+		fmt.Printf("Waiting for L1 head %v to reach %v, so that the batches are posted\n", head, target)
+		select {
+		case <-ctx.Done():
+			return head, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Read all of the batches posted to the BatchInbox contract in the given L1 block ranges. Once the
 // batches are fetched, they are written to the given data directory.
-func fetchBatchesBetweenL1Blocks(config BatchDecoderConfig, rollupCfg *rollup.Config, l1Start, l1End uint64) error {
+func fetchBatchesInL1Ranges(ctx context.Context, config BatchDecoderConfig, rollupCfg *rollup.Config, l1Ranges []l1Range) error {
 	// Clear the out directory so that loading the transaction frames is fast. Otherwise, when loading thousands of transactions,
 	// this process can become quite slow.
 	err := os.RemoveAll(config.DataDir)
@@ -341,29 +708,24 @@ func fetchBatchesBetweenL1Blocks(config BatchDecoderConfig, rollupCfg *rollup.Co
 		return fmt.Errorf("failed to clear out directory: %w", err)
 	}
 
-	fetchConfig := fetch.Config{
-		Start:   l1Start,
-		End:     l1End,
-		ChainID: rollupCfg.L1ChainID,
-		BatchSenders: map[common.Address]struct{}{
-			config.BatchSender: {},
-		},
-		BatchInbox:         config.BatchInboxAddress,
-		OutDirectory:       config.DataDir,
-		ConcurrentRequests: 10,
+	for _, r := range l1Ranges {
+		totalValid, totalInvalid, err := fetchBatches(ctx, config, rollupCfg, r.start, r.end)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Fetched batches in range [%v,%v). Found %v valid & %v invalid batches\n", r.start, r.end, totalValid, totalInvalid)
 	}
 
-	totalValid, totalInvalid := fetch.Batches(&config.L1RPC, config.L1Beacon, fetchConfig)
-
-	fmt.Printf("Fetched batches in range [%v,%v). Found %v valid & %v invalid batches\n", fetchConfig.Start, fetchConfig.End, totalValid, totalInvalid)
-
 	return nil
 }
 
 // Setup the L1 Beacon client.
-func SetupBeacon(l1BeaconUrl string) (*sources.L1BeaconClient, error) {
+// The version check inherits the deadline of the given context. If it has none, it times out after the given timeout.
+func SetupBeacon(ctx context.Context, l1BeaconUrl string, timeout time.Duration) (*sources.L1BeaconClient, error) {
+	// Without a beacon client, only batches posted in calldata can be decoded. Fetching a batch posted in blobs fails
+	// with ErrBeaconRequired.
 	if l1BeaconUrl == "" {
-		fmt.Println("L1 Beacon endpoint not set. Unable to fetch post-ecotone channel frames")
+		fmt.Println("L1 Beacon endpoint not set, only batches posted in calldata can be decoded")
 		return nil, nil
 	}
 
@@ -371,7 +733,7 @@ func SetupBeacon(l1BeaconUrl string) (*sources.L1BeaconClient, error) {
 	beaconCfg := sources.L1BeaconClientConfig{FetchAllSidecars: false}
 	beacon := sources.NewL1BeaconClient(beaconClient, beaconCfg)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := rpcContext(ctx, timeout)
 	defer cancel()
 
 	_, err := beacon.GetVersion(ctx)
@@ -384,19 +746,29 @@ func SetupBeacon(l1BeaconUrl string) (*sources.L1BeaconClient, error) {
 
 // Copied from op-proposer-go/op-node/cmd/batch_decoder/utils/reassemble.go, because it wasn't exported.
 // TODO: Ask Optimism team to export this function.
-func processFrames(cfg reassemble.Config, rollupCfg *rollup.Config, id derive.ChannelID, frames []reassemble.FrameWithMetadata) reassemble.ChannelWithMetadata {
+//
+// Unlike the original, batches that fail to decode are left out of the channel, and the errors are returned in a
+// report instead of being logged. Like the channel bank of the derivation pipeline, frames included after the channel
+// timed out are dropped, so that a channel that isn't complete by its timeout yields no batches.
+func processFrames(cfg reassemble.Config, rollupCfg *rollup.Config, id derive.ChannelID, frames []reassemble.FrameWithMetadata) (reassemble.ChannelWithMetadata, *ChannelReport) {
 	spec := rollup.NewChainSpec(rollupCfg)
 	ch := derive.NewChannel(id, eth.L1BlockRef{Number: frames[0].InclusionBlock})
+	report := &ChannelReport{ChannelID: id}
 	invalidFrame := false
 
 	for _, frame := range frames {
 		if ch.IsReady() {
-			fmt.Printf("Channel %v is ready despite having more frames\n", id.String())
+			report.add(DecodeErrorInvalidFrame, -1, fmt.Errorf("channel is ready despite having more frames"))
 			invalidFrame = true
 			break
 		}
+		if timeout := ch.OpenBlockNumber() + spec.ChannelTimeout(frame.Timestamp); timeout < frame.InclusionBlock {
+			report.add(DecodeErrorChannelTimeout, -1, fmt.Errorf("frame %d included in L1 block %d, after the channel timed out at L1 block %d", frame.Frame.FrameNumber, frame.InclusionBlock, timeout))
+			invalidFrame = true
+			continue
+		}
 		if err := ch.AddFrame(frame.Frame, eth.L1BlockRef{Number: frame.InclusionBlock, Time: frame.Timestamp}); err != nil {
-			fmt.Printf("Error adding to channel %v. Err: %v\n", id.String(), err)
+			report.add(DecodeErrorInvalidFrame, -1, fmt.Errorf("frame %d: %w", frame.Frame.FrameNumber, err))
 			invalidFrame = true
 		}
 	}
@@ -411,41 +783,47 @@ func processFrames(cfg reassemble.Config, rollupCfg *rollup.Config, id derive.Ch
 	if ch.IsReady() {
 		br, err := derive.BatchReader(ch.Reader(), spec.MaxRLPBytesPerChannel(ch.HighestBlock().Time), rollupCfg.IsFjord(ch.HighestBlock().Time))
 		if err == nil {
+			idx := 0
 			for batchData, err := br(); err != io.EOF; batchData, err = br() {
 				if err != nil {
-					fmt.Printf("Error reading batchData for channel %v. Err: %v\n", id.String(), err)
+					report.add(DecodeErrorInvalidBatch, idx, fmt.Errorf("failed to read batch data: %w", err))
 					invalidBatches = true
 				} else {
-					comprAlgos = append(comprAlgos, batchData.ComprAlgo)
-					batchType := batchData.GetBatchType()
-					batchTypes = append(batchTypes, int(batchType))
-					switch batchType {
+					// Batches that fail to decode are skipped, so that the channel never contains nil batches.
+					var batch derive.Batch
+					switch batchType := batchData.GetBatchType(); batchType {
 					case derive.SingularBatchType:
 						singularBatch, err := derive.GetSingularBatch(batchData)
 						if err != nil {
-							invalidBatches = true
-							fmt.Printf("Error converting singularBatch from batchData for channel %v. Err: %v\n", id.String(), err)
+							report.add(DecodeErrorInvalidBatch, idx, fmt.Errorf("failed to convert singular batch: %w", err))
+						} else {
+							batch = singularBatch
 						}
-						// singularBatch will be nil when errored
-						batches = append(batches, singularBatch)
 					case derive.SpanBatchType:
 						spanBatch, err := derive.DeriveSpanBatch(batchData, cfg.L2BlockTime, cfg.L2GenesisTime, cfg.L2ChainID)
 						if err != nil {
-							invalidBatches = true
-							fmt.Printf("Error deriving spanBatch from batchData for channel %v. Err: %v\n", id.String(), err)
+							report.add(DecodeErrorInvalidBatch, idx, fmt.Errorf("failed to derive span batch: %w", err))
+						} else {
+							batch = spanBatch
 						}
-						// spanBatch will be nil when errored
-						batches = append(batches, spanBatch)
 					default:
-						fmt.Printf("unrecognized batch type: %d for channel %v.\n", batchData.GetBatchType(), id.String())
+						report.add(DecodeErrorUnknownBatchType, idx, fmt.Errorf("unrecognized batch type %d", batchType))
+					}
+					if batch != nil {
+						batches = append(batches, batch)
+						batchTypes = append(batchTypes, int(batchData.GetBatchType()))
+						comprAlgos = append(comprAlgos, batchData.ComprAlgo)
+					} else {
+						invalidBatches = true
 					}
 				}
+				idx++
 			}
 		} else {
-			fmt.Printf("Error creating batch reader for channel %v. Err: %v\n", id.String(), err)
+			report.add(DecodeErrorBatchReader, -1, fmt.Errorf("failed to create batch reader: %w", err))
 		}
 	} else {
-		fmt.Printf("Channel %v is not ready\n", id.String())
+		report.add(DecodeErrorChannelNotReady, -1, fmt.Errorf("channel is missing frames"))
 	}
 
 	return reassemble.ChannelWithMetadata{
@@ -457,5 +835,5 @@ func processFrames(cfg reassemble.Config, rollupCfg *rollup.Config, id derive.Ch
 		Batches:        batches,
 		BatchTypes:     batchTypes,
 		ComprAlgos:     comprAlgos,
-	}
+	}, report
 }
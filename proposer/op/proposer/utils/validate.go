@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ParentMismatchError is returned when the parent check of a span batch doesn't match the hash of the L2 block before
+// its first block, so the batch doesn't extend the canonical chain.
+type ParentMismatchError struct {
+	StartBlock  uint64
+	ParentCheck []byte
+	ParentHash  common.Hash
+}
+
+func (e *ParentMismatchError) Error() string {
+	return fmt.Sprintf("span batch starting at block %d has parent check %x, but the parent hash is %s", e.StartBlock, e.ParentCheck, e.ParentHash)
+}
+
+// parentHashFn returns the hash of the L2 block with the given number.
+type parentHashFn func(number uint64) (common.Hash, error)
+
+// rollupParentHash looks up L2 block hashes through the rollup node.
+func rollupParentHash(ctx context.Context, rollupClient dial.RollupClientInterface, timeout time.Duration) parentHashFn {
+	return func(number uint64) (common.Hash, error) {
+		ctx, cancel := rpcContext(ctx, timeout)
+		defer cancel()
+		output, err := rollupClient.OutputAtBlock(ctx, number)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to get output at block %d: %w", number, err)
+		}
+		return output.BlockRef.Hash, nil
+	}
+}
+
+// validateParentLinkage checks that the span batch extends the L2 block before its first block.
+func validateParentLinkage(b cachedBatch, parentHash parentHashFn) error {
+	if b.StartBlock == 0 || len(b.ParentCheck) == 0 {
+		return nil
+	}
+	hash, err := parentHash(b.StartBlock - 1)
+	if err != nil {
+		return err
+	}
+	// The parent check of a span batch is a prefix of the parent hash. A longer one, e.g. from a corrupt cache entry,
+	// can't match.
+	if len(b.ParentCheck) > len(hash) || !bytes.Equal(hash[:len(b.ParentCheck)], b.ParentCheck) {
+		return &ParentMismatchError{StartBlock: b.StartBlock, ParentCheck: b.ParentCheck, ParentHash: hash}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/cmd/batch_decoder/reassemble"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// The maximum number of L1 blocks fetched in a single step of the watcher, so that the index makes progress while the
+// watcher catches up with the L1 chain.
+const maxWatchStepBlocks = 1000
+
+var ErrRangeNotIndexed = errors.New("block range is not indexed yet")
+
+// SpanBatchWatcher follows the L1 chain and maintains an index of the span batch ranges of the batches posted to the
+// batch inbox, so that span batch ranges can be queried without fetching and decoding the batches for each query.
+//
+// The batcher transactions of channels that aren't complete yet are kept in config.DataDir until the channel is
+// complete or times out. Unlike GetSpanBatchRanges, a singular batch is indexed as a range of a single block.
+type SpanBatchWatcher struct {
+	config        BatchDecoderConfig
+	rollupCfg     *rollup.Config
+	reassembleCfg reassemble.Config
+	// The number of L1 blocks behind the head that are fetched, so that batches in reorged blocks aren't indexed.
+	confirmations uint64
+
+	mu sync.RWMutex
+	// The ranges of the indexed batches, sorted by start block.
+	ranges []SpanBatchRange
+	// The next L1 block to fetch.
+	nextL1 uint64
+}
+
+// NewSpanBatchWatcher creates a watcher that indexes the batches posted from the L1 origin of config.L2StartBlock
+// onwards. Any batcher transactions in config.DataDir are removed.
+func NewSpanBatchWatcher(config BatchDecoderConfig, confirmations uint64) (*SpanBatchWatcher, error) {
+	rollupCfg, err := setupBatchDecoderConfig(&config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup config: %w", err)
+	}
+
+	ctx, cancel := rpcContext(context.Background(), config.RPCTimeout)
+	defer cancel()
+	output, err := config.L2Node.OutputAtBlock(ctx, config.L2StartBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get output at start block: %w", err)
+	}
+
+	if err := os.RemoveAll(config.DataDir); err != nil {
+		return nil, fmt.Errorf("failed to clear out directory: %w", err)
+	}
+
+	return &SpanBatchWatcher{
+		config:    config,
+		rollupCfg: rollupCfg,
+		reassembleCfg: reassemble.Config{
+			BatchInbox:    config.frameInbox(),
+			InDirectory:   config.DataDir,
+			L2ChainID:     config.L2ChainID,
+			L2GenesisTime: config.L2GenesisTime,
+			L2BlockTime:   config.L2BlockTime,
+		},
+		confirmations: confirmations,
+		nextL1:        output.BlockRef.L1Origin.Number,
+	}, nil
+}
+
+// Run indexes new batches every pollInterval until the context is cancelled. Errors are logged and retried on the
+// next poll.
+func (w *SpanBatchWatcher) Run(ctx context.Context, pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		// Catch up without waiting for the ticker while there are more blocks to fetch.
+		for {
+			caughtUp, err := w.step(ctx)
+			if err != nil {
+				fmt.Printf("Error indexing span batches: %v\n", err)
+				break
+			}
+			if caughtUp {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Ranges returns the span batch ranges in the given L2 block range, with the span batches that straddle the edges of
+// the range split or snapped according to the policy. Returns ErrRangeNotIndexed if the batches for the end of the
+// range haven't been indexed yet.
+func (w *SpanBatchWatcher) Ranges(startBlock, endBlock uint64, policy RangeBoundaryPolicy) ([]SpanBatchRange, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if len(w.ranges) == 0 || startBlock < w.ranges[0].Start || endBlock > w.ranges[len(w.ranges)-1].End {
+		return nil, ErrRangeNotIndexed
+	}
+
+	var ranges []SpanBatchRange
+	for _, r := range w.ranges {
+		if r.Start > endBlock || r.End < startBlock {
+			continue
+		}
+		ranges = append(ranges, policy.apply(r.Start, r.End, startBlock, endBlock))
+	}
+	return ranges, nil
+}
+
+// BatchContaining returns the range of the indexed batch that contains the given L2 block. Returns ErrRangeNotIndexed
+// if the block is outside of the indexed range, or no indexed batch contains it.
+func (w *SpanBatchWatcher) BatchContaining(block uint64) (SpanBatchRange, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	// Find the last range that starts at or before the block.
+	i := sort.Search(len(w.ranges), func(i int) bool {
+		return w.ranges[i].Start > block
+	})
+	if i == 0 || w.ranges[i-1].End < block {
+		return SpanBatchRange{}, ErrRangeNotIndexed
+	}
+	return w.ranges[i-1], nil
+}
+
+// NextL1Block returns the next L1 block that the watcher will fetch.
+func (w *SpanBatchWatcher) NextL1Block() uint64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.nextL1
+}
+
+// step fetches and indexes the batches in the next L1 blocks. Returns true if the watcher has caught up with the L1
+// chain.
+func (w *SpanBatchWatcher) step(ctx context.Context) (bool, error) {
+	headCtx, cancel := rpcContext(ctx, w.config.RPCTimeout)
+	defer cancel()
+	head, err := w.config.L1RPC.BlockNumber(headCtx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	if head < w.confirmations {
+		return true, nil
+	}
+
+	start := w.NextL1Block()
+	end := head - w.confirmations + 1
+	if end <= start {
+		return true, nil
+	}
+	end = min(end, start+maxWatchStepBlocks)
+
+	if _, _, err := fetchBatches(ctx, w.config, w.rollupCfg, start, end); err != nil {
+		return false, fmt.Errorf("failed to fetch batches: %w", err)
+	}
+	if w.config.AltDA != nil {
+		if err := resolveAltDABatches(w.config.AltDA, w.config.DataDir); err != nil {
+			return false, fmt.Errorf("failed to resolve alt-DA batches: %w", err)
+		}
+	}
+
+	ranges, pending, err := w.indexChannels(end)
+	if err != nil {
+		return false, err
+	}
+
+	// Only keep the transactions of channels that are still pending, so that indexed transactions aren't read again.
+	entries, err := os.ReadDir(w.config.DataDir)
+	if err != nil {
+		return false, fmt.Errorf("failed to read batch directory: %w", err)
+	}
+	for _, entry := range entries {
+		if pending[entry.Name()] {
+			continue
+		}
+		if err := os.Remove(filepath.Join(w.config.DataDir, entry.Name())); err != nil {
+			return false, fmt.Errorf("failed to remove indexed transaction: %w", err)
+		}
+	}
+
+	w.mu.Lock()
+	w.ranges = normalizeRanges(append(w.ranges, ranges...))
+	w.nextL1 = end
+	w.mu.Unlock()
+
+	fmt.Printf("Indexed %v span batches in L1 blocks [%v,%v)\n", len(ranges), start, end)
+	return end == head-w.confirmations+1, nil
+}
+
+// indexChannels decodes the complete channels stored in the data directory. Returns the ranges of their batches, and
+// the file names of the transactions that hold frames of channels that are neither complete nor timed out before L1
+// block l1End.
+func (w *SpanBatchWatcher) indexChannels(l1End uint64) ([]SpanBatchRange, map[string]bool, error) {
+	spec := rollup.NewChainSpec(w.rollupCfg)
+	var ranges []SpanBatchRange
+	pending := make(map[string]bool)
+
+	err := forEachChannel(w.config.DataDir, w.config.frameInbox(), func(id derive.ChannelID, frames []reassemble.FrameWithMetadata) bool {
+		if !channelComplete(frames) {
+			if frames[0].InclusionBlock+spec.ChannelTimeout(frames[0].Timestamp) < l1End {
+				fmt.Printf("Dropping channel %v, which timed out\n", id.String())
+				return true
+			}
+			for _, f := range frames {
+				pending[fmt.Sprintf("%s.json", f.TxHash.String())] = true
+			}
+			return true
+		}
+
+		ch, report := processFrames(w.reassembleCfg, w.rollupCfg, id, frames)
+		if !report.OK() {
+			fmt.Printf("Errors decoding %v\n", report)
+		}
+		compressionOf(w.rollupCfg, ch)
+		for _, b := range summarizeBatches(w.rollupCfg, ch.Batches).Batches {
+			blockCount := uint64(1)
+			if b.IsSpan {
+				blockCount = b.BlockCount
+			}
+			ranges = append(ranges, SpanBatchRange{Start: b.StartBlock, End: b.StartBlock + blockCount - 1})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load frames: %w", err)
+	}
+
+	return ranges, pending, nil
+}
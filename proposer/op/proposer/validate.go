@@ -0,0 +1,169 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// validateEndpoints checks that the configured endpoints and contracts belong to the chain described by the rollup
+// config, so that misconfiguration is reported at startup instead of surfacing as errors while proposing.
+func (ps *ProposerService) validateEndpoints(ctx context.Context, cfg *CLIConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rollupClient, err := ps.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup client: %w", err)
+	}
+	rollupCfg, err := rollupClient.RollupConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rollup config: %w", err)
+	}
+
+	if cfg.Network != "" {
+		if chainCfg := chaincfg.ChainByName(cfg.Network); chainCfg != nil && chainCfg.ChainID != rollupCfg.L2ChainID.Uint64() {
+			return fmt.Errorf("rollup node chain ID %v does not match the %s chain ID %d", rollupCfg.L2ChainID, cfg.Network, chainCfg.ChainID)
+		}
+	}
+
+	l1ChainID, err := ps.L1Client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 chain ID: %w", err)
+	}
+	if l1ChainID.Cmp(rollupCfg.L1ChainID) != 0 {
+		return fmt.Errorf("L1 RPC chain ID %v does not match the rollup config L1 chain ID %v", l1ChainID, rollupCfg.L1ChainID)
+	}
+
+	if ps.L2Client != nil {
+		l2ChainID, err := ps.L2Client.ChainID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get L2 chain ID: %w", err)
+		}
+		if l2ChainID.Cmp(rollupCfg.L2ChainID) != 0 {
+			return fmt.Errorf("L2 RPC chain ID %v does not match the rollup config L2 chain ID %v", l2ChainID, rollupCfg.L2ChainID)
+		}
+	}
+
+	if ps.L2OutputOracleAddr != nil {
+		if err := ps.checkContractCode(ctx, "L2OutputOracle", *ps.L2OutputOracleAddr); err != nil {
+			return err
+		}
+	}
+	if ps.DisputeGameFactoryAddr != nil {
+		if err := ps.checkContractCode(ctx, "DisputeGameFactory", *ps.DisputeGameFactoryAddr); err != nil {
+			return err
+		}
+	}
+
+	// The OP Succinct server may still be starting up, so an unreachable server is only reported.
+	for _, serverUrl := range []string{cfg.OPSuccinctServerUrl, cfg.OPSuccinctSecondaryServerUrl} {
+		if serverUrl == "" {
+			continue
+		}
+		if err := checkServerReachable(ctx, serverUrl); err != nil {
+			ps.Log.Warn("OP Succinct server is not reachable", "url", redactURL(serverUrl), "err", err)
+		}
+	}
+
+	return nil
+}
+
+// checkContractCode checks that there is a contract deployed at the given address on L1.
+func (ps *ProposerService) checkContractCode(ctx context.Context, name string, addr common.Address) error {
+	code, err := ps.L1Client.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get %s code: %w", name, err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("no %s contract deployed at %s on L1", name, addr)
+	}
+	return nil
+}
+
+// checkServerReachable checks that the server responds to HTTP requests. Any response, including an error status,
+// counts as reachable.
+func checkServerReachable(ctx context.Context, serverUrl string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", serverUrl, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// logEffectiveConfig logs every setting of the config, with secrets and URL credentials redacted.
+func (ps *ProposerService) logEffectiveConfig(cfg *CLIConfig) {
+	var ctx []interface{}
+	collectConfigFields(reflect.ValueOf(*cfg), "", &ctx)
+	ps.Log.Info("Effective configuration", ctx...)
+}
+
+// The names of config fields whose values are never logged.
+var secretConfigFields = []string{"privatekey", "mnemonic", "secret", "password", "token", "apikey"}
+
+// collectConfigFields appends the name and value of each field of a config struct to ctx, flattening nested structs.
+func collectConfigFields(v reflect.Value, prefix string, ctx *[]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := prefix + field.Name
+		value := v.Field(i)
+
+		if value.Kind() == reflect.Struct && value.Type().PkgPath() != "time" {
+			collectConfigFields(value, name+".", ctx)
+			continue
+		}
+
+		lower := strings.ToLower(field.Name)
+		redacted := false
+		for _, secret := range secretConfigFields {
+			if strings.Contains(lower, secret) {
+				redacted = true
+				break
+			}
+		}
+		switch {
+		case redacted:
+			if !value.IsZero() {
+				*ctx = append(*ctx, name, "<redacted>")
+			} else {
+				*ctx = append(*ctx, name, "")
+			}
+		case value.Kind() == reflect.String:
+			*ctx = append(*ctx, name, redactURL(value.String()))
+		default:
+			*ctx = append(*ctx, name, fmt.Sprint(value.Interface()))
+		}
+	}
+}
+
+// redactURL removes credentials from URLs, which are commonly embedded in the userinfo or query string of RPC URLs.
+// Values that aren't URLs are returned unchanged.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return s
+	}
+	if u.User != nil {
+		u.User = url.User("redacted")
+	}
+	if u.RawQuery != "" {
+		u.RawQuery = "redacted"
+	}
+	return u.String()
+}
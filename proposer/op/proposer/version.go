@@ -0,0 +1,257 @@
+package proposer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ServerAPIVersion is the version of the OP Succinct server API that this proposer speaks.
+const ServerAPIVersion = "v1"
+
+// The git commit and date the binary was built from. Set by the main package.
+var (
+	GitCommit = ""
+	GitDate   = ""
+)
+
+// VersionInfo describes the proposer binary, and the programs that the L2OO contract and the OP Succinct server
+// verify and prove, so that operators can confirm that they're compatible.
+type VersionInfo struct {
+	Version          string `json:"version"`
+	GitCommit        string `json:"gitCommit"`
+	GitDate          string `json:"gitDate"`
+	ServerAPIVersion string `json:"serverApiVersion"`
+
+	// Read from the L2OO contract.
+	ContractVersion     string `json:"contractVersion,omitempty"`
+	AggregationVkey     string `json:"aggregationVkey,omitempty"`
+	RangeVkeyCommitment string `json:"rangeVkeyCommitment,omitempty"`
+	RollupConfigHash    string `json:"rollupConfigHash,omitempty"`
+
+	// The version reported by the OP Succinct server, if it supports the version endpoint.
+	Server json.RawMessage `json:"server,omitempty"`
+}
+
+// VersionContract is the part of the L2OO contract that describes the programs it verifies.
+type VersionContract interface {
+	Version(*bind.CallOpts) (string, error)
+	AggregationVkey(*bind.CallOpts) ([32]byte, error)
+	RangeVkeyCommitment(*bind.CallOpts) ([32]byte, error)
+	RollupConfigHash(*bind.CallOpts) ([32]byte, error)
+}
+
+// NewVersionInfo returns the build info of the proposer, and the program info of the contract and server if they're
+// given.
+func NewVersionInfo(ctx context.Context, version string, contract VersionContract, serverUrl string) (VersionInfo, error) {
+	info := VersionInfo{
+		Version:          version,
+		GitCommit:        GitCommit,
+		GitDate:          GitDate,
+		ServerAPIVersion: ServerAPIVersion,
+	}
+
+	if contract != nil {
+		opts := &bind.CallOpts{Context: ctx}
+		var err error
+		if info.ContractVersion, err = contract.Version(opts); err != nil {
+			return info, fmt.Errorf("failed to get contract version: %w", err)
+		}
+		for _, v := range []struct {
+			dst  *string
+			call func(*bind.CallOpts) ([32]byte, error)
+			name string
+		}{
+			{&info.AggregationVkey, contract.AggregationVkey, "aggregation vkey"},
+			{&info.RangeVkeyCommitment, contract.RangeVkeyCommitment, "range vkey commitment"},
+			{&info.RollupConfigHash, contract.RollupConfigHash, "rollup config hash"},
+		} {
+			hash, err := v.call(opts)
+			if err != nil {
+				return info, fmt.Errorf("failed to get %s: %w", v.name, err)
+			}
+			*v.dst = common.Hash(hash).Hex()
+		}
+	}
+
+	if serverUrl != "" {
+		server, err := fetchServerVersion(ctx, serverUrl)
+		if err != nil {
+			return info, err
+		}
+		info.Server = server
+	}
+
+	return info, nil
+}
+
+// ServerVersion is the version that the OP Succinct server reports on its version endpoint.
+type ServerVersion struct {
+	APIVersion string `json:"api_version"`
+	// The programs that the server proves, if it reports them.
+	AggregationVkey     string `json:"aggregation_vkey,omitempty"`
+	RangeVkeyCommitment string `json:"range_vkey_commitment,omitempty"`
+	// The optional endpoints that the server supports. If the server doesn't report them, all of them are assumed to
+	// be supported.
+	Features []string `json:"features,omitempty"`
+}
+
+// The optional endpoints of the OP Succinct server.
+const (
+	ServerFeatureBatchSpanRequests = "batch_span_requests"
+	ServerFeatureWitnessUpload     = "witness_upload"
+)
+
+// hasFeature returns whether the server supports the optional endpoint.
+func (v *ServerVersion) hasFeature(feature string) bool {
+	return v.Features == nil || slices.Contains(v.Features, feature)
+}
+
+// negotiateServerVersion checks that the OP Succinct servers speak the same API version as the proposer, and prove the
+// programs that the L2OO contract verifies, so that protocol drift is caught at startup instead of surfacing as
+// decoding errors later on. Optional endpoints that a server doesn't support are disabled.
+//
+// Only a definite mismatch of the primary server's API version or vkeys fails startup. If the check can't be completed,
+// e.g. because the server is unreachable or doesn't report its API version, or if the secondary server is
+// incompatible, a warning is logged and the proposer starts anyway.
+func (l *L2OutputSubmitter) negotiateServerVersion(ctx context.Context) error {
+	for _, backend := range []*proverBackend{l.backends.primary, l.backends.secondary} {
+		if backend == nil {
+			continue
+		}
+		err := l.checkServerVersion(ctx, backend)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrServerIncompatible) && backend == l.backends.primary:
+			return err
+		case errors.Is(err, ErrServerIncompatible):
+			l.Log.Error("secondary OP Succinct server is incompatible, proofs requested from it after a failover may fail", "err", err)
+		default:
+			l.Log.Warn("failed to check OP Succinct server compatibility, assuming it's compatible", "backend", backend.name, "err", err)
+		}
+	}
+	return nil
+}
+
+// checkServerVersion checks the version of a server for negotiateServerVersion. The error wraps ErrServerIncompatible
+// if the server is definitely incompatible.
+func (l *L2OutputSubmitter) checkServerVersion(ctx context.Context, backend *proverBackend) error {
+	raw, err := fetchServerVersion(ctx, backend.url)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		l.Log.Warn("OP Succinct server has no version endpoint, skipping the compatibility check", "backend", backend.name)
+		return nil
+	}
+	var version ServerVersion
+	if err := json.Unmarshal(raw, &version); err != nil {
+		return fmt.Errorf("unrecognized version %s: %w", raw, err)
+	}
+
+	if !version.hasFeature(ServerFeatureBatchSpanRequests) {
+		l.Log.Info("OP Succinct server does not support batch span proof requests, requesting span proofs individually", "backend", backend.name)
+		l.batchSpanRequestsUnsupported.Store(true)
+	}
+	if l.config().WitnessGenCmd != "" && !version.hasFeature(ServerFeatureWitnessUpload) {
+		l.Log.Warn("OP Succinct server does not support witness uploads, falling back to server-side witness generation", "backend", backend.name)
+		l.witnessUploadUnsupported.Store(true)
+	}
+
+	if version.APIVersion == "" {
+		return fmt.Errorf("server doesn't report its API version")
+	}
+	if version.APIVersion != ServerAPIVersion {
+		return fmt.Errorf("%w: %s backend speaks API version %q, expected %q", ErrServerIncompatible, backend.name, version.APIVersion, ServerAPIVersion)
+	}
+	if err := l.checkServerVkeys(ctx, backend, version); err != nil {
+		return err
+	}
+	l.Log.Info("OP Succinct server is compatible", "backend", backend.name, "apiVersion", version.APIVersion)
+	return nil
+}
+
+// checkServerVkeys checks that the programs that the server reports proving are verified by the L2OO contract. The
+// error wraps ErrServerIncompatible if they aren't.
+func (l *L2OutputSubmitter) checkServerVkeys(ctx context.Context, backend *proverBackend, version ServerVersion) error {
+	if l.l2ooContract == nil {
+		return nil
+	}
+	for _, v := range []struct {
+		server string
+		call   func(*bind.CallOpts) ([32]byte, error)
+		name   string
+	}{
+		{version.AggregationVkey, l.l2ooContract.AggregationVkey, "aggregation vkey"},
+		{version.RangeVkeyCommitment, l.l2ooContract.RangeVkeyCommitment, "range vkey commitment"},
+	} {
+		if v.server == "" {
+			continue
+		}
+		hash, err := v.call(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", v.name, err)
+		}
+		if common.HexToHash(v.server) != common.Hash(hash) {
+			return fmt.Errorf("%w: %s backend proves %s %s, but the L2OO contract verifies %s", ErrServerIncompatible, backend.name, v.name, v.server, common.Hash(hash).Hex())
+		}
+	}
+	return nil
+}
+
+// fetchServerVersion returns the version reported by the OP Succinct server, or nil if the server doesn't have a
+// version endpoint.
+func fetchServerVersion(ctx context.Context, serverUrl string) (json.RawMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", serverUrl+"/version", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server version request failed with status %d: %s", resp.StatusCode, body)
+	}
+	if !json.Valid(body) {
+		// Wrap plain text versions in a JSON string.
+		return json.Marshal(string(body))
+	}
+	return body, nil
+}
+
+// versionHandler serves the version info on /version, and passes other requests to next.
+func (ps *ProposerService) versionHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		info, err := ps.driver.VersionInfo(r.Context(), ps.Version)
+		if err != nil {
+			ps.Log.Warn("failed to get complete version info", "err", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(info); err != nil {
+			ps.Log.Error("failed to write version info", "err", err)
+		}
+	})
+}
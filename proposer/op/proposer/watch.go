@@ -0,0 +1,232 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/succinctlabs/op-succinct-go/proposer/db/ent/proofrequest"
+)
+
+// BlockStage is how far the proposer got with proving and submitting an L2 block.
+type BlockStage string
+
+const (
+	// No proof of the block is being generated yet.
+	BlockStageQueued BlockStage = "QUEUED"
+	// A span or agg proof of the block is being generated.
+	BlockStageProving BlockStage = "PROVING"
+	// An agg proof of the block is complete, and waits to be submitted.
+	BlockStageProven BlockStage = "PROVEN"
+	// An output at or after the block is on the L2OO contract.
+	BlockStageSubmitted BlockStage = "SUBMITTED"
+)
+
+// BlockStatus is the proving status of an L2 block.
+type BlockStatus struct {
+	Block uint64     `json:"block"`
+	Stage BlockStage `json:"stage"`
+	// The L2 block of the latest output on the L2OO contract.
+	LatestOutputBlock uint64 `json:"latest_output_block"`
+	// The proof requests that prove the block, newest first.
+	Proofs []ProofRequestInfo `json:"proofs,omitempty"`
+}
+
+// BlockStatus returns the proving status of the given L2 block. It returns ErrNoL2OutputOracle if the proposer proposes
+// to a dispute game factory.
+func (l *L2OutputSubmitter) BlockStatus(ctx context.Context, block uint64) (*BlockStatus, error) {
+	latest, err := l.latestOutputBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := l.db.GetProofRequestsCovering(block)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &BlockStatus{Block: block, Stage: BlockStageQueued, LatestOutputBlock: latest}
+	for _, p := range proofs {
+		status.Proofs = append(status.Proofs, newProofRequestInfo(p))
+		switch {
+		case p.Type == proofrequest.TypeAGG && p.Status == proofrequest.StatusCOMPLETE:
+			status.Stage = BlockStageProven
+		case status.Stage == BlockStageQueued && p.Status != proofrequest.StatusUNREQ && p.Status != proofrequest.StatusFAILED:
+			status.Stage = BlockStageProving
+		}
+	}
+	if latest >= block {
+		status.Stage = BlockStageSubmitted
+	}
+	return status, nil
+}
+
+// latestOutputBlock returns the L2 block of the latest output on the L2OO contract.
+func (l *L2OutputSubmitter) latestOutputBlock(ctx context.Context) (uint64, error) {
+	if l.l2ooContract == nil {
+		return 0, ErrNoL2OutputOracle
+	}
+	latest, err := l.l2ooContract.LatestBlockNumber(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest output block: %w", err)
+	}
+	return latest.Uint64(), nil
+}
+
+// watchPoll polls a watched value for the watch APIs. It returns nil if there's nothing new to send, and whether no
+// more values will be sent.
+type watchPoll func(ctx context.Context) (any, bool, error)
+
+// watchBlock polls the proving status of the given L2 block, and returns it each time its stage changes, starting with
+// the current status. No more statuses are returned once the block is submitted.
+func (l *L2OutputSubmitter) watchBlock(block uint64) watchPoll {
+	var last BlockStage
+	return func(ctx context.Context) (any, bool, error) {
+		status, err := l.BlockStatus(ctx, block)
+		if err != nil || status.Stage == last {
+			return nil, false, err
+		}
+		last = status.Stage
+		return status, status.Stage == BlockStageSubmitted, nil
+	}
+}
+
+// watchOutputs polls the L2 block of the latest output on the L2OO contract, and returns it each time a new output is
+// submitted, starting with the current one.
+func (l *L2OutputSubmitter) watchOutputs() watchPoll {
+	var last uint64
+	return func(ctx context.Context) (any, bool, error) {
+		latest, err := l.latestOutputBlock(ctx)
+		if err != nil || latest == last {
+			return nil, false, err
+		}
+		last = latest
+		return latest, false, nil
+	}
+}
+
+// watch calls poll every poll interval until ctx is done, and sends the values that poll returns. Nil values aren't
+// sent, and watch returns once poll reports that it's done, or sending fails. Polls that fail are logged and retried.
+func (l *L2OutputSubmitter) watch(ctx context.Context, poll watchPoll, send func(value any) error) error {
+	ticker := time.NewTicker(l.config().PollInterval)
+	defer ticker.Stop()
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, l.config().NetworkTimeout)
+		value, done, err := poll(pollCtx)
+		cancel()
+		if err != nil {
+			l.Log.Warn("failed to poll watched proving status", "err", err)
+		} else if value != nil {
+			if err := send(value); err != nil {
+				return err
+			}
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchAPI serves the proof queue and the proving status of L2 blocks in the "proposer" namespace, so that other
+// services, e.g. bridges and withdrawal relayers, can act as soon as the output for a block is submitted. The watch
+// methods are subscriptions, and only served over the websocket endpoint.
+type watchAPI struct {
+	l *L2OutputSubmitter
+}
+
+func getWatchAPI(l *L2OutputSubmitter) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "proposer",
+		Service:   &watchAPI{l: l},
+	}
+}
+
+// Queue returns the proof queue and the proven and submitted L2 heights.
+func (a *watchAPI) Queue(ctx context.Context) (ProposerMetrics, error) {
+	return a.l.GetProposerMetrics(ctx)
+}
+
+// ProofRequest returns the proof request with the given ID.
+func (a *watchAPI) ProofRequest(_ context.Context, id int) (ProofRequestInfo, error) {
+	p, err := a.l.db.GetProofRequestMetadata(id)
+	if err != nil {
+		return ProofRequestInfo{}, err
+	}
+	return newProofRequestInfo(p), nil
+}
+
+// BlockStatus returns the proving status of the given L2 block.
+func (a *watchAPI) BlockStatus(ctx context.Context, block uint64) (*BlockStatus, error) {
+	return a.l.BlockStatus(ctx, block)
+}
+
+// WatchBlock streams the proving status of the given L2 block each time its stage changes, starting with the current
+// status. No more statuses are sent once the block is submitted.
+func (a *watchAPI) WatchBlock(ctx context.Context, block uint64) (*gethrpc.Subscription, error) {
+	if a.l.l2ooContract == nil {
+		return nil, ErrNoL2OutputOracle
+	}
+	return a.subscribe(ctx, a.l.watchBlock(block))
+}
+
+// WatchOutputs streams the L2 block of the latest output on the L2OO contract each time a new output is submitted,
+// starting with the current one.
+func (a *watchAPI) WatchOutputs(ctx context.Context) (*gethrpc.Subscription, error) {
+	if a.l.l2ooContract == nil {
+		return nil, ErrNoL2OutputOracle
+	}
+	return a.subscribe(ctx, a.l.watchOutputs())
+}
+
+// subscribe creates a subscription that sends the values that poll returns until the client unsubscribes.
+func (a *watchAPI) subscribe(ctx context.Context, poll watchPoll) (*gethrpc.Subscription, error) {
+	notifier, supported := gethrpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+
+	watchCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-sub.Err():
+		case <-watchCtx.Done():
+		}
+		cancel()
+	}()
+	go func() {
+		defer cancel()
+		a.l.watch(watchCtx, poll, func(value any) error { return notifier.Notify(sub.ID, value) })
+	}()
+	return sub, nil
+}
+
+// newWatchServer creates the JSON-RPC server of the websocket endpoint, which serves the watch API with subscriptions.
+// The op-service RPC server only serves HTTP, which doesn't support subscriptions.
+func newWatchServer(l *L2OutputSubmitter) (*gethrpc.Server, error) {
+	srv := gethrpc.NewServer()
+	api := getWatchAPI(l)
+	if err := srv.RegisterName(api.Namespace, api.Service); err != nil {
+		return nil, fmt.Errorf("failed to register watch API: %w", err)
+	}
+	return srv, nil
+}
+
+// watchHandler serves the websocket endpoint of the watch API on /ws, and passes other requests to next.
+func (ps *ProposerService) watchHandler(next http.Handler) http.Handler {
+	ws := ps.watchServer.WebsocketHandler([]string{"*"})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ws" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ws.ServeHTTP(w, r)
+	})
+}
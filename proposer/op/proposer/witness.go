@@ -0,0 +1,90 @@
+package proposer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+// ErrWitnessUploadUnsupported is returned when the OP Succinct server doesn't accept span proof requests with a
+// witness.
+var ErrWitnessUploadUnsupported = errors.New("OP Succinct server does not support witness uploads")
+
+// The witness generation for larger proofs can take up to 20 minutes, as on the server.
+const witnessGenTimeout = 20 * time.Minute
+
+// requestSpanProofWithWitness generates the witness of a span proof locally with the configured command, and uploads
+// it with the span proof request, so that the server can skip its own derivation. The request is sent as a multipart
+// form with a "request" part holding the JSON request, and a "witness" part holding the witness. The witness is
+// streamed from the command to the server, so it's never held in memory.
+func (l *L2OutputSubmitter) requestSpanProofWithWitness(l2Start, l2End uint64, jsonBody []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(l.serverContext(), witnessGenTimeout)
+	// Stops the command if the request failed before the whole witness was uploaded.
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, l.config().WitnessGenCmd, "--start", strconv.FormatUint(l2Start, 10), "--end", strconv.FormatUint(l2End, 10))
+	cmd.Stderr = &stderr
+	witness, err := cmd.StdoutPipe()
+	if err != nil {
+		return "", fmt.Errorf("failed to create witness generation output pipe: %w", err)
+	}
+	l.Log.Info("generating span proof witness", "start", l2Start, "end", l2End)
+	if err := cmd.Start(); err != nil {
+		return "", fmt.Errorf("failed to start witness generation: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := writeWitnessUpload(mw, jsonBody, witness)
+		// Wait closes the output pipe, so it's only called once the witness was read, or the upload failed.
+		if waitErr := cmd.Wait(); waitErr != nil && err == nil {
+			err = fmt.Errorf("witness generation failed: %w: %s", waitErr, stderr.String())
+		}
+		pw.CloseWithError(err)
+	}()
+	// Unblocks the writer if the server responded before reading the whole body.
+	defer pr.Close()
+
+	backend := l.backends.active()
+	statusCode, body, err := l.sendServerRequestBody(backend, "request_span_proof_with_witness", mw.FormDataContentType(), pr)
+	if err != nil {
+		return "", err
+	}
+	if statusCode == http.StatusNotFound || statusCode == http.StatusMethodNotAllowed {
+		return "", ErrWitnessUploadUnsupported
+	}
+	return l.decodeProofResponse(backend, statusCode, body)
+}
+
+// writeWitnessUpload writes the multipart form of a span proof request with a witness.
+func writeWitnessUpload(mw *multipart.Writer, jsonBody []byte, witness io.Reader) error {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="request"`)
+	header.Set("Content-Type", "application/json")
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(jsonBody); err != nil {
+		return err
+	}
+
+	part, err = mw.CreateFormFile("witness", "witness.bin")
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, witness); err != nil {
+		return fmt.Errorf("failed to upload witness: %w", err)
+	}
+	return mw.Close()
+}
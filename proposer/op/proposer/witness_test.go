@@ -0,0 +1,70 @@
+package proposer
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequestSpanProofWithWitness tests that a locally generated witness is uploaded with the span proof request, and
+// that span proofs are requested without a witness if the server doesn't support it.
+func TestRequestSpanProofWithWitness(t *testing.T) {
+	witnessGen := filepath.Join(t.TempDir(), "witnessgen")
+	require.NoError(t, os.WriteFile(witnessGen, []byte("#!/bin/sh\necho \"witness $2 $4\"\n"), 0o755))
+
+	t.Run("Supported", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/request_span_proof_with_witness", r.URL.Path)
+			mr, err := r.MultipartReader()
+			require.NoError(t, err)
+
+			part, err := mr.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, "request", part.FormName())
+			var req SpanProofRequest
+			require.NoError(t, json.NewDecoder(part).Decode(&req))
+			assert.Equal(t, SpanProofRequest{Start: 100, End: 110}, req)
+
+			part, err = mr.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, "witness", part.FormName())
+			witness, err := io.ReadAll(part)
+			require.NoError(t, err)
+			assert.Equal(t, "witness 100 110\n", string(witness))
+
+			json.NewEncoder(w).Encode(ProofResponse{ProofID: "a"})
+		}))
+		defer srv.Close()
+
+		l := newTestSubmitter(t, srv.URL)
+		l.Cfg.WitnessGenCmd = witnessGen
+		id, err := l.RequestSpanProof(100, 110)
+		require.NoError(t, err)
+		assert.Equal(t, "a", id)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/request_span_proof" {
+				http.NotFound(w, r)
+				return
+			}
+			json.NewEncoder(w).Encode(ProofResponse{ProofID: "b"})
+		}))
+		defer srv.Close()
+
+		l := newTestSubmitter(t, srv.URL)
+		l.Cfg.WitnessGenCmd = witnessGen
+		id, err := l.RequestSpanProof(100, 110)
+		require.NoError(t, err)
+		assert.Equal(t, "b", id)
+		assert.True(t, l.witnessUploadUnsupported.Load())
+	})
+}
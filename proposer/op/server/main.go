@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
 	"net/http"
-	"sort"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -14,6 +18,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // Span batch request is a request to find all span batches in a given block range.
@@ -23,18 +29,88 @@ type SpanBatchRequest struct {
 	L2ChainID   uint64 `json:"l2ChainID"`
 	L2Node      string `json:"l2Node"`
 	L1RPC       string `json:"l1RPC"`
-	L1Beacon    string `json:"l1Beacon"`
+	L1Beacon    string `json:"l1Beacon"` // Optional for ranges whose batches were all posted in calldata.
 	BatchSender string `json:"batchSender"`
+	// If set, batchSender is ignored, and the batch senders are derived from the batcher updates of the SystemConfig
+	// contract, so that batches posted across a batcher rotation are found.
+	AutoBatchSender bool `json:"autoBatchSender,omitempty"`
+	// Optional batch inbox addresses, for chains whose batcher transactions aren't all sent to the batch inbox address
+	// of the rollup config.
+	BatchInboxes []string `json:"batchInboxes,omitempty"`
+	// Optional URL of the Celestia alt-DA server, for chains posting batch data to Celestia.
+	CelestiaServer string `json:"celestiaServer,omitempty"`
+	// Optional URL of the EigenDA proxy, for chains posting batch data to EigenDA.
+	EigenDAProxy string `json:"eigenDAProxy,omitempty"`
+	// If set, any invalid frame or batch fails the request instead of being skipped.
+	Strict bool `json:"strict,omitempty"`
+	// How span batches that straddle the edges of the range are returned: "split" (the default) clips them to the
+	// range, "snap" returns them whole.
+	Boundary string `json:"boundary,omitempty"`
 }
 
 // Response to a span batch request.
 type SpanBatchResponse struct {
 	Ranges []utils.SpanBatchRange `json:"ranges"`
+	// The compression statistics of the channels that carried the batches of the range, by compression algorithm.
+	Compression utils.CompressionStats `json:"compression,omitempty"`
+}
+
+// Multi-range span batch request is a request to find all span batches in several L2 block ranges, which are derived
+// from a single fetch of the L1 blocks of all ranges. The start and end blocks of the embedded request are ignored.
+type MultiSpanBatchRequest struct {
+	SpanBatchRequest
+	Ranges []utils.SpanBatchRange `json:"ranges"`
+}
+
+// Response to a multi-range span batch request, with the span batches of each requested range, in request order.
+type MultiSpanBatchResponse struct {
+	Results []SpanBatchRangesResult `json:"results"`
+}
+
+// The span batches in a requested L2 block range.
+type SpanBatchRangesResult struct {
+	Start       uint64                 `json:"start"`
+	End         uint64                 `json:"end"`
+	Ranges      []utils.SpanBatchRange `json:"ranges"`
+	Compression utils.CompressionStats `json:"compression,omitempty"`
+}
+
+// Block location request is a request to find where the batch of an L2 block was posted to L1. The start and end
+// blocks of the embedded request are ignored.
+type BlockLocationRequest struct {
+	SpanBatchRequest
+	Block uint64 `json:"block"`
+}
+
+// Response to a channel integrity request, with the integrity report of each channel posted in the L1 search range
+// of the requested L2 block range.
+type ChannelIntegrityResponse struct {
+	Channels []*utils.ChannelIntegrity `json:"channels"`
 }
 
 func main() {
 	r := mux.NewRouter()
+	prometheus.MustRegister(utils.DecoderMetrics()...)
+	r.Handle("/metrics", promhttp.Handler()).Methods("GET")
 	r.HandleFunc("/span-batch-ranges", handleSpanBatchRanges).Methods("POST")
+	r.HandleFunc("/span-batch-ranges/multi", handleMultiSpanBatchRanges).Methods("POST")
+	r.HandleFunc("/block-location", handleBlockLocation).Methods("POST")
+	r.HandleFunc("/channel-integrity", handleChannelIntegrity).Methods("POST")
+
+	// In watch mode, the span batch ranges of the configured chain are indexed as batches land on L1, and can be
+	// queried without fetching the batches for each request.
+	if os.Getenv("WATCH") == "true" {
+		watcher, err := newWatcherFromEnv()
+		if err != nil {
+			log.Fatalf("Failed to start watcher: %v", err)
+		}
+		go watcher.Run(context.Background(), 12*time.Second)
+		r.HandleFunc("/span-batch-ranges", handleWatchedSpanBatchRanges(watcher)).Methods("GET")
+
+		// The public API for explorers and monitoring tools, which only reads the index.
+		r.HandleFunc("/span-ranges", publicAPI(handleWatchedSpanBatchRanges(watcher))).Methods("GET")
+		r.HandleFunc("/block/{n}/batch", publicAPI(handleBlockBatch(watcher))).Methods("GET")
+	}
 
 	fmt.Println("Server is running on :8089")
 	log.Fatal(http.ListenAndServe(":8089", r))
@@ -48,56 +124,309 @@ func handleSpanBatchRanges(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	l1BeaconClient, err := utils.SetupBeacon(req.L1Beacon)
+	config, err := newBatchDecoderConfig(r.Context(), req)
 	if err != nil {
-		fmt.Printf("Error setting up beacon: %v\n", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	l1Client, err := ethclient.Dial(req.L1RPC)
+	results, stats, _, err := utils.GetAllSpanBatchesInL2BlockRangesWithStats(r.Context(), config, []utils.SpanBatchRange{{Start: req.StartBlock, End: req.EndBlock}})
 	if err != nil {
-		fmt.Printf("Error creating L1 client: %v\n", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		fmt.Printf("Error getting span batch ranges: %v\n", err)
+		http.Error(w, err.Error(), decodeErrorStatus(err))
+		return
+	}
+
+	// The ranges are sorted by start block, without overlaps.
+	response := SpanBatchResponse{
+		Ranges:      results[0],
+		Compression: stats[0],
+	}
+
+	fmt.Printf("Response: %v\n", response)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Return all of the span batches in each of several L2 block ranges, fetching and decoding the batches once for all
+// of them.
+func handleMultiSpanBatchRanges(w http.ResponseWriter, r *http.Request) {
+	var req MultiSpanBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.Ranges) == 0 {
+		http.Error(w, "no ranges requested", http.StatusBadRequest)
 		return
 	}
+	for _, rng := range req.Ranges {
+		if rng.End < rng.Start {
+			http.Error(w, fmt.Sprintf("invalid range [%d, %d]", rng.Start, rng.End), http.StatusBadRequest)
+			return
+		}
+	}
 
-	l2Node, err := dial.DialRollupClientWithTimeout(r.Context(), dial.DefaultDialTimeout, nil, req.L2Node)
+	config, err := newBatchDecoderConfig(r.Context(), req.SpanBatchRequest)
 	if err != nil {
-		fmt.Printf("Error dialing L2 node: %v\n", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	config := utils.BatchDecoderConfig{
-		L2ChainID:    new(big.Int).SetUint64(req.L2ChainID),
-		L2Node:       l2Node,
-		L1RPC:        *l1Client,
-		L1Beacon:     l1BeaconClient,
-		BatchSender:  common.HexToAddress(req.BatchSender),
-		L2StartBlock: req.StartBlock,
-		L2EndBlock:   req.EndBlock,
-		DataDir:      fmt.Sprintf("/tmp/batch_decoder/%d/transactions_cache", req.L2ChainID),
+	ranges, stats, _, err := utils.GetAllSpanBatchesInL2BlockRangesWithStats(r.Context(), config, req.Ranges)
+	if err != nil {
+		fmt.Printf("Error getting span batch ranges: %v\n", err)
+		http.Error(w, err.Error(), decodeErrorStatus(err))
+		return
+	}
+
+	response := MultiSpanBatchResponse{Results: make([]SpanBatchRangesResult, len(req.Ranges))}
+	for i, rng := range req.Ranges {
+		response.Results[i] = SpanBatchRangesResult{Start: rng.Start, End: rng.End, Ranges: ranges[i], Compression: stats[i]}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Return the channel, frames and L1 batcher transactions that carried the batch of an L2 block.
+func handleBlockLocation(w http.ResponseWriter, r *http.Request) {
+	var req BlockLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	ranges, err := utils.GetAllSpanBatchesInL2BlockRange(config)
+	config, err := newBatchDecoderConfig(r.Context(), req.SpanBatchRequest)
 	if err != nil {
-		fmt.Printf("Error getting span batch ranges: %v\n", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	// Sort the ranges by start block
-	sort.Slice(ranges, func(i, j int) bool {
-		return ranges[i].Start < ranges[j].Start
-	})
+	loc, reports, err := utils.LocateL2Block(r.Context(), config, req.Block)
+	if errors.Is(err, utils.ErrNoSpanBatchFound) {
+		// The decode errors of the channels in the L1 search range usually explain why the batch wasn't found.
+		msg := err.Error()
+		for _, report := range reports {
+			msg += "\n" + report.String()
+		}
+		http.Error(w, msg, http.StatusNotFound)
+		return
+	} else if err != nil {
+		fmt.Printf("Error locating batch of block %d: %v\n", req.Block, err)
+		http.Error(w, err.Error(), decodeErrorStatus(err))
+		return
+	}
 
-	response := SpanBatchResponse{
-		Ranges: ranges,
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(loc)
+}
+
+// Return the integrity report of each channel posted for a given L2 block range.
+func handleChannelIntegrity(w http.ResponseWriter, r *http.Request) {
+	var req SpanBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	fmt.Printf("Response: %v\n", response)
+	config, err := newBatchDecoderConfig(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	channels, err := utils.GetChannelIntegrityReports(r.Context(), config)
+	if err != nil {
+		fmt.Printf("Error getting channel integrity reports: %v\n", err)
+		http.Error(w, err.Error(), decodeErrorStatus(err))
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
+	json.NewEncoder(w).Encode(ChannelIntegrityResponse{Channels: channels})
+}
+
+// The HTTP status of an error fetching and decoding batches. A request without an L1 beacon URL for a range with
+// batches posted in blobs is a bad request, as it succeeds with one.
+func decodeErrorStatus(err error) int {
+	if errors.Is(err, utils.ErrBeaconRequired) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// Create the batch decoder config of a span batch request, connecting to the request's L1 and L2 nodes.
+func newBatchDecoderConfig(ctx context.Context, req SpanBatchRequest) (utils.BatchDecoderConfig, error) {
+	l1BeaconClient, err := utils.SetupBeacon(ctx, req.L1Beacon, utils.DefaultRPCTimeout)
+	if err != nil {
+		fmt.Printf("Error setting up beacon: %v\n", err)
+		return utils.BatchDecoderConfig{}, err
+	}
+
+	l1Client, err := ethclient.Dial(req.L1RPC)
+	if err != nil {
+		fmt.Printf("Error creating L1 client: %v\n", err)
+		return utils.BatchDecoderConfig{}, err
+	}
+
+	l2Node, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, nil, req.L2Node)
+	if err != nil {
+		fmt.Printf("Error dialing L2 node: %v\n", err)
+		return utils.BatchDecoderConfig{}, err
+	}
+
+	config := utils.BatchDecoderConfig{
+		L2ChainID:       new(big.Int).SetUint64(req.L2ChainID),
+		L2Node:          l2Node,
+		L1RPC:           *l1Client,
+		L1Beacon:        l1BeaconClient,
+		BatchSender:     common.HexToAddress(req.BatchSender),
+		L2StartBlock:    req.StartBlock,
+		L2EndBlock:      req.EndBlock,
+		DataDir:         fmt.Sprintf("/tmp/batch_decoder/%d/transactions_cache", req.L2ChainID),
+		StrictDecode:    req.Strict,
+		AutoBatchSender: req.AutoBatchSender,
+	}
+	if config.BoundaryPolicy, err = utils.ParseRangeBoundaryPolicy(req.Boundary); err != nil {
+		return utils.BatchDecoderConfig{}, err
+	}
+	if len(req.BatchInboxes) > 0 {
+		config.InboxFilter = &utils.InboxFilter{}
+		for _, inbox := range req.BatchInboxes {
+			if !common.IsHexAddress(inbox) {
+				return utils.BatchDecoderConfig{}, fmt.Errorf("invalid batch inbox address %q", inbox)
+			}
+			config.InboxFilter.Addresses = append(config.InboxFilter.Addresses, common.HexToAddress(inbox))
+		}
+	}
+	if req.CelestiaServer != "" {
+		config.AltDA = utils.NewCelestiaDAClient(req.CelestiaServer)
+	} else if req.EigenDAProxy != "" {
+		config.AltDA = utils.NewEigenDAClient(req.EigenDAProxy)
+	}
+	return config, nil
+}
+
+// Create a span batch watcher for the chain of the rollup node at L2_NODE_RPC. The watcher indexes the batches from
+// WATCH_START_BLOCK, or the finalized L2 block if it isn't set, onwards.
+func newWatcherFromEnv() (*utils.SpanBatchWatcher, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	l1BeaconClient, err := utils.SetupBeacon(ctx, os.Getenv("L1_BEACON_RPC"), utils.DefaultRPCTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up beacon: %w", err)
+	}
+	l1Client, err := ethclient.Dial(os.Getenv("L1_RPC"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create L1 client: %w", err)
+	}
+	l2Node, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, nil, os.Getenv("L2_NODE_RPC"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial L2 node: %w", err)
+	}
+	rollupCfg, err := l2Node.RollupConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rollup config: %w", err)
+	}
+
+	var startBlock uint64
+	if s := os.Getenv("WATCH_START_BLOCK"); s != "" {
+		if startBlock, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid WATCH_START_BLOCK: %w", err)
+		}
+	} else {
+		status, err := l2Node.SyncStatus(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sync status: %w", err)
+		}
+		startBlock = status.FinalizedL2.Number
+	}
+
+	config := utils.BatchDecoderConfig{
+		L2ChainID:    rollupCfg.L2ChainID,
+		L2Node:       l2Node,
+		L1RPC:        *l1Client,
+		L1Beacon:     l1BeaconClient,
+		BatchSender:  rollupCfg.Genesis.SystemConfig.BatcherAddr,
+		L2StartBlock: startBlock,
+		DataDir:      fmt.Sprintf("/tmp/batch_decoder/%d/watch_cache", rollupCfg.L2ChainID),
+		// The watcher runs indefinitely, so it follows batcher rotations if WATCH_AUTO_BATCH_SENDER is set.
+		AutoBatchSender: os.Getenv("WATCH_AUTO_BATCH_SENDER") == "true",
+	}
+	// Wait for a few confirmations, so that batches in reorged L1 blocks aren't indexed.
+	return utils.NewSpanBatchWatcher(config, 5)
+}
+
+// Return the indexed span batches in the L2 block range given by the start and end query parameters.
+func handleWatchedSpanBatchRanges(watcher *utils.SpanBatchWatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start, err := strconv.ParseUint(r.URL.Query().Get("start"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid start block", http.StatusBadRequest)
+			return
+		}
+		end, err := strconv.ParseUint(r.URL.Query().Get("end"), 10, 64)
+		if err != nil || end < start {
+			http.Error(w, "invalid end block", http.StatusBadRequest)
+			return
+		}
+
+		policy, err := utils.ParseRangeBoundaryPolicy(r.URL.Query().Get("boundary"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ranges, err := watcher.Ranges(start, end, policy)
+		if errors.Is(err, utils.ErrRangeNotIndexed) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SpanBatchResponse{Ranges: ranges})
+	}
+}
+
+// Response to a query for the batch that contains an L2 block.
+type BlockBatchResponse struct {
+	Block uint64               `json:"block"`
+	Batch utils.SpanBatchRange `json:"batch"`
+}
+
+// Return the indexed batch that contains the L2 block given by the n path parameter.
+func handleBlockBatch(watcher *utils.SpanBatchWatcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		block, err := strconv.ParseUint(mux.Vars(r)["n"], 10, 64)
+		if err != nil {
+			http.Error(w, "invalid block number", http.StatusBadRequest)
+			return
+		}
+
+		batch, err := watcher.BatchContaining(block)
+		if errors.Is(err, utils.ErrRangeNotIndexed) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(BlockBatchResponse{Block: block, Batch: batch})
+	}
+}
+
+// Allow browsers to query the public API from any origin, so that explorers can call it directly.
+func publicAPI(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		next(w, r)
+	}
 }
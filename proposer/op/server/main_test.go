@@ -57,7 +57,7 @@ func TestHandleSpanBatchRanges(t *testing.T) {
 	startBlock := block - 10000
 	endBlock := block - 9000
 
-	l1BeaconClient, err := utils.SetupBeacon(l1Beacon)
+	l1BeaconClient, err := utils.SetupBeacon(context.Background(), l1Beacon, utils.DefaultRPCTimeout)
 	if err != nil {
 		t.Fatalf("Failed to setup beacon: %v", err)
 	}